@@ -4,6 +4,8 @@ go 1.12
 
 require (
 	github.com/chnsz/golangsdk v0.0.0-20220815060718-d9eb219b1e74
+	github.com/hashicorp/go-multierror v1.1.1
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.10.0
+	github.com/huaweicloud/huaweicloud-sdk-go-v3 v0.0.102
 	github.com/huaweicloud/terraform-provider-huaweicloud v1.39.0
 )
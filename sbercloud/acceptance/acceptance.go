@@ -35,6 +35,65 @@ var (
 	SBC_SECRET_KEY = os.Getenv("SBC_SECRET_KEY")
 
 	SBC_DLI_FLINK_JAR_OBS_PATH = os.Getenv("SBC_DLI_FLINK_JAR_OBS_PATH")
+
+	SBC_MEETING_ACCOUNT_NAME     = os.Getenv("SBC_MEETING_ACCOUNT_NAME")
+	SBC_MEETING_ACCOUNT_PASSWORD = os.Getenv("SBC_MEETING_ACCOUNT_PASSWORD")
+	SBC_MEETING_ROOM_ID          = os.Getenv("SBC_MEETING_ROOM_ID")
+
+	SBC_CCE_CLUSTER_ID = os.Getenv("SBC_CCE_CLUSTER_ID")
+	SBC_ASM_CLUSTER_ID = os.Getenv("SBC_ASM_CLUSTER_ID")
+
+	SBC_WORKSPACE_FLAVOR_ID = os.Getenv("SBC_WORKSPACE_FLAVOR_ID")
+	SBC_WORKSPACE_IMAGE_ID  = os.Getenv("SBC_WORKSPACE_IMAGE_ID")
+
+	SBC_MPC_BUCKET_NAME  = os.Getenv("SBC_MPC_BUCKET_NAME")
+	SBC_MPC_INPUT_OBJECT = os.Getenv("SBC_MPC_INPUT_OBJECT")
+	SBC_MPC_TEMPLATE_ID  = os.Getenv("SBC_MPC_TEMPLATE_ID")
+
+	SBC_DSS_STORAGE_ID = os.Getenv("SBC_DSS_STORAGE_ID")
+
+	SBC_MRS_CLUSTER_ID = os.Getenv("SBC_MRS_CLUSTER_ID")
+
+	SBC_APM_APPLICATION_ID = os.Getenv("SBC_APM_APPLICATION_ID")
+	SBC_APM_ENVIRONMENT_ID = os.Getenv("SBC_APM_ENVIRONMENT_ID")
+	SBC_APM_COMPONENT_ID   = os.Getenv("SBC_APM_COMPONENT_ID")
+
+	SBC_DGAS_OBS_BUCKET = os.Getenv("SBC_DGAS_OBS_BUCKET")
+	SBC_DGAS_OBS_PATH   = os.Getenv("SBC_DGAS_OBS_PATH")
+
+	SBC_BCS_INSTANCE_ID = os.Getenv("SBC_BCS_INSTANCE_ID")
+	SBC_BCS_ORG_NAME    = os.Getenv("SBC_BCS_ORG_NAME")
+
+	SBC_ROMA_FLAVOR_ID    = os.Getenv("SBC_ROMA_FLAVOR_ID")
+	SBC_ROMA_INSTANCE_ID  = os.Getenv("SBC_ROMA_INSTANCE_ID")
+	SBC_ROMA_API_GROUP_ID = os.Getenv("SBC_ROMA_API_GROUP_ID")
+
+	SBC_IVS_ID_CARD_FRONT_URL = os.Getenv("SBC_IVS_ID_CARD_FRONT_URL")
+	SBC_IVS_FACE_IMAGE_URL    = os.Getenv("SBC_IVS_FACE_IMAGE_URL")
+
+	SBC_RMS_POLICY_DEFINITION_ID = os.Getenv("SBC_RMS_POLICY_DEFINITION_ID")
+
+	SBC_VOD_MEDIA_ASSET_FILE = os.Getenv("SBC_VOD_MEDIA_ASSET_FILE")
+
+	SBC_CBR_BACKUP_ID        = os.Getenv("SBC_CBR_BACKUP_ID")
+	SBC_CBR_SHARE_PROJECT_ID = os.Getenv("SBC_CBR_SHARE_PROJECT_ID")
+
+	SBC_SDRS_DOMAIN_ID             = os.Getenv("SBC_SDRS_DOMAIN_ID")
+	SBC_SDRS_PROTECTED_INSTANCE_ID = os.Getenv("SBC_SDRS_PROTECTED_INSTANCE_ID")
+	SBC_SDRS_REPLICATION_ID        = os.Getenv("SBC_SDRS_REPLICATION_ID")
+
+	SBC_OST_BUSINESS_TYPE_ID    = os.Getenv("SBC_OST_BUSINESS_TYPE_ID")
+	SBC_OST_PRODUCT_CATEGORY_ID = os.Getenv("SBC_OST_PRODUCT_CATEGORY_ID")
+
+	SBC_HSS_HOST_ID = os.Getenv("SBC_HSS_HOST_ID")
+
+	SBC_CBH_FLAVOR_ID = os.Getenv("SBC_CBH_FLAVOR_ID")
+
+	SBC_DBSS_FLAVOR      = os.Getenv("SBC_DBSS_FLAVOR")
+	SBC_DBSS_INSTANCE_ID = os.Getenv("SBC_DBSS_INSTANCE_ID")
+
+	SBC_CNAD_INSTANCE_ID = os.Getenv("SBC_CNAD_INSTANCE_ID")
+	SBC_CNAD_EIP_ID      = os.Getenv("SBC_CNAD_EIP_ID")
 )
 
 // TestAccProviderFactories is a static map containing only the main provider instance
@@ -287,6 +346,110 @@ func TestAccPreCheckOBS(t *testing.T) {
 	}
 }
 
+func TestAccPreCheckMeetingAccount(t *testing.T) {
+	if SBC_MEETING_ACCOUNT_NAME == "" || SBC_MEETING_ACCOUNT_PASSWORD == "" || SBC_MEETING_ROOM_ID == "" {
+		t.Skip("SBC_MEETING_ACCOUNT_NAME, SBC_MEETING_ACCOUNT_PASSWORD and SBC_MEETING_ROOM_ID must be set " +
+			"for Meeting acceptance tests")
+	}
+}
+
+func TestAccPreCheckCceClusterId(t *testing.T) {
+	if SBC_CCE_CLUSTER_ID == "" {
+		t.Skip("SBC_CCE_CLUSTER_ID must be set for the acceptance tests which require an existing CCE cluster")
+	}
+}
+
+func TestAccPreCheckDssStorageId(t *testing.T) {
+	if SBC_DSS_STORAGE_ID == "" {
+		t.Skip("SBC_DSS_STORAGE_ID must be set for the DSS disk acceptance tests")
+	}
+}
+
+func TestAccPreCheckMrsClusterId(t *testing.T) {
+	if SBC_MRS_CLUSTER_ID == "" {
+		t.Skip("SBC_MRS_CLUSTER_ID must be set for the acceptance tests which depend on an MRS cluster")
+	}
+}
+
+// TestAccPreCheckApmComponent requires a component of an APM application which is reported by an agent.
+func TestAccPreCheckApmComponent(t *testing.T) {
+	if SBC_APM_APPLICATION_ID == "" || SBC_APM_ENVIRONMENT_ID == "" || SBC_APM_COMPONENT_ID == "" {
+		t.Skip("SBC_APM_APPLICATION_ID, SBC_APM_ENVIRONMENT_ID and SBC_APM_COMPONENT_ID must be set for the " +
+			"acceptance tests of the APM agent configuration")
+	}
+}
+
+// TestAccPreCheckDgasGraphData requires the graph data to be uploaded to OBS.
+func TestAccPreCheckDgasGraphData(t *testing.T) {
+	if SBC_DGAS_OBS_BUCKET == "" || SBC_DGAS_OBS_PATH == "" {
+		t.Skip("SBC_DGAS_OBS_BUCKET and SBC_DGAS_OBS_PATH must be set for the DGAS job acceptance tests")
+	}
+}
+
+// TestAccPreCheckBcsInstance requires an existing BCS instance with a peer organization.
+func TestAccPreCheckBcsInstance(t *testing.T) {
+	if SBC_BCS_INSTANCE_ID == "" || SBC_BCS_ORG_NAME == "" {
+		t.Skip("SBC_BCS_INSTANCE_ID and SBC_BCS_ORG_NAME must be set for the BCS peer node acceptance tests")
+	}
+}
+
+func TestAccPreCheckRomaConnectFlavor(t *testing.T) {
+	if SBC_ROMA_FLAVOR_ID == "" {
+		t.Skip("SBC_ROMA_FLAVOR_ID must be set for the ROMA Connect instance acceptance tests")
+	}
+}
+
+// TestAccPreCheckRomaConnectInstance requires a running ROMA Connect instance, because the provisioning takes up
+// to 20 minutes.
+func TestAccPreCheckRomaConnectInstance(t *testing.T) {
+	if SBC_ROMA_INSTANCE_ID == "" {
+		t.Skip("SBC_ROMA_INSTANCE_ID must be set for the ROMA Connect acceptance tests")
+	}
+}
+
+func TestAccPreCheckRomaConnectApiGroup(t *testing.T) {
+	if SBC_ROMA_INSTANCE_ID == "" || SBC_ROMA_API_GROUP_ID == "" {
+		t.Skip("SBC_ROMA_INSTANCE_ID and SBC_ROMA_API_GROUP_ID must be set for the ROMA Connect API acceptance tests")
+	}
+}
+
+// TestAccPreCheckIvsImages requires the images of an ID card and a face stored in OBS, each verification consumes
+// the quota of IVS.
+func TestAccPreCheckIvsImages(t *testing.T) {
+	if SBC_IVS_ID_CARD_FRONT_URL == "" || SBC_IVS_FACE_IMAGE_URL == "" {
+		t.Skip("SBC_IVS_ID_CARD_FRONT_URL and SBC_IVS_FACE_IMAGE_URL must be set for the IVS acceptance tests")
+	}
+}
+
+// TestAccPreCheckMpcTranscoding requires an OBS bucket containing the media file to be transcoded.
+func TestAccPreCheckMpcTranscoding(t *testing.T) {
+	if SBC_MPC_BUCKET_NAME == "" || SBC_MPC_INPUT_OBJECT == "" || SBC_MPC_TEMPLATE_ID == "" {
+		t.Skip("SBC_MPC_BUCKET_NAME, SBC_MPC_INPUT_OBJECT and SBC_MPC_TEMPLATE_ID must be set for the MPC " +
+			"acceptance tests")
+	}
+}
+
+// TestAccPreCheckWorkspaceDesktop requires the Workspace service to be registered in the region.
+func TestAccPreCheckWorkspaceDesktop(t *testing.T) {
+	if SBC_WORKSPACE_FLAVOR_ID == "" || SBC_WORKSPACE_IMAGE_ID == "" {
+		t.Skip("SBC_WORKSPACE_FLAVOR_ID and SBC_WORKSPACE_IMAGE_ID must be set for the Workspace desktop " +
+			"acceptance tests")
+	}
+}
+
+func TestAccPreCheckAsmClusterId(t *testing.T) {
+	if SBC_CCE_CLUSTER_ID == "" || SBC_ASM_CLUSTER_ID == "" {
+		t.Skip("SBC_CCE_CLUSTER_ID and SBC_ASM_CLUSTER_ID must be set for the acceptance tests which add an " +
+			"additional CCE cluster to the ASM mesh")
+	}
+}
+
+func TestAccPreCheckRmsPolicyDefinition(t *testing.T) {
+	if SBC_RMS_POLICY_DEFINITION_ID == "" {
+		t.Skip("SBC_RMS_POLICY_DEFINITION_ID must be set for RMS acceptance tests")
+	}
+}
+
 func RandomAccResourceName() string {
 	return fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
 }
@@ -303,3 +466,82 @@ func RandomCidrAndGatewayIp() (string, string) {
 	seed := acctest.RandIntRange(0, 255)
 	return fmt.Sprintf("172.16.%d.0/24", seed), fmt.Sprintf("172.16.%d.1", seed)
 }
+
+func TestAccPreCheckVODMediaAsset(t *testing.T) {
+	if SBC_VOD_MEDIA_ASSET_FILE == "" {
+		t.Skip("SBC_VOD_MEDIA_ASSET_FILE must be set for VOD media asset acceptance tests")
+	}
+}
+
+// TestAccPreCheckCbrBackupShare requires an existing backup and the project of another account to share it with.
+func TestAccPreCheckCbrBackupShare(t *testing.T) {
+	if SBC_CBR_BACKUP_ID == "" || SBC_CBR_SHARE_PROJECT_ID == "" {
+		t.Skip("SBC_CBR_BACKUP_ID and SBC_CBR_SHARE_PROJECT_ID must be set for the CBR backup share acceptance tests")
+	}
+}
+
+func TestAccPreCheckSdrsDomainId(t *testing.T) {
+	if SBC_SDRS_DOMAIN_ID == "" {
+		t.Skip("SBC_SDRS_DOMAIN_ID must be set for the SDRS acceptance tests")
+	}
+}
+
+// TestAccPreCheckSdrsReplication requires a protected instance in a group whose protection is started, and a
+// replication pair of the same group which is not attached yet.
+func TestAccPreCheckSdrsReplication(t *testing.T) {
+	if SBC_SDRS_PROTECTED_INSTANCE_ID == "" || SBC_SDRS_REPLICATION_ID == "" {
+		t.Skip("SBC_SDRS_PROTECTED_INSTANCE_ID and SBC_SDRS_REPLICATION_ID must be set for the SDRS replication " +
+			"attachment acceptance tests")
+	}
+}
+
+// TestAccPreCheckOstTicket requires the business type and the product category of the tickets. The tests submit real
+// tickets to the Operator Service Center.
+func TestAccPreCheckOstTicket(t *testing.T) {
+	if SBC_OST_BUSINESS_TYPE_ID == "" || SBC_OST_PRODUCT_CATEGORY_ID == "" {
+		t.Skip("SBC_OST_BUSINESS_TYPE_ID and SBC_OST_PRODUCT_CATEGORY_ID must be set for the OST ticket acceptance tests")
+	}
+}
+
+// TestAccPreCheckHssHostId requires an ECS instance with the HSS agent installed.
+func TestAccPreCheckHssHostId(t *testing.T) {
+	if SBC_HSS_HOST_ID == "" {
+		t.Skip("SBC_HSS_HOST_ID must be set for the HSS acceptance tests")
+	}
+}
+
+// TestAccPreCheckCbhFlavorId is required by the CBH instance tests, which create prePaid instances.
+func TestAccPreCheckCbhFlavorId(t *testing.T) {
+	if SBC_CBH_FLAVOR_ID == "" {
+		t.Skip("SBC_CBH_FLAVOR_ID must be set for the CBH instance acceptance tests")
+	}
+}
+
+// TestAccPreCheckDbssFlavor is required by the DBSS instance tests, which create prePaid instances.
+func TestAccPreCheckDbssFlavor(t *testing.T) {
+	if SBC_DBSS_FLAVOR == "" {
+		t.Skip("SBC_DBSS_FLAVOR must be set for the DBSS instance acceptance tests")
+	}
+}
+
+// TestAccPreCheckDbssInstanceId requires an existing DBSS instance for the tests of the audit rules.
+func TestAccPreCheckDbssInstanceId(t *testing.T) {
+	if SBC_DBSS_INSTANCE_ID == "" {
+		t.Skip("SBC_DBSS_INSTANCE_ID must be set for the DBSS audit rule acceptance tests")
+	}
+}
+
+// TestAccPreCheckCnadInstanceId requires an existing CNAD instance, which can't be purchased by the provider.
+func TestAccPreCheckCnadInstanceId(t *testing.T) {
+	if SBC_CNAD_INSTANCE_ID == "" {
+		t.Skip("SBC_CNAD_INSTANCE_ID must be set for the CNAD acceptance tests")
+	}
+}
+
+// TestAccPreCheckCnadProtectedObject requires an EIP in the region of the CNAD instance in addition to the instance.
+func TestAccPreCheckCnadProtectedObject(t *testing.T) {
+	TestAccPreCheckCnadInstanceId(t)
+	if SBC_CNAD_EIP_ID == "" {
+		t.Skip("SBC_CNAD_EIP_ID must be set for the CNAD protected object acceptance tests")
+	}
+}
@@ -0,0 +1,91 @@
+package aom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAlarmActionRuleResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "aomv2", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud AOM client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("alert", "action-rules", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccAomAlarmActionRule_basic(t *testing.T) {
+	var rule interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_aom_alarm_action_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&rule,
+		getAlarmActionRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAomAlarmActionRule_basic(rName, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttrPair(resourceName, "smn_topics.0",
+						"sbercloud_smn_topic.test", "id"),
+				),
+			},
+			{
+				Config: testAccAomAlarmActionRule_basic(rName, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAomAlarmActionRule_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_smn_topic" "test" {
+  name = "%[1]s"
+}
+
+resource "sbercloud_aom_alarm_action_rule" "test" {
+  name                  = "%[1]s"
+  type                  = "1"
+  description           = "%[2]s"
+  notification_template = "aom.built-in.template.en"
+  smn_topics            = [sbercloud_smn_topic.test.id]
+}
+`, rName, description)
+}
@@ -0,0 +1,101 @@
+package aom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAlarmRuleResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "aomv2", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud AOM client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("alarm-rules", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	rules, ok := respBody.(map[string]interface{})["thresholds"].([]interface{})
+	if !ok || len(rules) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rules[0], nil
+}
+
+func TestAccAomAlarmRule_basic(t *testing.T) {
+	var rule interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_aom_alarm_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&rule,
+		getAlarmRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAomAlarmRule_basic(rName, 2, "cpuUsage > 80"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "PAAS.NODE"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_level", "2"),
+					resource.TestCheckResourceAttr(resourceName, "condition_expression", "cpuUsage > 80"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "alarm_rule_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "state"),
+				),
+			},
+			{
+				Config: testAccAomAlarmRule_basic(rName, 3, "cpuUsage > 90"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "alarm_level", "3"),
+					resource.TestCheckResourceAttr(resourceName, "condition_expression", "cpuUsage > 90"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAomAlarmRule_basic(rName string, level int, expression string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_aom_alarm_rule" "test" {
+  name                 = "%s"
+  description          = "created by terraform"
+  namespace            = "PAAS.NODE"
+  dimension_name       = "hostID"
+  dimension_value      = "test-host"
+  alarm_level          = %d
+  condition_expression = "%s"
+}
+`, rName, level, expression)
+}
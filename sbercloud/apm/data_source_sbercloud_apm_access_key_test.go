@@ -0,0 +1,55 @@
+package apm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccApmAccessKeyDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.sbercloud_apm_access_key.test"
+
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApmAccessKeyDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_id",
+						"sbercloud_apm_application.test", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_key"),
+					// the data source is read for the first time when the application is created
+					resource.TestCheckResourceAttrSet(dataSourceName, "master_access_key"),
+				),
+			},
+			{
+				Config: testAccApmAccessKeyDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_key"),
+					resource.TestCheckResourceAttr(dataSourceName, "master_access_key", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccApmAccessKeyDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_apm_application" "test" {
+  name = "%s"
+}
+
+data "sbercloud_apm_access_key" "test" {
+  instance_id = sbercloud_apm_application.test.id
+}
+`, rName)
+}
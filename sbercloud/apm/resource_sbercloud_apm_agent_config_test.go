@@ -0,0 +1,96 @@
+package apm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAgentConfigResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "apm", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud APM client: %s", err)
+	}
+
+	configPath := c.ServiceURL("apm-service", "apps", state.Primary.Attributes["application_id"], "components",
+		state.Primary.ID, "envs", state.Primary.Attributes["environment_id"], "agent-config")
+	resp, err := c.Request("GET", configPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// The configuration is restored to the default one when the resource is destroyed, so there is no destroy check.
+func TestAccApmAgentConfig_basic(t *testing.T) {
+	var agentConfig interface{}
+
+	resourceName := "sbercloud_apm_agent_config.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&agentConfig,
+		getAgentConfigResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckApmComponent(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApmAgentConfig_basic("0.5", 1000),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "component_id", acceptance.SBC_APM_COMPONENT_ID),
+					resource.TestCheckResourceAttr(resourceName, "sample_rate", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "slow_request_threshold_ms", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "categories.#", "2"),
+				),
+			},
+			{
+				Config: testAccApmAgentConfig_basic("1", 800),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "sample_rate", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slow_request_threshold_ms", "800"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId: fmt.Sprintf("%s/%s/%s", acceptance.SBC_APM_APPLICATION_ID,
+					acceptance.SBC_APM_ENVIRONMENT_ID, acceptance.SBC_APM_COMPONENT_ID),
+			},
+		},
+	})
+}
+
+func testAccApmAgentConfig_basic(sampleRate string, slowThreshold int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_apm_agent_config" "test" {
+  application_id             = "%[1]s"
+  environment_id             = "%[2]s"
+  component_id               = "%[3]s"
+  categories                 = ["url", "jdbc"]
+  sample_rate                = %[4]s
+  log_sample_rate            = 0.1
+  slow_request_threshold_ms  = %[5]d
+  error_rate_threshold       = 0.05
+  db_slow_query_threshold_ms = 500
+}
+`, acceptance.SBC_APM_APPLICATION_ID, acceptance.SBC_APM_ENVIRONMENT_ID, acceptance.SBC_APM_COMPONENT_ID,
+		sampleRate, slowThreshold)
+}
@@ -0,0 +1,89 @@
+package apm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getApplicationResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "apm", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud APM client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("cmdb", "apps", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccApmApplication_basic(t *testing.T) {
+	var app interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_apm_application.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&app,
+		getApplicationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApmApplication_basic(rName, "created by terraform", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccApmApplication_basic(rName, "updated by terraform", "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApmApplication_basic(rName, description, tagValue string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_apm_application" "test" {
+  name        = "%s"
+  description = "%s"
+
+  tags = {
+    foo = "%s"
+  }
+}
+`, rName, description, tagValue)
+}
@@ -0,0 +1,89 @@
+package asm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getMeshClusterResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "asm", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud ASM client: %s", err)
+	}
+
+	url := c.ServiceURL("meshes", state.Primary.Attributes["mesh_id"], "clusters",
+		state.Primary.Attributes["cluster_id"])
+	resp, err := c.Request("GET", url, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccAsmMeshKubernetesCluster_basic(t *testing.T) {
+	var cluster interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_asm_mesh_kubernetes_cluster.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&cluster,
+		getMeshClusterResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAsmClusterId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsmMeshKubernetesCluster_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "mesh_id", "sbercloud_asm_mesh.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_id", acceptance.SBC_ASM_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "installation.0.node_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Running"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAsmMeshKubernetesCluster_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sbercloud_cce_nodes" "test" {
+  cluster_id = "%s"
+}
+
+resource "sbercloud_asm_mesh_kubernetes_cluster" "test" {
+  mesh_id    = sbercloud_asm_mesh.test.id
+  cluster_id = "%s"
+
+  installation {
+    node_ids = [data.sbercloud_cce_nodes.test.ids[0]]
+  }
+}
+`, testAccAsmMesh_basic(rName), acceptance.SBC_ASM_CLUSTER_ID, acceptance.SBC_ASM_CLUSTER_ID)
+}
@@ -0,0 +1,97 @@
+package asm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getMeshResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "asm", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud ASM client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("meshes", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccAsmMesh_basic(t *testing.T) {
+	var mesh interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_asm_mesh.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&mesh,
+		getMeshResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCceClusterId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsmMesh_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "InCluster"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_id", acceptance.SBC_CCE_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "conf.0.traffic.0.outbound_traffic_policy",
+						"ALLOW_ANY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Running"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAsmMesh_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_asm_mesh" "test" {
+  name       = "%s"
+  type       = "InCluster"
+  cluster_id = "%s"
+
+  conf {
+    traffic {
+      outbound_traffic_policy = "ALLOW_ANY"
+      tracing_sampling        = 1
+    }
+    istio {
+      access_log_enabled = true
+    }
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, rName, acceptance.SBC_CCE_CLUSTER_ID)
+}
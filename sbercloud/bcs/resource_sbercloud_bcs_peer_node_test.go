@@ -0,0 +1,98 @@
+package bcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/bcs/v2/blockchains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getPeerNodeResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.BcsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud BCS client: %s", err)
+	}
+
+	orgs, err := blockchains.GetNodes(c, state.Primary.Attributes["instance_id"]).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	org, ok := (*orgs)[state.Primary.Attributes["org_name"]]
+	if !ok {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	peer, ok := org.Peers[state.Primary.Attributes["peer_ids.0"]]
+	if !ok {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return peer, nil
+}
+
+func TestAccBcsPeerNode_basic(t *testing.T) {
+	var peer blockchains.Node
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_bcs_peer_node.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&peer,
+		getPeerNodeResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckBcsInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBcsPeerNode_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", acceptance.SBC_BCS_INSTANCE_ID),
+					resource.TestCheckResourceAttr(resourceName, "org_name", acceptance.SBC_BCS_ORG_NAME),
+					resource.TestCheckResourceAttr(resourceName, "peer_quantity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "peer_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Normal"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_path"),
+				),
+			},
+			{
+				Config: testAccBcsPeerNode_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "peer_quantity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "peer_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBcsPeerNode_basic(rName string, quantity int) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_bcs_peer_node" "test" {
+  instance_id       = "%[1]s"
+  org_name          = "%[2]s"
+  peer_node_name    = "%[3]s"
+  peer_quantity     = %[4]d
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+
+  storage {
+    type = "SAS"
+    size = 100
+  }
+}
+`, acceptance.SBC_BCS_INSTANCE_ID, acceptance.SBC_BCS_ORG_NAME, rName, quantity)
+}
@@ -0,0 +1,128 @@
+package cbh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getInstanceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "cbh", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CBH client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("cbs", "instance", "list"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := utils.PathSearch(fmt.Sprintf("instance[?instance_id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if instance == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func TestAccCbhInstance_basic(t *testing.T) {
+	var instance interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_cbh_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCbhFlavorId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbhInstance_basic(rName, "Terraform@123", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "foo"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "server_id"),
+				),
+			},
+			{
+				Config: testAccCbhInstance_basic(rName, "Terraform@456", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"flavor_id", "password", "period_unit", "period", "auto_renew",
+				},
+			},
+		},
+	})
+}
+
+func testAccCbhInstance_basic(rName, password, tagValue string) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/20"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  vpc_id     = sbercloud_vpc.test.id
+  gateway_ip = "192.168.0.1"
+}
+
+resource "sbercloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+
+resource "sbercloud_cbh_instance" "test" {
+  name              = "%[1]s"
+  flavor_id         = "%[2]s"
+  vpc_id            = sbercloud_vpc.test.id
+  subnet_id         = sbercloud_vpc_subnet.test.id
+  security_group_id = sbercloud_networking_secgroup.test.id
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  password          = "%[3]s"
+  period_unit       = "month"
+  period            = 1
+  auto_renew        = "false"
+
+  tags = {
+    key = "%[4]s"
+  }
+}
+`, rName, acceptance.SBC_CBH_FLAVOR_ID, password, tagValue)
+}
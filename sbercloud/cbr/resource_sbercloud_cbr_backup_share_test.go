@@ -0,0 +1,79 @@
+package cbr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getBackupShareResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.CbrV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CBR client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("backups", state.Primary.ID, "members"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if count, ok := respBody.(map[string]interface{})["count"].(float64); !ok || count == 0 {
+		return nil, fmt.Errorf("the CBR backup (%s) is not shared with any project", state.Primary.ID)
+	}
+	return respBody, nil
+}
+
+func TestAccCBRV3BackupShare_basic(t *testing.T) {
+	var members interface{}
+	resourceName := "sbercloud_cbr_backup_share.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&members,
+		getBackupShareResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCbrBackupShare(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCBRV3BackupShare_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "backup_id", acceptance.SBC_CBR_BACKUP_ID),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCBRV3BackupShare_basic() string {
+	return fmt.Sprintf(`
+resource "sbercloud_cbr_backup_share" "test" {
+  backup_id = "%s"
+  members   = ["%s"]
+}
+`, acceptance.SBC_CBR_BACKUP_ID, acceptance.SBC_CBR_SHARE_PROJECT_ID)
+}
@@ -0,0 +1,96 @@
+package cbr
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/cbr/v3/policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getVaultAssociatePolicyResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.CbrV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CBR client: %s", err)
+	}
+
+	parts := strings.Split(state.Primary.ID, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid ID format, want '<vault_id>/<policy_id>', but got '%s'", state.Primary.ID)
+	}
+	policy, err := policies.Get(c, parts[1]).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for _, vault := range policy.AssociatedVaults {
+		if vault.VaultID == parts[0] {
+			return policy, nil
+		}
+	}
+	return nil, fmt.Errorf("the CBR policy (%s) is not associated with the vault (%s)", parts[1], parts[0])
+}
+
+func TestAccCBRV3VaultAssociatePolicy_basic(t *testing.T) {
+	var policy policies.Policy
+	randName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_cbr_vault_associate_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&policy,
+		getVaultAssociatePolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCBRV3VaultAssociatePolicy_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "vault_id", "sbercloud_cbr_vault.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", "sbercloud_cbr_policy.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCBRV3VaultAssociatePolicy_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_cbr_vault" "test" {
+  name             = "%[1]s"
+  type             = "disk"
+  consistent_level = "crash_consistent"
+  protection_type  = "backup"
+  size             = 50
+}
+
+resource "sbercloud_cbr_policy" "test" {
+  name        = "%[1]s"
+  type        = "backup"
+  time_period = 20
+
+  backup_cycle {
+    days            = "MO,TU"
+    execution_times = ["06:00"]
+  }
+}
+
+resource "sbercloud_cbr_vault_associate_policy" "test" {
+  vault_id  = sbercloud_cbr_vault.test.id
+  policy_id = sbercloud_cbr_policy.test.id
+}
+`, rName)
+}
@@ -0,0 +1,122 @@
+package cbr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cbr/v3/vaults"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getVaultResourceAttachResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.CbrV3Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CBR client: %s", err)
+	}
+
+	vault, err := vaults.Get(c, state.Primary.ID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	volumeID := state.Primary.Attributes["volumes.0.id"]
+	for _, resource := range vault.Resources {
+		if resource.ID == volumeID {
+			return vault, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func TestAccCBRV3VaultResourceAttach_basic(t *testing.T) {
+	var vault vaults.Vault
+	randName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_cbr_vault_resource_attach.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&vault,
+		getVaultResourceAttachResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCBRV3VaultResourceAttach_basic(randName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "vault_id", "sbercloud_cbr_vault.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "volumes.0.id",
+						"sbercloud_evs_volume.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "protection_type", "backup"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccCBRV3VaultResourceAttach_basic(randName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "volumes.0.id",
+						"sbercloud_evs_volume.test.1", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVaultResourceAttachImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccVaultResourceAttachImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["volumes.0.id"]), nil
+	}
+}
+
+func testAccCBRV3VaultResourceAttach_basic(rName string, volumeIndex int) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_evs_volume" "test" {
+  count = 2
+
+  name              = "%[1]s-${count.index}"
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  volume_type       = "SSD"
+  size              = 40
+}
+
+resource "sbercloud_cbr_vault" "test" {
+  name             = "%[1]s"
+  type             = "disk"
+  consistent_level = "crash_consistent"
+  protection_type  = "backup"
+  size             = 50
+
+  lifecycle {
+    ignore_changes = [resources]
+  }
+}
+
+resource "sbercloud_cbr_vault_resource_attach" "test" {
+  vault_id = sbercloud_cbr_vault.test.id
+
+  volumes {
+    id = sbercloud_evs_volume.test[%[2]d].id
+  }
+}
+`, rName, volumeIndex)
+}
@@ -65,6 +65,15 @@ func TestAccCCEAddonV3_values(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "status", "running"),
 				),
 			},
+			{
+				Config: testAccCCEAddonV3_valuesUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCEAddonV3Exists(resourceName, clusterName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+					resource.TestCheckResourceAttr(resourceName, "template_version", "1.19.9"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
 		},
 	})
 }
@@ -246,3 +255,60 @@ resource "sbercloud_cce_addon" "test" {
 }
 `, testAccCCENodePool_Base(rName), rName, acceptance.SBC_PROJECT_ID)
 }
+
+func testAccCCEAddonV3_valuesUpdate(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_node_pool" "test" {
+  cluster_id         = sbercloud_cce_cluster.test.id
+  name               = "%s"
+  os                 = "CentOS 7.6"
+  flavor_id          = "c6nl.large.2"
+  initial_node_count = 2
+  availability_zone  = data.sbercloud_availability_zones.test.names[0]
+  key_pair           = sbercloud_compute_keypair.test.name
+  scall_enable       = true
+  min_node_count     = 2
+  max_node_count     = 4
+  priority           = 1
+  type               = "vm"
+
+  root_volume {
+    size       = 50
+    volumetype = "SAS"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SAS"
+  }
+}
+
+data "sbercloud_cce_addon_template" "test" {
+  cluster_id = sbercloud_cce_cluster.test.id
+  name       = "autoscaler"
+  version    = "1.19.9"
+}
+
+resource "sbercloud_cce_addon" "test" {
+  cluster_id       = sbercloud_cce_cluster.test.id
+  template_name    = "autoscaler"
+  template_version = "1.19.9"
+
+  values {
+    basic  = jsondecode(data.sbercloud_cce_addon_template.test.spec).basic
+    custom = merge(
+      jsondecode(data.sbercloud_cce_addon_template.test.spec).parameters.custom,
+      {
+        cluster_id       = sbercloud_cce_cluster.test.id
+        tenant_id        = "%s"
+        scaleDownEnabled = true
+      }
+    )
+    flavor_json = jsonencode(jsondecode(data.sbercloud_cce_addon_template.test.spec).parameters.flavor2)
+  }
+
+  depends_on = [sbercloud_cce_node_pool.test]
+}
+`, testAccCCENodePool_Base(rName), rName, acceptance.SBC_PROJECT_ID)
+}
@@ -0,0 +1,156 @@
+package cce
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAutopilotClusterResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "cce_autopilot", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CCE autopilot client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("clusters", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccCCEAutopilotCluster_basic(t *testing.T) {
+	var cluster interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_cce_autopilot_cluster.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&cluster,
+		getAutopilotClusterResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEAutopilotCluster_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Available"),
+					resource.TestCheckResourceAttr(resourceName, "container_network.0.mode", "eni"),
+					resource.TestCheckResourceAttr(resourceName, "authentication.0.mode", "rbac"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "host_network.0.subnet",
+						"sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_internal"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_api_server_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCCEAutopilotCluster_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "new description"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
+				),
+			},
+			{
+				Config:      testAccCCEAutopilotCluster_nodePool(rName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("node pools can't be added to autopilot clusters"),
+			},
+		},
+	})
+}
+
+func testAccCCEAutopilotCluster_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_autopilot_cluster" "test" {
+  name      = "%s"
+  vpc_id    = sbercloud_vpc.test.id
+  subnet_id = sbercloud_vpc_subnet.test.id
+
+  container_network {
+    mode = "eni"
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccCCEClusterV3_Base(rName), rName)
+}
+
+func testAccCCEAutopilotCluster_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_autopilot_cluster" "test" {
+  name        = "%s"
+  vpc_id      = sbercloud_vpc.test.id
+  subnet_id   = sbercloud_vpc_subnet.test.id
+  description = "new description"
+
+  container_network {
+    mode = "eni"
+  }
+
+  tags = {
+    foo = "baz"
+    key = "value"
+  }
+}
+`, testAccCCEClusterV3_Base(rName), rName)
+}
+
+func testAccCCEAutopilotCluster_nodePool(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_cce_node_pool" "test" {
+  cluster_id         = sbercloud_cce_autopilot_cluster.test.id
+  name               = "%s"
+  os                 = "CentOS 7.6"
+  flavor_id          = "c6nl.large.2"
+  initial_node_count = 1
+  availability_zone  = data.sbercloud_availability_zones.test.names[0]
+  password           = "Test@123"
+  type               = "vm"
+
+  root_volume {
+    size       = 40
+    volumetype = "SSD"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SSD"
+  }
+}
+`, testAccCCEAutopilotCluster_update(rName), rName)
+}
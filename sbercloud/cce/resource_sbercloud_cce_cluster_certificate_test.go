@@ -0,0 +1,47 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccCCEClusterCertificate_basic(t *testing.T) {
+	resourceName := "sbercloud_cce_cluster_certificate.test"
+	randName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEClusterCertificate_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "cluster_id",
+						"${sbercloud_cce_cluster.test.id}"),
+					resource.TestCheckResourceAttr(resourceName, "validity_period", "60"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_config"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_clusters.0.server"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_users.0.client_certificate_data"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiry_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCCEClusterCertificate_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_cluster_certificate" "test" {
+  cluster_id      = sbercloud_cce_cluster.test.id
+  validity_period = 60
+}
+`, testAccCceCluster_config(rName))
+}
@@ -54,6 +54,15 @@ func TestAccCCENamespaceV1_basic(t *testing.T) {
 						"${sbercloud_cce_cluster.test.id}"),
 					resource.TestCheckResourceAttr(resourceName, "name", randName),
 					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccCCENamespaceV1_forceDestroy(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
 				),
 			},
 			{
@@ -61,6 +70,9 @@ func TestAccCCENamespaceV1_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateIdFunc: testAccCCENamespaceImportStateIdFunc(randName),
+				ImportStateVerifyIgnore: []string{
+					"force_destroy",
+				},
 			},
 		},
 	})
@@ -126,6 +138,18 @@ resource "sbercloud_cce_namespace" "test" {
 `, testAccCceCluster_config(rName), rName)
 }
 
+func testAccCCENamespaceV1_forceDestroy(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_namespace" "test" {
+  cluster_id    = sbercloud_cce_cluster.test.id
+  name          = "%s"
+  force_destroy = true
+}
+`, testAccCceCluster_config(rName), rName)
+}
+
 func testAccCCENamespaceV1_generateName(rName string) string {
 	return fmt.Sprintf(`
 %s
@@ -62,6 +62,31 @@ func TestAccCCENodeV3_basic(t *testing.T) {
 	})
 }
 
+func TestAccCCENodeV3_eipcount(t *testing.T) {
+	var node nodes.Nodes
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_cce_node.test"
+	clusterName := "sbercloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENodeV3Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCENodeV3_eipcount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodeV3Exists(resourceName, clusterName, &node),
+					resource.TestCheckResourceAttr(resourceName, "eipcount.0.count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "iptype", "5_bgp"),
+					resource.TestMatchResourceAttr(resourceName, "public_ip", regexp.MustCompile("^[0-9]{1,3}\\.[0-9]{1,3}\\.[0-9]{1,3}\\.[0-9]{1,3}$")),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCCENodeV3Destroy(s *terraform.State) error {
 	config := acceptance.TestAccProvider.Meta().(*config.Config)
 	cceClient, err := config.CceV3Client(acceptance.SBC_REGION_NAME)
@@ -274,3 +299,34 @@ resource "sbercloud_cce_node" "test" {
 }
 `, testAccCCENodeV3_Base(rName), rName)
 }
+
+func testAccCCENodeV3_eipcount(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cce_node" "test" {
+  cluster_id        = sbercloud_cce_cluster.test.id
+  name              = "%s"
+  flavor_id         = "c6nl.large.2"
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  key_pair          = sbercloud_compute_keypair.test.name
+  os                = "CentOS 7.6"
+
+  root_volume {
+    size       = 50
+    volumetype = "SAS"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SAS"
+  }
+
+  eipcount {
+    id_type     = "5_bgp"
+    share_type  = "PER"
+    charge_mode = "traffic"
+    size        = 100
+  }
+}
+`, testAccCCENodeV3_Base(rName), rName)
+}
@@ -54,6 +54,10 @@ func TestAccCCEPersistentVolumeClaimsV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
 					resource.TestCheckResourceAttr(resourceName, "name", randName),
 					resource.TestCheckResourceAttr(resourceName, "storage_class_name", "csi-disk"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "annotations.everest.io/disk-volume-type", "SSD"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "Bound"),
+					resource.TestCheckResourceAttrSet(resourceName, "volume_name"),
 				),
 			},
 			{
@@ -61,9 +65,6 @@ func TestAccCCEPersistentVolumeClaimsV1_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateIdFunc: testAccCCEPVCImportStateIdFunc(),
-				ImportStateVerifyIgnore: []string{
-					"annotations",
-				},
 			},
 		},
 	})
@@ -96,6 +97,8 @@ func TestAccCCEPersistentVolumeClaimsV1_obs(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
 					resource.TestCheckResourceAttr(resourceName, "name", randName),
 					resource.TestCheckResourceAttr(resourceName, "storage_class_name", "csi-obs"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "Bound"),
+					resource.TestCheckResourceAttrSet(resourceName, "volume_name"),
 				),
 			},
 		},
@@ -129,6 +132,8 @@ func TestAccCCEPersistentVolumeClaimsV1_sfs(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "namespace", "default"),
 					resource.TestCheckResourceAttr(resourceName, "name", randName),
 					resource.TestCheckResourceAttr(resourceName, "storage_class_name", "csi-nas"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "Bound"),
+					resource.TestCheckResourceAttrSet(resourceName, "volume_name"),
 				),
 			},
 		},
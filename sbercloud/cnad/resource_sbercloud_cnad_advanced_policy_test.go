@@ -0,0 +1,107 @@
+package cnad
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAdvancedPolicyResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "cnad", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CNAD client: %s", err)
+	}
+
+	_, err = c.Get(c.ServiceURL("cnad", "policies", state.Primary.ID), nil, nil)
+	return state.Primary.ID, err
+}
+
+func TestAccCnadAdvancedPolicy_basic(t *testing.T) {
+	var policy interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_cnad_advanced_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&policy,
+		getAdvancedPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCnadInstanceId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCnadAdvancedPolicy_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", acceptance.SBC_CNAD_INSTANCE_ID),
+					resource.TestCheckResourceAttr(resourceName, "type", "software"),
+					resource.TestCheckResourceAttr(resourceName, "udp_filter_status", "true"),
+					resource.TestCheckResourceAttr(resourceName, "detect_threshold", "100"),
+				),
+			},
+			{
+				Config: testAccCnadAdvancedPolicy_update(rName + "_update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "udp_filter_status", "false"),
+					resource.TestCheckResourceAttr(resourceName, "http_protect_status", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_conn_filter.0.status", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_conn_filter.0.new_conn_limit", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "detect_threshold", "200"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCnadAdvancedPolicy_basic(name string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_cnad_advanced_policy" "test" {
+  instance_id       = "%s"
+  name              = "%s"
+  type              = "software"
+  udp_filter_status = true
+  detect_threshold  = 100
+}
+`, acceptance.SBC_CNAD_INSTANCE_ID, name)
+}
+
+func testAccCnadAdvancedPolicy_update(name string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_cnad_advanced_policy" "test" {
+  instance_id              = "%s"
+  name                     = "%s"
+  type                     = "software"
+  udp_filter_status        = false
+  all_src_ip_filter_status = false
+  http_protect_status      = true
+  detect_threshold         = 200
+  total_flow               = 1000
+
+  max_conn_filter {
+    status           = true
+    new_conn_limit   = 1000
+    total_conn_limit = 10000
+  }
+}
+`, acceptance.SBC_CNAD_INSTANCE_ID, name)
+}
@@ -0,0 +1,100 @@
+package cnad
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getProtectedObjectResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "cnad", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CNAD client: %s", err)
+	}
+
+	listPath := c.ServiceURL("cnad", "protected-ips") + "?package_id=" + state.Primary.Attributes["instance_id"]
+	resp, err := c.Request("GET", listPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("items[?ip_id=='%s' && policy_id=='%s']|[0]", state.Primary.Attributes["floating_ip_id"],
+		state.Primary.Attributes["policy_id"])
+	object := utils.PathSearch(expression, respBody, nil)
+	if object == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return object, nil
+}
+
+func TestAccCnadAdvancedProtectedObject_basic(t *testing.T) {
+	var object interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_cnad_advanced_protected_object.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&object,
+		getProtectedObjectResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCnadProtectedObject(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCnadAdvancedProtectedObject_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id",
+						"sbercloud_cnad_advanced_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "floating_ip_id", acceptance.SBC_CNAD_EIP_ID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+				),
+			},
+			{
+				Config: testAccCnadAdvancedProtectedObject_basic(rName, rName+"_update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCnadAdvancedProtectedObject_basic(rName, objectName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_cnad_advanced_protected_object" "test" {
+  policy_id      = sbercloud_cnad_advanced_policy.test.id
+  floating_ip_id = "%s"
+  name           = "%s"
+}
+`, testAccCnadAdvancedPolicy_basic(rName), acceptance.SBC_CNAD_EIP_ID, objectName)
+}
@@ -0,0 +1,116 @@
+package codearts
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getPipelineResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "codearts_pipeline", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CodeArts pipeline client: %s", err)
+	}
+
+	path := fmt.Sprintf("%s/api/pipelines/%s", state.Primary.Attributes["project_id"], state.Primary.ID)
+	resp, err := c.Request("GET", c.ServiceURL(path), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccCodeArtsPipeline_basic(t *testing.T) {
+	var pipeline interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_codearts_pipeline.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&pipeline,
+		getPipelineResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeArtsPipeline_basic(rName, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.params.0.git_type", "codehub"),
+					resource.TestCheckResourceAttr(resourceName, "variables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variables.0.name", "env"),
+				),
+			},
+			{
+				Config: testAccCodeArtsPipeline_basic(rName, "updated by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCodeArtsPipelineImportStateFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCodeArtsPipelineImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCodeArtsPipeline_basic(rName, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_codearts_pipeline" "test" {
+  project_id  = sbercloud_codearts_project.test.id
+  name        = "%s"
+  description = "%s"
+
+  sources {
+    type = "code"
+
+    params {
+      git_type       = "codehub"
+      git_url        = sbercloud_codearts_repository.test.https_url
+      codehub_id     = sbercloud_codearts_repository.test.id
+      default_branch = "master"
+      repo_name      = sbercloud_codearts_repository.test.name
+    }
+  }
+
+  variables {
+    name  = "env"
+    type  = "string"
+    value = "test"
+  }
+}
+`, testAccCodeArtsRepository_basic(rName), rName, description)
+}
@@ -0,0 +1,81 @@
+package codearts
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getRepositoryResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "codearts_repository", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud CodeArts repository client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("v2", "repositories", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccCodeArtsRepository_basic(t *testing.T) {
+	var repository interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_codearts_repository.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&repository,
+		getRepositoryResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeArtsRepository_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "visibility", "private"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id",
+						"sbercloud_codearts_project.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ssh_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "https_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "web_url"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_members", "template_id"},
+			},
+		},
+	})
+}
+
+func testAccCodeArtsRepository_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_codearts_repository" "test" {
+  project_id  = sbercloud_codearts_project.test.id
+  name        = "%s"
+  description = "created by acc test"
+}
+`, testAccCodeArtsProject_basic(rName, "created by acc test"), rName)
+}
@@ -0,0 +1,77 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceApmAccessKey() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApmAccessKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"master_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceApmAccessKeyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "apm", region)
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	resp, err := client.Request("GET", client.ServiceURL("cmdb", "apps", instanceID, "access-keys"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return diag.Errorf("error retrieving APM access keys of instance (%s): %s", instanceID, err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID)
+	// the data source keeps no state between the reads, so the master access key is set to whatever the API
+	// returns: the API only returns it for the first query of the application, the later reads get an empty string
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("access_key", pathSearch("access_key", respBody, nil)),
+		d.Set("master_access_key", pathSearch("master_access_key", respBody, "")),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting APM access key fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/compute/v2/servers"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceComputeInstanceConsole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeInstanceConsoleRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"force_fresh": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"output": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceConsoleRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	opts := servers.ShowConsoleOutputOpts{
+		Length: d.Get("length").(int),
+	}
+	output, err := servers.ShowConsoleOutput(client, instanceID, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving the console output of compute instance (%s): %s", instanceID, err)
+	}
+
+	// the ID changes on every read, so the references to the data source always see the output as fetched again
+	if d.Get("force_fresh").(bool) {
+		d.SetId(fmt.Sprintf("%s/%d", instanceID, time.Now().UnixNano()))
+	} else {
+		d.SetId(instanceID)
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("output", output),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting compute instance console fields: %s", err)
+	}
+
+	return nil
+}
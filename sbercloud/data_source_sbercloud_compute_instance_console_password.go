@@ -0,0 +1,160 @@
+package sbercloud
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/compute/v2/servers"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceComputeInstanceConsolePassword() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeInstanceConsolePasswordRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_file": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// parseComputeInstancePrivateKey reads the RSA private key in PEM format, both the PKCS #1 and PKCS #8 forms are
+// supported.
+func parseComputeInstancePrivateKey(path string) (*rsa.PrivateKey, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the private key file (%s): %s", path, err)
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("the private key file (%s) is not in PEM format", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the private key file (%s): %s", path, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the private key file (%s) does not contain an RSA private key", path)
+	}
+	return rsaKey, nil
+}
+
+func getComputeInstanceOsType(client *golangsdk.ServiceClient, instanceID string) (string, error) {
+	resp, err := client.Request("GET", client.ServiceURL("cloudservers", instanceID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	return pathSearch("server.metadata.os_type", respBody, "").(string), nil
+}
+
+func computeInstancePasswordRefreshFunc(client *golangsdk.ServiceClient, instanceID string,
+	key *rsa.PrivateKey) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		password, err := servers.GetPassword(client, instanceID).ExtractPassword(key)
+		if err != nil {
+			return nil, "", err
+		}
+		// the password is empty until the cloud-init (Cloudbase-Init) of the instance finishes
+		if password == "" {
+			return password, "PENDING", nil
+		}
+		return password, "AVAILABLE", nil
+	}
+}
+
+func dataSourceComputeInstanceConsolePasswordRead(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	ecsClient, err := conf.ComputeV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ECS client: %s", err)
+	}
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	osType, err := getComputeInstanceOsType(ecsClient, instanceID)
+	if err != nil {
+		return diag.Errorf("error retrieving compute instance (%s): %s", instanceID, err)
+	}
+	if !strings.EqualFold(osType, "Windows") {
+		return diag.Errorf("the OS type of the compute instance (%s) is %s, the console password is only "+
+			"applicable to the Windows instances", instanceID, osType)
+	}
+
+	key, err := parseComputeInstancePrivateKey(d.Get("key_file").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      computeInstancePasswordRefreshFunc(computeClient, instanceID, key),
+		Timeout:      d.Timeout(schema.TimeoutRead),
+		PollInterval: 10 * time.Second,
+	}
+	password, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the console password of the compute instance (%s) to be "+
+			"available: %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("password", password),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting compute instance console password fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package sbercloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeInstanceConsolePasswordDataSource_linux(t *testing.T) {
+	rName := fmt.Sprintf("ecs-data-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeInstanceConsolePasswordDataSource_linux(rName),
+				ExpectError: regexp.MustCompile(`the console password is only applicable to the Windows instances`),
+			},
+		},
+	})
+}
+
+func testAccComputeInstanceConsolePasswordDataSource_linux(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance" "test" {
+  name              = "%s"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+
+  network {
+    uuid = data.sbercloud_vpc_subnet.test.id
+  }
+}
+
+data "sbercloud_compute_instance_console_password" "test" {
+  instance_id = sbercloud_compute_instance.test.id
+  key_file    = "./private.pem"
+}
+`, testAccCompute_data, rName)
+}
@@ -0,0 +1,53 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeInstanceConsoleDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("ecs-data-test-%s", acctest.RandString(5))
+	dataSourceName := "data.sbercloud_compute_instance_console.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceConsoleDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "sbercloud_compute_instance.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "length", "20"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "output"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeInstanceConsoleDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance" "test" {
+  name              = "%s"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+
+  network {
+    uuid = data.sbercloud_vpc_subnet.test.id
+  }
+}
+
+data "sbercloud_compute_instance_console" "test" {
+  instance_id = sbercloud_compute_instance.test.id
+  length      = 20
+}
+`, testAccCompute_data, rName)
+}
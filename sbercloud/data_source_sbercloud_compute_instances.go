@@ -0,0 +1,217 @@
+package sbercloud
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/ecs/v1/cloudservers"
+	"github.com/chnsz/golangsdk/pagination"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+)
+
+// the page size of the ECS list API, the maximum is 1000
+const computeInstancesPageSize = 100
+
+// DataSourceComputeInstances extends the instances data source of the huaweicloud package with the name_regex and
+// vpc_id filters and the addresses of the instances. All pages of the instances are queried, not only the first one.
+func DataSourceComputeInstances() *schema.Resource {
+	r := huaweicloud.DataSourceComputeInstances()
+	r.ReadContext = dataSourceComputeInstancesRead
+
+	r.Schema["name_regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+	r.Schema["vpc_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	instance := r.Schema["instances"].Elem.(*schema.Resource)
+	for _, name := range []string{"vpc_id", "public_ip", "private_ip"} {
+		instance.Schema[name] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	return r
+}
+
+// listComputeInstances queries the instances page by page using the page number, because the API doesn't always
+// return the links of the next pages.
+func listComputeInstances(client *golangsdk.ServiceClient, opts cloudservers.ListOpts) ([]cloudservers.CloudServer,
+	error) {
+	result := make([]cloudservers.CloudServer, 0)
+	opts.Limit = computeInstancesPageSize
+	for opts.Offset = 1; ; opts.Offset++ {
+		var servers []cloudservers.CloudServer
+		err := cloudservers.List(client, opts).EachPage(func(page pagination.Page) (bool, error) {
+			var err error
+			servers, err = cloudservers.ExtractServers(page)
+			// only the requested page is handled, the next one is queried by the page number
+			return false, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, servers...)
+		if len(servers) < computeInstancesPageSize {
+			return result, nil
+		}
+	}
+}
+
+func filterComputeInstances(d *schema.ResourceData, servers []cloudservers.CloudServer) []cloudservers.CloudServer {
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	filters := map[string]string{
+		"flavor_name":       d.Get("flavor_name").(string),
+		"image_id":          d.Get("image_id").(string),
+		"availability_zone": d.Get("availability_zone").(string),
+		"key_pair":          d.Get("key_pair").(string),
+		"vpc_id":            d.Get("vpc_id").(string),
+	}
+	result := make([]cloudservers.CloudServer, 0, len(servers))
+	for _, server := range servers {
+		values := map[string]string{
+			"flavor_name":       server.Flavor.Name,
+			"image_id":          server.Image.ID,
+			"availability_zone": server.AvailabilityZone,
+			"key_pair":          server.KeyName,
+			"vpc_id":            server.Metadata.VpcID,
+		}
+		matched := nameRegex == nil || nameRegex.MatchString(server.Name)
+		for key, filter := range filters {
+			if filter != "" && filter != values[key] {
+				matched = false
+			}
+		}
+		if matched {
+			result = append(result, server)
+		}
+	}
+	return result
+}
+
+// flattenComputeInstanceTags converts the tags in the key=value format to a map.
+func flattenComputeInstanceTags(tags []string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, tag := range tags {
+		kv := strings.SplitN(tag, "=", 2)
+		if len(kv) != 2 {
+			log.Printf("[WARN] invalid key/value format of the instance tag: %s", tag)
+			continue
+		}
+		result[kv[0]] = kv[1]
+	}
+	return result
+}
+
+// flattenComputeInstanceAddresses returns the first fixed and floating IPv4 addresses of the instance.
+func flattenComputeInstanceAddresses(server cloudservers.CloudServer) (publicIP, privateIP string) {
+	for _, addresses := range server.Addresses {
+		for _, address := range addresses {
+			if address.Version != "4" {
+				continue
+			}
+			if address.Type == "floating" && publicIP == "" {
+				publicIP = address.Addr
+			}
+			if address.Type == "fixed" && privateIP == "" {
+				privateIP = address.Addr
+			}
+		}
+	}
+	return
+}
+
+func flattenComputeInstances(servers []cloudservers.CloudServer) ([]map[string]interface{}, []string) {
+	result := make([]map[string]interface{}, 0, len(servers))
+	ids := make([]string, 0, len(servers))
+	for _, server := range servers {
+		securityGroupIDs := make([]string, 0, len(server.SecurityGroups))
+		for _, sg := range server.SecurityGroups {
+			securityGroupIDs = append(securityGroupIDs, sg.ID)
+		}
+		volumes := make([]map[string]interface{}, 0, len(server.VolumeAttached))
+		for _, volume := range server.VolumeAttached {
+			volumes = append(volumes, map[string]interface{}{
+				"volume_id":     volume.ID,
+				"is_sys_volume": huaweicloud.IsSystemVolume(volume.BootIndex),
+			})
+		}
+		hints := make([]map[string]interface{}, 0, len(server.OsSchedulerHints.Group))
+		for _, group := range server.OsSchedulerHints.Group {
+			hints = append(hints, map[string]interface{}{"group": group})
+		}
+		publicIP, privateIP := flattenComputeInstanceAddresses(server)
+
+		ids = append(ids, server.ID)
+		result = append(result, map[string]interface{}{
+			"id":                    server.ID,
+			"name":                  server.Name,
+			"image_id":              server.Image.ID,
+			"flavor_id":             server.Flavor.ID,
+			"flavor_name":           server.Flavor.Name,
+			"enterprise_project_id": server.EnterpriseProjectID,
+			"status":                server.Status,
+			"availability_zone":     server.AvailabilityZone,
+			"key_pair":              server.KeyName,
+			"security_group_ids":    securityGroupIDs,
+			"user_data":             server.UserData,
+			"volume_attached":       volumes,
+			"scheduler_hints":       hints,
+			"tags":                  flattenComputeInstanceTags(server.Tags),
+			"vpc_id":                server.Metadata.VpcID,
+			"public_ip":             publicIP,
+			"private_ip":            privateIP,
+		})
+	}
+	return result, ids
+}
+
+func dataSourceComputeInstancesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ComputeV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ECS v1 client: %s", err)
+	}
+
+	// the filters which are not supported by the API are applied after the query
+	opts := cloudservers.ListOpts{
+		Name:                d.Get("name").(string),
+		Flavor:              d.Get("flavor_id").(string),
+		Status:              d.Get("status").(string),
+		EnterpriseProjectID: conf.DataGetEnterpriseProjectID(d),
+	}
+	allServers, err := listComputeInstances(client, opts)
+	if err != nil {
+		return diag.Errorf("error retrieving ECS instances: %s", err)
+	}
+
+	instances, ids := flattenComputeInstances(filterComputeInstances(d, allServers))
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instances", instances),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ECS instances fields: %s", err)
+	}
+
+	return nil
+}
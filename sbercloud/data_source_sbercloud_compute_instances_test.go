@@ -15,6 +15,7 @@ import (
 func TestAccComputeInstancesDataSource_basic(t *testing.T) {
 	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	dataSourceName := "data.sbercloud_compute_instances.test"
+	byRegexName := "data.sbercloud_compute_instances.name_regex_filter"
 	var instance cloudservers.CloudServer
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -38,6 +39,13 @@ func TestAccComputeInstancesDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.availability_zone"),
 					resource.TestCheckResourceAttr(dataSourceName, "instances.0.tags.foo", "bar"),
 					resource.TestCheckResourceAttr(dataSourceName, "instances.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.vpc_id",
+						"data.sbercloud_vpc_subnet.test", "vpc_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.private_ip",
+						"sbercloud_compute_instance.test", "access_ip_v4"),
+					resource.TestCheckResourceAttr(byRegexName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(byRegexName, "instances.0.id",
+						"sbercloud_compute_instance.test", "id"),
 				),
 			},
 		},
@@ -86,5 +94,14 @@ data "sbercloud_compute_instances" "test" {
     sbercloud_compute_instance.test
   ]
 }
+
+data "sbercloud_compute_instances" "name_regex_filter" {
+  name_regex = "^${sbercloud_compute_instance.test.name}$"
+  vpc_id     = data.sbercloud_vpc_subnet.test.vpc_id
+
+  depends_on = [
+    sbercloud_compute_instance.test
+  ]
+}
 `, testAccCompute_data, rName)
 }
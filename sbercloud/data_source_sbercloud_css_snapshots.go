@@ -0,0 +1,166 @@
+package sbercloud
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceCssSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCssSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finished_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"indices": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenCssSnapshotTime converts the start and end times of the snapshot, which are unix timestamps in milliseconds.
+func flattenCssSnapshotTime(expression string, snapshot interface{}) string {
+	timestamp := pathSearch(expression, snapshot, float64(0)).(float64)
+	if timestamp == 0 {
+		return ""
+	}
+	return utils.FormatTimeStampRFC3339(int64(timestamp) / 1000)
+}
+
+func flattenCssSnapshots(snapshots []interface{}, nameRegex *regexp.Regexp, status string) ([]map[string]interface{},
+	[]string) {
+	result := make([]map[string]interface{}, 0, len(snapshots))
+	ids := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		name := pathSearch("name", snapshot, "").(string)
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		snapshotStatus := pathSearch("status", snapshot, "").(string)
+		if status != "" && snapshotStatus != status {
+			continue
+		}
+
+		id := pathSearch("id", snapshot, "").(string)
+		ids = append(ids, id)
+		result = append(result, map[string]interface{}{
+			"id":          id,
+			"name":        name,
+			"type":        pathSearch("backupType", snapshot, nil),
+			"created_at":  flattenCssSnapshotTime("startTime", snapshot),
+			"finished_at": flattenCssSnapshotTime("endTime", snapshot),
+			"indices":     pathSearch("indices", snapshot, nil),
+			"status":      snapshotStatus,
+			"cluster_id":  pathSearch("clusterId", snapshot, nil),
+			"version":     pathSearch("version", snapshot, nil),
+		})
+	}
+	return result, ids
+}
+
+func dataSourceCssSnapshotsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	resp, err := client.Request("GET", client.ServiceURL("clusters", clusterID, "index_snapshots"),
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200},
+		})
+	if err != nil {
+		return diag.Errorf("error retrieving snapshots of CSS cluster (%s): %s", clusterID, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	snapshots, ids := flattenCssSnapshots(pathSearch("backups", respBody, make([]interface{}, 0)).([]interface{}),
+		nameRegex, d.Get("status").(string))
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("snapshots", snapshots),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CSS snapshots fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCssSnapshotsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.sbercloud_css_snapshots.test"
+	byStatus := "data.sbercloud_css_snapshots.by_status"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckCssSnapshot(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssSnapshotsDataSource_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "snapshots.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "snapshots.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "snapshots.0.name"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshots.0.cluster_id", SBC_CSS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(byStatus, "snapshots.0.status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssSnapshotsDataSource_basic() string {
+	return fmt.Sprintf(`
+data "sbercloud_css_snapshots" "test" {
+  cluster_id = "%[1]s"
+}
+
+data "sbercloud_css_snapshots" "by_status" {
+  cluster_id = "%[1]s"
+  status     = "COMPLETED"
+}
+`, SBC_CSS_CLUSTER_ID)
+}
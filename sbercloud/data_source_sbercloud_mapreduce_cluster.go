@@ -0,0 +1,285 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/mrs/v1/cluster"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the cluster types are represented by numbers in the API responses
+var mrsClusterTypes = []string{"ANALYSIS", "STREAMING", "MIXED", "CUSTOM"}
+
+func DataSourceMapreduceCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMapreduceClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mrsClusterTypes, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_node_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"component_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// listMapreduceClusters queries all clusters page by page, the name, the status and the enterprise project are
+// filtered by the API.
+func listMapreduceClusters(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]cluster.Cluster, error) {
+	var result []cluster.Cluster
+	for page := 1; ; page++ {
+		listURL := client.ServiceURL("cluster_infos") + fmt.Sprintf("?pageSize=100&currentPage=%d", page)
+		if v, ok := d.GetOk("name"); ok {
+			listURL += fmt.Sprintf("&clusterName=%s", url.QueryEscape(v.(string)))
+		}
+		if v, ok := d.GetOk("status"); ok {
+			listURL += fmt.Sprintf("&clusterState=%s", url.QueryEscape(v.(string)))
+		}
+		if v, ok := d.GetOk("enterprise_project_id"); ok {
+			listURL += fmt.Sprintf("&enterpriseProjectId=%s", url.QueryEscape(v.(string)))
+		}
+
+		var body struct {
+			Total    int               `json:"clusterTotal"`
+			Clusters []cluster.Cluster `json:"clusters"`
+		}
+		if _, err := client.Get(listURL, &body, nil); err != nil {
+			return nil, err
+		}
+
+		result = append(result, body.Clusters...)
+		if len(body.Clusters) == 0 || len(result) >= body.Total {
+			break
+		}
+	}
+	return result, nil
+}
+
+func filterMapreduceClusters(clusters []cluster.Cluster, d *schema.ResourceData) []cluster.Cluster {
+	result := make([]cluster.Cluster, 0, len(clusters))
+	for _, c := range clusters {
+		if v, ok := d.GetOk("id"); ok && v.(string) != c.Clusterid {
+			continue
+		}
+		if v, ok := d.GetOk("type"); ok && v.(string) != flattenMapreduceClusterType(c.ClusterType) {
+			continue
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func flattenMapreduceClusterType(clusterType int) string {
+	if clusterType < 0 || clusterType >= len(mrsClusterTypes) {
+		return ""
+	}
+	return mrsClusterTypes[clusterType]
+}
+
+func flattenMapreduceClusterComponents(components []cluster.Component) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(components))
+	for i, component := range components {
+		result[i] = map[string]interface{}{
+			"id":          component.Componentid,
+			"name":        component.Componentname,
+			"version":     component.Componentversion,
+			"description": component.Componentdesc,
+		}
+	}
+	return result
+}
+
+func flattenMapreduceClusterNodes(hosts []cluster.Host) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(hosts))
+	for i, host := range hosts {
+		result[i] = map[string]interface{}{
+			"id":     host.Id,
+			"name":   host.Name,
+			"type":   host.Type,
+			"ip":     host.Ip,
+			"flavor": host.Flavor,
+			"status": host.Status,
+		}
+	}
+	return result
+}
+
+// flattenMapreduceClusterCreatedAt converts the creation time, which is a unix timestamp in string form.
+func flattenMapreduceClusterCreatedAt(createAt string) string {
+	timestamp, err := strconv.ParseInt(createAt, 10, 64)
+	if err != nil {
+		return createAt
+	}
+	return utils.FormatTimeStampRFC3339(timestamp)
+}
+
+func dataSourceMapreduceClusterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.MrsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating MRS client: %s", err)
+	}
+
+	allClusters, err := listMapreduceClusters(client, d)
+	if err != nil {
+		return diag.Errorf("error retrieving MRS clusters: %s", err)
+	}
+
+	clusters := filterMapreduceClusters(allClusters, d)
+	if len(clusters) < 1 {
+		return diag.Errorf("your query returned no results, please change your search criteria and try again")
+	}
+	if len(clusters) > 1 {
+		return diag.Errorf("your query returned more than one result, please try a more specific search criteria")
+	}
+
+	c := clusters[0]
+	hosts, err := cluster.ListHosts(client, c.Clusterid, cluster.HostOpts{PageSize: 1000})
+	if err != nil {
+		return diag.Errorf("error retrieving the nodes of MRS cluster (%s): %s", c.Clusterid, err)
+	}
+
+	d.SetId(c.Clusterid)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", c.Clustername),
+		d.Set("type", flattenMapreduceClusterType(c.ClusterType)),
+		d.Set("status", c.Clusterstate),
+		d.Set("enterprise_project_id", c.EnterpriseProjectId),
+		d.Set("version", c.Clusterversion),
+		d.Set("availability_zone", c.AvailabilityZone),
+		d.Set("vpc_id", c.Vpcid),
+		d.Set("subnet_id", c.Subnetid),
+		d.Set("master_node_ip", c.Masternodeip),
+		d.Set("private_ip", c.Privateipfirst),
+		d.Set("nodes", flattenMapreduceClusterNodes(hosts.Hosts)),
+		d.Set("component_list", flattenMapreduceClusterComponents(c.Componentlist)),
+		d.Set("created_at", flattenMapreduceClusterCreatedAt(c.Createat)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting MRS cluster fields: %s", err)
+	}
+
+	return nil
+}
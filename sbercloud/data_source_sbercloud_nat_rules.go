@@ -0,0 +1,271 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// DataSourceNatRules lists the SNAT or DNAT rules of the NAT gateways, the rules of the other type are left empty.
+func DataSourceNatRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNatRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"rule_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"snat", "dnat"}, false),
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"floating_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"snat_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dnat_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internal_service_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"external_service_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildNatRulesQueryParams(d *schema.ResourceData) url.Values {
+	params := url.Values{}
+	params.Set("limit", "100")
+	for param, key := range map[string]string{
+		"nat_gateway_id":      "gateway_id",
+		"status":              "status",
+		"floating_ip_address": "floating_ip_address",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			params.Set(param, v.(string))
+		}
+	}
+	return params
+}
+
+// listNatRules queries the rules page by page, the marker of the next page is the ID of the last rule.
+func listNatRules(client *golangsdk.ServiceClient, ruleType string, params url.Values) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+	for {
+		listPath := client.ServiceURL(fmt.Sprintf("%s_rules", ruleType)) + "?" + params.Encode()
+		resp, err := client.Request("GET", listPath, &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		rules := pathSearch(fmt.Sprintf("%s_rules", ruleType), respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, rules...)
+		if len(rules) < 100 {
+			return result, nil
+		}
+		params.Set("marker", pathSearch("id", rules[len(rules)-1], "").(string))
+	}
+}
+
+func flattenNatSnatRules(rules []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"id":                  pathSearch("id", rule, nil),
+			"gateway_id":          pathSearch("nat_gateway_id", rule, nil),
+			"floating_ip_id":      pathSearch("floating_ip_id", rule, nil),
+			"floating_ip_address": pathSearch("floating_ip_address", rule, nil),
+			"subnet_id":           pathSearch("network_id", rule, nil),
+			"cidr":                pathSearch("cidr", rule, nil),
+			"source_type":         pathSearch("source_type", rule, nil),
+			"description":         pathSearch("description", rule, nil),
+			"status":              pathSearch("status", rule, nil),
+			"created_at":          pathSearch("created_at", rule, nil),
+		})
+	}
+	return result
+}
+
+func flattenNatDnatRules(rules []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"id":                    pathSearch("id", rule, nil),
+			"gateway_id":            pathSearch("nat_gateway_id", rule, nil),
+			"floating_ip_id":        pathSearch("floating_ip_id", rule, nil),
+			"floating_ip_address":   pathSearch("floating_ip_address", rule, nil),
+			"port_id":               pathSearch("port_id", rule, nil),
+			"private_ip":            pathSearch("private_ip", rule, nil),
+			"protocol":              pathSearch("protocol", rule, nil),
+			"internal_service_port": pathSearch("internal_service_port", rule, nil),
+			"external_service_port": pathSearch("external_service_port", rule, nil),
+			"description":           pathSearch("description", rule, nil),
+			"status":                pathSearch("status", rule, nil),
+			"created_at":            pathSearch("created_at", rule, nil),
+		})
+	}
+	return result
+}
+
+func dataSourceNatRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.NatGatewayClient(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT client: %s", err)
+	}
+
+	ruleType := d.Get("rule_type").(string)
+	rules, err := listNatRules(client, ruleType, buildNatRulesQueryParams(d))
+	if err != nil {
+		return diag.Errorf("error retrieving %s rules: %s", ruleType, err)
+	}
+
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, pathSearch("id", rule, "").(string))
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil, d.Set("region", region))
+	if ruleType == "snat" {
+		mErr = multierror.Append(mErr,
+			d.Set("snat_rules", flattenNatSnatRules(rules)),
+			d.Set("dnat_rules", nil),
+		)
+	} else {
+		mErr = multierror.Append(mErr,
+			d.Set("snat_rules", nil),
+			d.Set("dnat_rules", flattenNatDnatRules(rules)),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting NAT rules fields: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNatRulesDataSource_basic(t *testing.T) {
+	randSuffix := acctest.RandString(5)
+	dataSourceName := "data.sbercloud_nat_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatRulesDataSource_basic(randSuffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "snat_rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "dnat_rules.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snat_rules.0.id",
+						"sbercloud_nat_snat_rule.snat_1", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snat_rules.0.floating_ip_address",
+						"sbercloud_vpc_eip.eip_1", "address"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "snat_rules.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNatRulesDataSource_basic(suffix string) string {
+	return fmt.Sprintf(`
+%s
+
+data "sbercloud_nat_rules" "test" {
+  gateway_id = sbercloud_nat_gateway.nat_1.id
+  rule_type  = "snat"
+
+  depends_on = [sbercloud_nat_snat_rule.snat_1]
+}
+`, testAccNatV2SnatRule_basic(suffix))
+}
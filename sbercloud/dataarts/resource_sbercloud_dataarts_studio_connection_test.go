@@ -0,0 +1,98 @@
+package dataarts
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getConnectionResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "dataarts", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DataArts Studio client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("data-connections", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders: map[string]string{
+			"workspace": state.Primary.Attributes["workspace_id"],
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccDataArtsStudioConnection_basic(t *testing.T) {
+	var connection interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_dataarts_studio_connection.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&connection,
+		getConnectionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataArtsStudioConnection_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "connection_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "DLI"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id",
+						"sbercloud_dataarts_studio_workspace.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccDataArtsStudioConnectionImportStateFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+		},
+	})
+}
+
+func testAccDataArtsStudioConnectionImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDataArtsStudioConnection_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dataarts_studio_connection" "test" {
+  workspace_id    = sbercloud_dataarts_studio_workspace.test.id
+  connection_name = "%s"
+  connection_type = "DLI"
+  description     = "created by acc test"
+
+  config = jsonencode({
+    "user"     = "admin"
+    "password" = "Test@12345"
+  })
+}
+`, testAccDataArtsStudioWorkspace_basic(rName, "created by acc test"), rName)
+}
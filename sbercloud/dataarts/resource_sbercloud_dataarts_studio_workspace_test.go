@@ -0,0 +1,117 @@
+package dataarts
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getWorkspaceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "dataarts", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DataArts Studio client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("workspaces", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccDataArtsStudioWorkspace_basic(t *testing.T) {
+	var workspace interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_dataarts_studio_workspace.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&workspace,
+		getWorkspaceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataArtsStudioWorkspace_basic(rName, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccDataArtsStudioWorkspace_basic(rName+"-update", "updated by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataArtsStudioWorkspace_base(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  vpc_id     = sbercloud_vpc.test.id
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "sbercloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+`, rName)
+}
+
+func testAccDataArtsStudioWorkspace_basic(rName, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_dataarts_studio_workspace" "test" {
+  name              = "%[2]s"
+  description       = "%[3]s"
+  vpc_id            = sbercloud_vpc.test.id
+  subnet_id         = sbercloud_vpc_subnet.test.id
+  security_group_id = sbercloud_networking_secgroup.test.id
+
+  resource_spec {
+    spec_code = "dayu.starter"
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccDataArtsStudioWorkspace_base(rName), rName, description)
+}
@@ -0,0 +1,111 @@
+package dbss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAuditRuleResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "dbss", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DBSS client: %s", err)
+	}
+
+	listPath := c.ServiceURL(state.Primary.Attributes["instance_id"], "audit", "rule", "risk")
+	resp, err := c.Request("GET", listPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := utils.PathSearch(fmt.Sprintf("rules[?id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func TestAccDbssAuditRule_basic(t *testing.T) {
+	var rule interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_dbss_audit_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&rule,
+		getAuditRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDbssInstanceId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbssAuditRule_basic(rName, "log", "LOW"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "sensitive_exception"),
+					resource.TestCheckResourceAttr(resourceName, "action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "risk_level", "LOW"),
+				),
+			},
+			{
+				Config: testAccDbssAuditRule_basic(rName+"-update", "block", "HIGH"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+					resource.TestCheckResourceAttr(resourceName, "action", "block"),
+					resource.TestCheckResourceAttr(resourceName, "risk_level", "HIGH"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDbssAuditRuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccDbssAuditRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", resourceName, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDbssAuditRule_basic(rName, action, riskLevel string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_dbss_audit_rule" "test" {
+  instance_id = "%[1]s"
+  name        = "%[2]s"
+  type        = "sensitive_exception"
+  action      = "%[3]s"
+  rank_id     = 1
+  risk_level  = "%[4]s"
+}
+`, acceptance.SBC_DBSS_INSTANCE_ID, rName, action, riskLevel)
+}
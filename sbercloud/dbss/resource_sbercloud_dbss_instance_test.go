@@ -0,0 +1,128 @@
+package dbss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getInstanceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "dbss", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DBSS client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("dbss", "audit", "instances"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := utils.PathSearch(fmt.Sprintf("servers[?id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if instance == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func TestAccDbssInstance_basic(t *testing.T) {
+	var instance interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_dbss_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDbssFlavor(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbssInstance_basic(rName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "foo"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+				),
+			},
+			{
+				Config: testAccDbssInstance_basic(rName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"flavor", "charging_mode", "period_unit", "period", "auto_renew",
+				},
+			},
+		},
+	})
+}
+
+func testAccDbssInstance_basic(rName, tagValue string) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/20"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  vpc_id     = sbercloud_vpc.test.id
+  gateway_ip = "192.168.0.1"
+}
+
+resource "sbercloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+
+resource "sbercloud_dbss_instance" "test" {
+  name              = "%[1]s"
+  description       = "created by acceptance test"
+  flavor            = "%[2]s"
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  vpc_id            = sbercloud_vpc.test.id
+  subnet_id         = sbercloud_vpc_subnet.test.id
+  security_group_id = sbercloud_networking_secgroup.test.id
+  period_unit       = "month"
+  period            = 1
+  auto_renew        = "false"
+
+  tags = {
+    key = "%[3]s"
+  }
+}
+`, rName, acceptance.SBC_DBSS_FLAVOR, tagValue)
+}
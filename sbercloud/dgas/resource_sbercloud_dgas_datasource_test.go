@@ -0,0 +1,95 @@
+package dgas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDatasourceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "dgas", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DGAS client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("datasources", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccDgasDatasource_basic(t *testing.T) {
+	var datasource interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_dgas_datasource.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&datasource,
+		getDatasourceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDgasDatasource_basic(rName, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "OBS"),
+					resource.TestCheckResourceAttrPair(resourceName, "location.0.obs_bucket",
+						"sbercloud_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.obs_object_path", "graph/"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+				),
+			},
+			{
+				Config: testAccDgasDatasource_basic(rName, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDgasDatasource_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_dgas_datasource" "test" {
+  name        = "%[1]s"
+  type        = "OBS"
+  description = "%[2]s"
+
+  location {
+    obs_bucket      = sbercloud_obs_bucket.test.bucket
+    obs_object_path = "graph/"
+  }
+}
+`, rName, description)
+}
@@ -0,0 +1,96 @@
+package dgas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getJobResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "dgas", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DGAS client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("jobs", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccDgasJob_basic(t *testing.T) {
+	var job interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_dgas_job.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&job,
+		getJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDgasGraphData(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		// the finished jobs are kept by DGAS, so there is nothing to check after the resource is destroyed
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDgasJob_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "pagerank"),
+					resource.TestCheckResourceAttrPair(resourceName, "datasource_id",
+						"sbercloud_dgas_datasource.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "success"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDgasJob_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_dgas_datasource" "test" {
+  name = "%[1]s"
+  type = "OBS"
+
+  location {
+    obs_bucket      = "%[2]s"
+    obs_object_path = "%[3]s"
+  }
+}
+
+resource "sbercloud_dgas_job" "test" {
+  name          = "%[1]s"
+  algorithm     = "pagerank"
+  datasource_id = sbercloud_dgas_datasource.test.id
+
+  parameters = {
+    alpha       = "0.85"
+    convergence = "0.00001"
+  }
+
+  output {
+    obs_bucket      = "%[2]s"
+    obs_object_path = "output/%[1]s/"
+  }
+}
+`, rName, acceptance.SBC_DGAS_OBS_BUCKET, acceptance.SBC_DGAS_OBS_PATH)
+}
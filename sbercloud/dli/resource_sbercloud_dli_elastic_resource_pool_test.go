@@ -0,0 +1,98 @@
+package dli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getElasticResourcePoolResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "dliv3", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DLI v3 client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("elastic-resource-pools")+"?name="+state.Primary.ID,
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := utils.PathSearch(fmt.Sprintf("elastic_resource_pools[?elastic_resource_pool_name=='%s'] | [0]",
+		state.Primary.ID), respBody, nil)
+	if pool == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return pool, nil
+}
+
+func TestAccDliElasticResourcePool_basic(t *testing.T) {
+	var pool interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_dli_elastic_resource_pool.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&pool,
+		getElasticResourcePoolResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliElasticResourcePool_basic(rName, "Created by terraform", 64, 64),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "min_cu", "64"),
+					resource.TestCheckResourceAttr(resourceName, "max_cu", "64"),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "cidr"),
+				),
+			},
+			{
+				Config: testAccDliElasticResourcePool_basic(rName, "Updated by terraform", 64, 128),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "max_cu", "128"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDliElasticResourcePool_basic(rName, description string, minCu, maxCu int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_dli_elastic_resource_pool" "test" {
+  name        = "%s"
+  description = "%s"
+  min_cu      = %d
+  max_cu      = %d
+}
+`, rName, description, minCu, maxCu)
+}
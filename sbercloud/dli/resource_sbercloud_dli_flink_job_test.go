@@ -0,0 +1,103 @@
+package dli
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/dli/v1/flinkjob"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getFlinkJobResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.DliV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DLI v1 client: %s", err)
+	}
+	jobID, err := strconv.Atoi(state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	return flinkjob.Get(c, jobID)
+}
+
+func TestAccDliFlinkJob_basic(t *testing.T) {
+	var job flinkjob.GetJobResp
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_dli_flink_job.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&job,
+		getFlinkJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliFlinkJob_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "flink_opensource_sql_job"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_name", "sbercloud_dli_queue.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "cu_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manager_cu_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "job_running"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDliFlinkJob_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_dli_queue" "test" {
+  name          = "%[1]s"
+  queue_type    = "general"
+  cu_count      = 16
+  resource_mode = 1
+}
+
+resource "sbercloud_dli_flink_job" "test" {
+  name              = "%[1]s"
+  description       = "Created by terraform"
+  type              = "flink_opensource_sql_job"
+  run_mode          = "exclusive_cluster"
+  queue_name        = sbercloud_dli_queue.test.name
+  cu_number         = 2
+  manager_cu_number = 1
+
+  sql = <<SQL
+CREATE TABLE orders (
+  order_id STRING,
+  price INT
+) WITH (
+  'connector' = 'datagen',
+  'rows-per-second' = '1'
+);
+
+CREATE TABLE print_sink (
+  order_id STRING,
+  price INT
+) WITH (
+  'connector' = 'print'
+);
+
+INSERT INTO print_sink SELECT * FROM orders;
+SQL
+}
+`, rName)
+}
@@ -76,3 +76,59 @@ resource "sbercloud_dli_queue" "test" {
 }
 `, rName, cuCount)
 }
+
+func TestAccDliQueue_elasticResourcePool(t *testing.T) {
+	rName := act.RandomAccResourceName()
+	resourceName := "sbercloud_dli_queue.test"
+
+	var obj queues.CreateOpts
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDliQueueResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { act.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliQueue_elasticResourcePool(rName, 16, 32),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "elastic_resource_pool_name",
+						"sbercloud_dli_elastic_resource_pool.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "min_cu", "16"),
+					resource.TestCheckResourceAttr(resourceName, "max_cu", "32"),
+					resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
+				),
+			},
+			{
+				Config: testAccDliQueue_elasticResourcePool(rName, 16, 64),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "max_cu", "64"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDliQueue_elasticResourcePool(rName string, minCu, maxCu int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_dli_elastic_resource_pool" "test" {
+  name   = "%[1]s"
+  min_cu = 64
+  max_cu = 64
+}
+
+resource "sbercloud_dli_queue" "test" {
+  name                       = "%[1]s"
+  cu_count                   = 16
+  elastic_resource_pool_name = sbercloud_dli_elastic_resource_pool.test.name
+  min_cu                     = %[2]d
+  max_cu                     = %[3]d
+}
+`, rName, minCu, maxCu)
+}
@@ -0,0 +1,101 @@
+package dns
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dns/v2/zones"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getZoneVpcAssociationResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.DnsWithRegionClient(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud DNS client: %s", err)
+	}
+
+	zoneID := state.Primary.Attributes["zone_id"]
+	vpcID := state.Primary.Attributes["vpc_id"]
+	zone, err := zones.Get(c, zoneID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for _, router := range zone.Routers {
+		if router.RouterID == vpcID {
+			return router, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func TestAccDNSZoneVpcAssociation_basic(t *testing.T) {
+	var router zones.RouterResult
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_dns_zone_vpc_association.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&router,
+		getZoneVpcAssociationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneVpcAssociation_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "sbercloud_dns_zone.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test.1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_region", acceptance.SBC_REGION_NAME),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDNSZoneVpcAssociation_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+  cidr = "192.168.${count.index}.0/24"
+}
+
+resource "sbercloud_dns_zone" "test" {
+  name      = "%[1]s.com."
+  email     = "email@example.com"
+  zone_type = "private"
+
+  router {
+    router_id = sbercloud_vpc.test[0].id
+  }
+
+  lifecycle {
+    ignore_changes = [router]
+  }
+}
+
+resource "sbercloud_dns_zone_vpc_association" "test" {
+  zone_id = sbercloud_dns_zone.test.id
+  vpc_id  = sbercloud_vpc.test[1].id
+}
+`, rName)
+}
@@ -0,0 +1,81 @@
+package dss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/evs/v2/cloudvolumes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDiskResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.BlockStorageV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud EVS v2 client: %s", err)
+	}
+	return cloudvolumes.Get(c, state.Primary.ID).Extract()
+}
+
+func TestAccDssDisk_basic(t *testing.T) {
+	var volume cloudvolumes.Volume
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_dss_disk.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&volume,
+		getDiskResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDssStorageId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDssDisk_basic(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "storage_id", acceptance.SBC_DSS_STORAGE_ID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "size", "20"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id", resourceName, "id"),
+				),
+			},
+			{
+				Config: testAccDssDisk_basic(rName+"-update", 40),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+					resource.TestCheckResourceAttr(resourceName, "size", "40"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDssDisk_basic(rName string, size int) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_dss_disk" "test" {
+  storage_id        = "%[1]s"
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  type              = "SAS"
+  name              = "%[2]s"
+  size              = %[3]d
+}
+`, acceptance.SBC_DSS_STORAGE_ID, rName, size)
+}
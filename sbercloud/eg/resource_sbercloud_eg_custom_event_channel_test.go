@@ -0,0 +1,82 @@
+package eg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getEgResourceFunc(path string) acceptance.ServiceFunc {
+	return func(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+		c, err := sbercloud.NewServiceClient(conf, "eg", acceptance.SBC_REGION_NAME)
+		if err != nil {
+			return nil, fmt.Errorf("error creating SberCloud EG client: %s", err)
+		}
+
+		resp, err := c.Request("GET", c.ServiceURL(path, state.Primary.ID), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return utils.FlattenResponse(resp)
+	}
+}
+
+func TestAccEgCustomEventChannel_basic(t *testing.T) {
+	var channel interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_eg_custom_event_channel.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&channel,
+		getEgResourceFunc("channels"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEgCustomEventChannel_basic(rName, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccEgCustomEventChannel_basic(rName, "updated by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEgCustomEventChannel_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_eg_custom_event_channel" "test" {
+  name        = "%s"
+  description = "%s"
+}
+`, rName, description)
+}
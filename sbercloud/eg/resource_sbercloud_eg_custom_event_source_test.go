@@ -0,0 +1,65 @@
+package eg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccEgCustomEventSource_basic(t *testing.T) {
+	var source interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_eg_custom_event_source.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&source,
+		getEgResourceFunc("sources"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEgCustomEventSource_basic(rName, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id",
+						"sbercloud_eg_custom_event_channel.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "APPLICATION"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+				),
+			},
+			{
+				Config: testAccEgCustomEventSource_basic(rName, "updated by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEgCustomEventSource_basic(rName, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_eg_custom_event_source" "test" {
+  channel_id  = sbercloud_eg_custom_event_channel.test.id
+  name        = "%[2]s"
+  description = "%[3]s"
+}
+`, testAccEgCustomEventChannel_basic(rName, ""), rName, description)
+}
@@ -0,0 +1,86 @@
+package eg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccEgEventSubscription_basic(t *testing.T) {
+	var subscription interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_eg_event_subscription.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&subscription,
+		getEgResourceFunc("subscriptions"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEgEventSubscription_basic(rName, "https://example.com/events"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "targets.0.id"),
+				),
+			},
+			{
+				Config: testAccEgEventSubscription_basic(rName, "https://example.com/events/update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEgEventSubscription_basic(rName, url string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_eg_event_subscription" "test" {
+  channel_id = sbercloud_eg_custom_event_channel.test.id
+  name       = "%[2]s"
+
+  sources {
+    name          = sbercloud_eg_custom_event_source.test.name
+    provider_type = "CUSTOM"
+    filter_rule   = jsonencode({
+      source = [{
+        op     = "StringIn"
+        values = [sbercloud_eg_custom_event_source.test.name]
+      }]
+    })
+  }
+
+  targets {
+    name          = "HTTPS"
+    provider_type = "CUSTOM"
+    operation     = jsonencode({
+      url = "%[3]s"
+    })
+    event_data_filter_rule = jsonencode({
+      type = "ORIGINAL"
+    })
+  }
+}
+`, testAccEgCustomEventSource_basic(rName, ""), rName, url)
+}
@@ -0,0 +1,206 @@
+package sbercloud
+
+import (
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// serviceCatalog defines an API category which is not provided by the config package.
+// The endpoint looks like https://{Name}.{Region}.hc.sbercloud.ru/{Version}/{project_id}/
+// and the endpoint of the global service omits the region.
+type serviceCatalog struct {
+	Name             string
+	Version          string
+	WithOutProjectID bool
+	Global           bool
+}
+
+var sberServiceCatalog = map[string]serviceCatalog{
+	// the aom catalog of the config package points to the v1 API of the service discovery
+	"aomv2": {
+		Name:    "aom",
+		Version: "v2",
+	},
+	"apm": {
+		Name:             "apm",
+		Version:          "v1/apm2/openapi",
+		WithOutProjectID: true,
+	},
+	"asm": {
+		Name:             "asm",
+		Version:          "v1",
+		WithOutProjectID: true,
+	},
+	// the bandwidth scaling policies are only provided by the AS v2 API
+	"asv2": {
+		Name:    "as",
+		Version: "autoscaling-api/v2",
+	},
+	// the config package provides no catalog for CBH
+	"cbh": {
+		Name:    "cbh",
+		Version: "v2",
+	},
+	// the autopilot clusters are managed by a separate API of CCE, which is not the cce catalog of the config package
+	"cce_autopilot": {
+		Name:    "cce",
+		Version: "autopilot/v3/projects",
+	},
+	// the Cloud Native Anti-DDoS API is provided by the global AAD service, the config package provides no catalog
+	"cnad": {
+		Name:             "aad",
+		Version:          "v1",
+		WithOutProjectID: true,
+		Global:           true,
+	},
+	"codearts_project": {
+		Name:             "projectman-ext",
+		Version:          "v4",
+		WithOutProjectID: true,
+	},
+	// the API version of CodeHub differs between the operations
+	"codearts_repository": {
+		Name:             "codehub-ext",
+		WithOutProjectID: true,
+	},
+	"codearts_pipeline": {
+		Name:             "cloudpipeline-ext",
+		Version:          "v5",
+		WithOutProjectID: true,
+	},
+	"dataarts": {
+		Name:    "dayu",
+		Version: "v1",
+	},
+	// the config package provides no catalog for DBSS
+	"dbss": {
+		Name:    "dbss",
+		Version: "v1",
+	},
+	"dgas": {
+		Name:    "dgas",
+		Version: "v1",
+	},
+	// the elastic resource pools are only provided by the DLI v3 API
+	"dliv3": {
+		Name:    "dli",
+		Version: "v3",
+	},
+	"dss": {
+		Name:    "dss",
+		Version: "v1",
+	},
+	"eg": {
+		Name:    "eg",
+		Version: "v1",
+	},
+	// the GES v2 API is not provided by the ges catalog of the config package, which is v1.0
+	"gesv2": {
+		Name:    "ges",
+		Version: "v2",
+	},
+	// the config package provides no catalog for HSS
+	"hss": {
+		Name:    "hss",
+		Version: "v5",
+	},
+	// the image sharing API is only provided by the IMS v1 API, the ims catalog of the config package is v2
+	"imsv1": {
+		Name:             "ims",
+		Version:          "v1",
+		WithOutProjectID: true,
+	},
+	"ivs": {
+		Name:    "ivs",
+		Version: "v2.0",
+	},
+	// the key pairs of DEW are managed by KPS, which is not the kms catalog of the config package
+	"kps": {
+		Name:    "kps",
+		Version: "v3",
+	},
+	// the tickets of the Operator Service Center are managed by the global OSM service
+	"osm": {
+		Name:             "osm",
+		Version:          "v2",
+		WithOutProjectID: true,
+		Global:           true,
+	},
+	"roma": {
+		Name:    "roma",
+		Version: "v2",
+	},
+	"rms": {
+		Name:             "rms",
+		Version:          "v1",
+		WithOutProjectID: true,
+		Global:           true,
+	},
+	// the config package provides no catalog for SDRS
+	"sdrs": {
+		Name:    "sdrs",
+		Version: "v1",
+	},
+	"secmaster": {
+		Name:    "secmaster",
+		Version: "v1",
+	},
+	// the config package provides no catalog for SIS
+	"sis": {
+		Name:    "sis",
+		Version: "v1",
+	},
+	"ucs": {
+		Name:             "ucs",
+		Version:          "v1",
+		WithOutProjectID: true,
+		Global:           true,
+	},
+	"workspace": {
+		Name:    "workspace",
+		Version: "v2",
+	},
+	// the flow log API of VPC v1 requires the project ID, unlike the vpc catalog of the config package
+	"vpc_flow_log": {
+		Name:    "vpc",
+		Version: "v1",
+	},
+}
+
+// NewServiceClient returns a ServiceClient for the specified catalog key. The keys which are not defined in
+// sberServiceCatalog are delegated to config.NewServiceClient.
+func NewServiceClient(c *config.Config, srv, region string) (*golangsdk.ServiceClient, error) {
+	catalog, ok := sberServiceCatalog[srv]
+	if !ok {
+		return c.NewServiceClient(srv, region)
+	}
+
+	// the ECS client is only used to resolve the project ID of the region
+	base, err := c.NewServiceClient("ecs", region)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &golangsdk.ServiceClient{
+		ProviderClient: base.ProviderClient,
+	}
+	if endpoint, ok := c.Endpoints[srv]; ok {
+		sc.Endpoint = endpoint
+	} else if catalog.Global {
+		sc.Endpoint = fmt.Sprintf("https://%s.%s/", catalog.Name, c.Cloud)
+	} else {
+		sc.Endpoint = fmt.Sprintf("https://%s.%s.%s/", catalog.Name, region, c.Cloud)
+	}
+
+	sc.ResourceBase = sc.Endpoint
+	if catalog.Version != "" {
+		sc.ResourceBase = sc.ResourceBase + catalog.Version + "/"
+	}
+	if !catalog.WithOutProjectID {
+		sc.ResourceBase = sc.ResourceBase + sc.ProjectID + "/"
+	}
+
+	return sc, nil
+}
@@ -48,6 +48,7 @@ func TestAccEnterpriseProject_basic(t *testing.T) {
 					rc.CheckResourceExists(),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "description", "terraform test"),
+					resource.TestCheckResourceAttr(resourceName, "type", "poc"),
 					resource.TestCheckResourceAttr(resourceName, "status", "1"),
 				),
 			},
@@ -97,6 +98,7 @@ func testAccEnterpriseProject_basic(rName string) string {
 resource "sbercloud_enterprise_project" "test" {
   name        = "%s"
   description = "terraform test"
+  type        = "poc"
 }`, rName)
 }
 
@@ -105,5 +107,6 @@ func testAccEnterpriseProject_update(rName string) string {
 resource "sbercloud_enterprise_project" "test" {
   name        = "%s"
   description = "terraform test update"
+  type        = "poc"
 }`, rName)
 }
@@ -0,0 +1,93 @@
+package hss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getHostGroupResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "hss", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud HSS client: %s", err)
+	}
+
+	listPath := c.ServiceURL("host-management", "groups") + "?limit=200&enterprise_project_id=all_granted_eps"
+	resp, err := c.Request("GET", listPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	group := utils.PathSearch(fmt.Sprintf("data_list[?group_id=='%s']|[0]", state.Primary.ID), respBody, nil)
+	if group == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return group, nil
+}
+
+func TestAccHssHostGroup_basic(t *testing.T) {
+	var group interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_hss_host_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&group,
+		getHostGroupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckHssHostId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHssHostGroup_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "host_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "host_num", "1"),
+				),
+			},
+			{
+				Config: testAccHssHostGroup_basic(rName + "_update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccHssHostGroup_basic(name string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_hss_host_group" "test" {
+  name     = "%s"
+  host_ids = ["%s"]
+}
+`, name, acceptance.SBC_HSS_HOST_ID)
+}
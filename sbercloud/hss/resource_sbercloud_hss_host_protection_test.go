@@ -0,0 +1,106 @@
+package hss
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getHostProtectionResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "hss", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud HSS client: %s", err)
+	}
+
+	getPath := c.ServiceURL("host-management", "hosts") +
+		fmt.Sprintf("?host_id=%s&enterprise_project_id=all_granted_eps", state.Primary.ID)
+	resp, err := c.Request("GET", getPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	host := utils.PathSearch("data_list|[0]", respBody, nil)
+	if version := utils.PathSearch("version", host, "").(string); version == "" || version == "hss.version.null" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return host, nil
+}
+
+func TestAccHssHostProtection_basic(t *testing.T) {
+	var host interface{}
+
+	resourceName := "sbercloud_hss_host_protection.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&host,
+		getHostProtectionResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckHssHostId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHssHostProtection_basic("hss.version.basic"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "host_id", acceptance.SBC_HSS_HOST_ID),
+					resource.TestCheckResourceAttr(resourceName, "version", "hss.version.basic"),
+					resource.TestCheckResourceAttr(resourceName, "charging_mode", "postPaid"),
+					resource.TestCheckResourceAttrSet(resourceName, "host_name"),
+				),
+			},
+			{
+				Config: testAccHssHostProtection_basic("hss.version.enterprise"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "version", "hss.version.enterprise"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccHssHostProtectionImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccHssHostProtectionImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["enterprise_project_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccHssHostProtection_basic(version string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_hss_host_protection" "test" {
+  host_id       = "%s"
+  version       = "%s"
+  charging_mode = "postPaid"
+}
+`, acceptance.SBC_HSS_HOST_ID, version)
+}
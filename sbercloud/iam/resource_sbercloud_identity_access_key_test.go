@@ -57,6 +57,8 @@ func TestAccIdentityAccessKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "status", "active"),
 					resource.TestCheckResourceAttr(resourceName, "description", "access key by terraform"),
 					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_key_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_access_key"),
 				),
 			},
 			{
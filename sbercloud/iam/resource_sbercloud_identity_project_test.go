@@ -50,12 +50,14 @@ func TestAccIdentityV3Project_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "A project"),
 					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
 					resource.TestCheckResourceAttrSet(resourceName, "parent_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_id"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
 			{
 				Config: testAccIdentityV3Project_update(projectName),
@@ -63,7 +65,7 @@ func TestAccIdentityV3Project_basic(t *testing.T) {
 					rc.CheckResourceExists(),
 					resource.TestCheckResourceAttrPtr(resourceName, "name", &project.Name),
 					resource.TestCheckResourceAttr(resourceName, "description", "An updated project"),
-					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "parent_id"),
 				),
 			},
@@ -85,6 +87,7 @@ func testAccIdentityV3Project_update(projectName string) string {
 resource "sbercloud_identity_project" "project_1" {
   name        = "%s_%s"
   description = "An updated project"
+  enabled     = false
 }
 `, acceptance.SBC_REGION_NAME, projectName)
 }
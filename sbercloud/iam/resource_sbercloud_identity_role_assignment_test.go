@@ -39,6 +39,11 @@ func TestAccIdentityV3RoleAssignment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "project_id", acceptance.SBC_PROJECT_ID),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccIdentityV3RoleAssignment_domain(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -48,6 +53,43 @@ func TestAccIdentityV3RoleAssignment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "domain_id", acceptance.SBC_DOMAIN_ID),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityV3RoleAssignment_epsID(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_identity_role_assignment.role_assignment_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckAdminOnly(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityV3RoleAssignment_epsID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "group_id",
+						"sbercloud_identity_group.group_1", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_id",
+						"data.sbercloud_identity_role.role_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id",
+						acceptance.SBC_ENTERPRISE_PROJECT_ID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -170,3 +212,21 @@ resource "sbercloud_identity_role_assignment" "role_assignment_1" {
 }
 `, rName, acceptance.SBC_DOMAIN_ID)
 }
+
+func testAccIdentityV3RoleAssignment_epsID(rName string) string {
+	return fmt.Sprintf(`
+data "sbercloud_identity_role" "role_1" {
+  name = "rds_adm"
+}
+
+resource "sbercloud_identity_group" "group_1" {
+  name = "%s"
+}
+
+resource "sbercloud_identity_role_assignment" "role_assignment_1" {
+  role_id               = data.sbercloud_identity_role.role_1.id
+  group_id              = sbercloud_identity_group.group_1.id
+  enterprise_project_id = "%s"
+}
+`, rName, acceptance.SBC_ENTERPRISE_PROJECT_ID)
+}
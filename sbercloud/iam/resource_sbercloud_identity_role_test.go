@@ -2,6 +2,7 @@ package iam
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
@@ -50,6 +51,9 @@ func TestAccIdentityRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", roleName),
 					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
 					resource.TestCheckResourceAttr(resourceName, "type", "AX"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", roleName),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog"),
+					resource.TestCheckResourceAttrSet(resourceName, "flag"),
 				),
 			},
 			{
@@ -107,6 +111,24 @@ func TestAccIdentityRole_agency(t *testing.T) {
 	})
 }
 
+func TestAccIdentityRole_invalidPolicy(t *testing.T) {
+	var roleName = acceptance.RandomAccResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityRole_invalidPolicy(roleName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must contain the Statement key`),
+			},
+		},
+	})
+}
+
 func testAccIdentityRole_basic(roleName string) string {
 	return fmt.Sprintf(`
 resource "sbercloud_identity_role" test {
@@ -194,3 +216,18 @@ EOF
 }
 `, roleName)
 }
+
+func testAccIdentityRole_invalidPolicy(roleName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_identity_role" test {
+  name        = "%s"
+  description = "created by terraform"
+  type        = "AX"
+  policy      = <<EOF
+{
+  "Version": "1.1"
+}
+EOF
+}
+`, roleName)
+}
@@ -0,0 +1,54 @@
+package ivs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccIvsStandard_basic(t *testing.T) {
+	resourceName := "sbercloud_ivs_standard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckIvsImages(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIvsStandard_basic("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "pass"),
+					resource.TestCheckResourceAttrSet(resourceName, "score"),
+					resource.TestCheckResourceAttr(resourceName, "comparison_result.#", "1"),
+				),
+			},
+			{
+				// the refresh must not submit the verification again
+				Config:   testAccIvsStandard_basic("1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccIvsStandard_basic("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_refresh", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "pass"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIvsStandard_basic(refresh string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_ivs_standard" "test" {
+  name              = "Ivan Ivanov"
+  id_card_front_url = "%s"
+  face_image_url    = "%s"
+  force_refresh     = "%s"
+}
+`, acceptance.SBC_IVS_ID_CARD_FRONT_URL, acceptance.SBC_IVS_FACE_IMAGE_URL, refresh)
+}
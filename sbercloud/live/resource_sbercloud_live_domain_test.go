@@ -0,0 +1,106 @@
+package live
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/live/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDomainResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcLiveV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud Live client: %s", err)
+	}
+
+	return client.ShowDomain(&model.ShowDomainRequest{Domain: &state.Primary.ID})
+}
+
+func TestAccLiveDomain_basic(t *testing.T) {
+	var obj model.ShowDomainResponse
+
+	pushDomainName := fmt.Sprintf("%s.example.com", acceptance.RandomAccResourceNameWithDash())
+	pullDomainName := fmt.Sprintf("%s.example.com", acceptance.RandomAccResourceNameWithDash())
+	pushResourceName := "sbercloud_live_domain.ingestDomain"
+	pullResourceName := "sbercloud_live_domain.streamingDomain"
+
+	rc := acceptance.InitResourceCheck(
+		pushResourceName,
+		&obj,
+		getDomainResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDomain_basic(pushDomainName, pullDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(pushResourceName, "domain", pushDomainName),
+					resource.TestCheckResourceAttr(pushResourceName, "domain_type", "push"),
+					resource.TestCheckResourceAttr(pushResourceName, "app_name", "live"),
+					resource.TestCheckResourceAttr(pushResourceName, "status", "on"),
+					resource.TestCheckResourceAttrSet(pushResourceName, "created_at"),
+					resource.TestCheckResourceAttr(pullResourceName, "domain", pullDomainName),
+					resource.TestCheckResourceAttr(pullResourceName, "domain_type", "pull"),
+					resource.TestCheckResourceAttr(pullResourceName, "status", "on"),
+					resource.TestCheckResourceAttr(pullResourceName, "ingest_domain_name", pushDomainName),
+				),
+			},
+			{
+				Config: testDomain_basic_update(pushDomainName, pullDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(pushResourceName, "domain", pushDomainName),
+					resource.TestCheckResourceAttr(pushResourceName, "domain_type", "push"),
+					resource.TestCheckResourceAttr(pushResourceName, "status", "on"),
+					resource.TestCheckResourceAttr(pullResourceName, "domain", pullDomainName),
+					resource.TestCheckResourceAttr(pullResourceName, "domain_type", "pull"),
+					resource.TestCheckResourceAttr(pullResourceName, "status", "off"),
+					resource.TestCheckResourceAttr(pullResourceName, "ingest_domain_name", ""),
+				),
+			},
+			{
+				ResourceName:      pullResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDomain_basic(pushDomain, pullDomain string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_live_domain" "ingestDomain" {
+  domain      = "%s"
+  domain_type = "push"
+}
+
+resource "sbercloud_live_domain" "streamingDomain" {
+  domain             = "%s"
+  domain_type        = "pull"
+  ingest_domain_name = sbercloud_live_domain.ingestDomain.domain
+}
+`, pushDomain, pullDomain)
+}
+
+func testDomain_basic_update(pushDomain, pullDomain string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_live_domain" "ingestDomain" {
+  domain      = "%s"
+  domain_type = "push"
+}
+
+resource "sbercloud_live_domain" "streamingDomain" {
+  domain      = "%s"
+  domain_type = "pull"
+  status      = "off"
+}
+`, pushDomain, pullDomain)
+}
@@ -0,0 +1,115 @@
+package live
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/live/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getRecordingResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcLiveV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud Live client: %s", err)
+	}
+	return client.ShowRecordRule(&model.ShowRecordRuleRequest{Id: state.Primary.ID})
+}
+
+func TestAccLiveRecordConfig_basic(t *testing.T) {
+	var obj model.ShowRecordRuleResponse
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	pushDomainName := fmt.Sprintf("%s.example.com", name)
+	rName := "sbercloud_live_record_config.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRecordingResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRecording_basic(pushDomainName, name, "MP4", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "domain", pushDomainName),
+					resource.TestCheckResourceAttr(rName, "app_name", "live"),
+					resource.TestCheckResourceAttr(rName, "stream_name", "*"),
+					resource.TestCheckResourceAttr(rName, "type.0.record_type", "CONTINUOUS_RECORD"),
+					resource.TestCheckResourceAttr(rName, "type.0.obs_addr.0.location", acceptance.SBC_REGION_NAME),
+					resource.TestCheckResourceAttrPair(rName, "type.0.obs_addr.0.bucket",
+						"sbercloud_obs_bucket.bucket", "bucket"),
+					resource.TestCheckResourceAttr(rName, "type.0.record_format", "MP4"),
+					resource.TestCheckResourceAttr(rName, "type.0.record_cycle", "7200"),
+					resource.TestCheckResourceAttrSet(rName, "type.0.record_prefix"),
+				),
+			},
+			{
+				Config: testRecording_basic(pushDomainName, name, "HLS", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "domain", pushDomainName),
+					resource.TestCheckResourceAttr(rName, "type.0.record_format", "HLS"),
+					resource.TestCheckResourceAttr(rName, "type.0.record_cycle", "3600"),
+					resource.TestCheckResourceAttrSet(rName, "type.0.record_prefix"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLiveObs(obsName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "bucket" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+
+  lifecycle {
+    ignore_changes = [
+      cors_rule,
+    ]
+  }
+}
+`, obsName)
+}
+
+func testRecording_basic(pushDomainName, obsName, format string, cycle int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_live_domain" "ingestDomain" {
+  domain      = "%[2]s"
+  domain_type = "push"
+}
+
+resource "sbercloud_live_record_config" "test" {
+  domain   = sbercloud_live_domain.ingestDomain.domain
+  app_name = "live"
+
+  type {
+    record_type   = "CONTINUOUS_RECORD"
+    record_format = "%[3]s"
+    record_cycle  = %[4]d
+
+    obs_addr {
+      location = sbercloud_obs_bucket.bucket.region
+      bucket   = sbercloud_obs_bucket.bucket.bucket
+    }
+  }
+}
+`, testAccLiveObs(obsName), pushDomainName, format, cycle)
+}
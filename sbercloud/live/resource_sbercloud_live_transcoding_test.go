@@ -0,0 +1,132 @@
+package live
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/live/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getTranscodingResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcLiveV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud Live client: %s", err)
+	}
+
+	domain := state.Primary.Attributes["domain_name"]
+	appName := state.Primary.Attributes["app_name"]
+	return client.ShowTranscodingsTemplate(&model.ShowTranscodingsTemplateRequest{
+		Domain:  domain,
+		AppName: &appName,
+	})
+}
+
+func TestAccLiveTranscoding_basic(t *testing.T) {
+	var obj model.ShowTranscodingsTemplateResponse
+
+	pushDomainName := fmt.Sprintf("%s.example.com", acceptance.RandomAccResourceNameWithDash())
+	rName := "sbercloud_live_transcoding.test"
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getTranscodingResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testTranscoding_basic(pushDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "domain", pushDomainName),
+					resource.TestCheckResourceAttr(rName, "app_name", "live"),
+					resource.TestCheckResourceAttr(rName, "quality.#", "1"),
+					resource.TestCheckResourceAttr(rName, "quality.0.name", "t1"),
+					resource.TestCheckResourceAttr(rName, "quality.0.codecs", "H264"),
+					resource.TestCheckResourceAttr(rName, "quality.0.width", "300"),
+					resource.TestCheckResourceAttr(rName, "quality.0.height", "400"),
+					resource.TestCheckResourceAttr(rName, "quality.0.bitrate", "300"),
+					resource.TestCheckResourceAttr(rName, "quality.0.protocol", "RTMP"),
+				),
+			},
+			{
+				Config: testTranscoding_update(pushDomainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "domain", pushDomainName),
+					resource.TestCheckResourceAttr(rName, "app_name", "live"),
+					resource.TestCheckResourceAttr(rName, "quality.#", "2"),
+					resource.TestCheckResourceAttr(rName, "quality.1.name", "t2"),
+					resource.TestCheckResourceAttr(rName, "quality.1.codecs", "H265"),
+					resource.TestCheckResourceAttr(rName, "quality.1.width", "640"),
+					resource.TestCheckResourceAttr(rName, "quality.1.height", "480"),
+					resource.TestCheckResourceAttr(rName, "quality.1.bitrate", "800"),
+					resource.TestCheckResourceAttr(rName, "quality.1.frame_rate", "25"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/live", pushDomainName),
+			},
+		},
+	})
+}
+
+func testTranscoding_basic(pushDomainName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_live_domain" "ingestDomain" {
+  domain      = "%s"
+  domain_type = "push"
+}
+
+resource "sbercloud_live_transcoding" "test" {
+  domain   = sbercloud_live_domain.ingestDomain.domain
+  app_name = "live"
+
+  quality {
+    name    = "t1"
+    width   = 300
+    height  = 400
+    bitrate = 300
+  }
+}
+`, pushDomainName)
+}
+
+func testTranscoding_update(pushDomainName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_live_domain" "ingestDomain" {
+  domain      = "%s"
+  domain_type = "push"
+}
+
+resource "sbercloud_live_transcoding" "test" {
+  domain   = sbercloud_live_domain.ingestDomain.domain
+  app_name = "live"
+
+  quality {
+    name    = "t1"
+    width   = 300
+    height  = 400
+    bitrate = 300
+  }
+
+  quality {
+    name       = "t2"
+    codecs     = "H265"
+    width      = 640
+    height     = 480
+    bitrate    = 800
+    frame_rate = 25
+  }
+}
+`, pushDomainName)
+}
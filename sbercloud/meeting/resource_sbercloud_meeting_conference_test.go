@@ -0,0 +1,94 @@
+package meeting
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/meeting/v1/conferences"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/meeting"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getConferenceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	token, err := meeting.NewMeetingToken(conf, state)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := conferences.GetOpts{
+		ConferenceId: state.Primary.ID,
+		UserId:       state.Primary.Attributes["user_id"],
+		Token:        token,
+	}
+	return conferences.Get(meeting.NewMeetingV1Client(conf), opts)
+}
+
+func TestAccMeetingConference_basic(t *testing.T) {
+	var conference conferences.GetResp
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_meeting_conference.test"
+	startTime := time.Now().UTC().Add(time.Hour).Format("2006-01-02 15:04")
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&conference,
+		getConferenceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckMeetingAccount(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMeetingConference_basic(rName, startTime, 15),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "topic", rName),
+					resource.TestCheckResourceAttr(resourceName, "duration", "15"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", startTime),
+					resource.TestCheckResourceAttr(resourceName, "vmr_flag", "true"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_id", "56"),
+					resource.TestCheckResourceAttrPair(resourceName, "conference_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "conf_uuid"),
+					resource.TestCheckResourceAttrSet(resourceName, "conference_uuid"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccMeetingConference_basic(rName, startTime, 30),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "duration", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMeetingConference_basic(rName, startTime string, duration int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_meeting_conference" "test" {
+  account_name     = "%s"
+  account_password = "%s"
+  meeting_room_id  = "%s"
+  topic            = "%s"
+  start_time       = "%s"
+  duration         = %d
+  media_types      = ["Voice", "Video"]
+  is_auto_record   = 0
+  vmr_flag         = true
+  time_zone_id     = 56
+}
+`, acceptance.SBC_MEETING_ACCOUNT_NAME, acceptance.SBC_MEETING_ACCOUNT_PASSWORD, acceptance.SBC_MEETING_ROOM_ID,
+		rName, startTime, duration)
+}
@@ -0,0 +1,103 @@
+package mls
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getInstanceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.MlsV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud MLS client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("instances", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccMlsInstance_basic(t *testing.T) {
+	var instance interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_mls_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckMrsClusterId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMlsInstance_basic(rName, "mls.c2.2xlarge.common"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "mls.c2.2xlarge.common"),
+					resource.TestCheckResourceAttr(resourceName, "mrs_cluster_id", acceptance.SBC_MRS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "inner_endpoint"),
+				),
+			},
+			{
+				Config: testAccMlsInstance_basic(rName, "mls.c2.4xlarge.common"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "mls.c2.4xlarge.common"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMlsInstance_basic(rName, flavor string) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  vpc_id     = sbercloud_vpc.test.id
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "sbercloud_mls_instance" "test" {
+  name              = "%[1]s"
+  version           = "1.5.0"
+  flavor            = "%[2]s"
+  mrs_cluster_id    = "%[3]s"
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  vpc_id            = sbercloud_vpc.test.id
+  subnet_id         = sbercloud_vpc_subnet.test.id
+}
+`, rName, flavor, acceptance.SBC_MRS_CLUSTER_ID)
+}
@@ -0,0 +1,95 @@
+package mpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getTranscodingTaskResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "mpc", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud MPC client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("transcodings")+"?task_id="+state.Primary.ID,
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	task := utils.PathSearch("task_array[0]", respBody, nil)
+	if task == nil || utils.PathSearch("status", task, "").(string) == "NO_TASK" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return task, nil
+}
+
+func TestAccMpcTranscodingTask_basic(t *testing.T) {
+	var task interface{}
+
+	resourceName := "sbercloud_mpc_transcoding_task.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&task,
+		getTranscodingTaskResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckMpcTranscoding(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		// the completed tasks are kept by MPC, so there is nothing to check after the resource is destroyed
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMpcTranscodingTask_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "input.0.bucket", acceptance.SBC_MPC_BUCKET_NAME),
+					resource.TestCheckResourceAttr(resourceName, "output.0.object", "output"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMpcTranscodingTask_basic() string {
+	return fmt.Sprintf(`
+resource "sbercloud_mpc_transcoding_task" "test" {
+  template_id = %[4]s
+
+  input {
+    bucket   = "%[2]s"
+    location = "%[1]s"
+    object   = "%[3]s"
+  }
+
+  output {
+    bucket   = "%[2]s"
+    location = "%[1]s"
+    object   = "output"
+  }
+}
+`, acceptance.SBC_REGION_NAME, acceptance.SBC_MPC_BUCKET_NAME, acceptance.SBC_MPC_INPUT_OBJECT,
+		acceptance.SBC_MPC_TEMPLATE_ID)
+}
@@ -0,0 +1,50 @@
+package mrs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccMapreduceClusterDataSource_basic(t *testing.T) {
+	dataSourceName := "data.sbercloud_mapreduce_cluster.test"
+	byName := "data.sbercloud_mapreduce_cluster.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckMrsClusterId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMapreduceClusterDataSource_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", acceptance.SBC_MRS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "running"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "master_node_ip"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_ip"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.0.ip"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "component_list.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(byName, "id", dataSourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMapreduceClusterDataSource_basic() string {
+	return fmt.Sprintf(`
+data "sbercloud_mapreduce_cluster" "test" {
+  id = "%s"
+}
+
+data "sbercloud_mapreduce_cluster" "by_name" {
+  name = data.sbercloud_mapreduce_cluster.test.name
+}
+`, acceptance.SBC_MRS_CLUSTER_ID)
+}
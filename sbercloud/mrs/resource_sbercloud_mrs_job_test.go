@@ -0,0 +1,91 @@
+package mrs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/mrs/v2/jobs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getMrsJobResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.MrsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating MRS client: %s", err)
+	}
+	return jobs.Get(c, state.Primary.Attributes["cluster_id"], state.Primary.ID).Extract()
+}
+
+func TestAccMrsJob_basic(t *testing.T) {
+	var obj jobs.Job
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_mrs_job.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getMrsJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckMrsClusterId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMrsJob_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "cluster_id", acceptance.SBC_MRS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "SparkSql"),
+					resource.TestCheckResourceAttr(resourceName, "state", "Completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccMrsJobImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"arguments", "properties", "submit_job_once",
+				},
+			},
+		},
+	})
+}
+
+func testAccMrsJobImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccMrsJob_basic(name string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_mrs_job" "test" {
+  cluster_id = "%s"
+  type       = "SparkSql"
+  name       = "%s"
+  arguments  = ["show databases;"]
+
+  properties = {
+    "spark.executor.memory" = "1g"
+  }
+}
+`, acceptance.SBC_MRS_CLUSTER_ID, name)
+}
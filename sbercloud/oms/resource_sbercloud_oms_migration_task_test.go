@@ -0,0 +1,134 @@
+package oms
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	oms "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/oms/v2/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getMigrationTaskResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.HcOmsV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud OMS client: %s", err)
+	}
+
+	taskID, err := strconv.ParseInt(state.Primary.ID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("the task ID must be integer: %s", err)
+	}
+
+	return c.ShowTask(&oms.ShowTaskRequest{TaskId: taskID})
+}
+
+func TestAccOmsMigrationTask_basic(t *testing.T) {
+	var task oms.ShowTaskResponse
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_oms_migration_task.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&task,
+		getMigrationTaskResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOmsMigrationTask_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "task_type", "object"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_policy.0.max_bandwidth", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "smn_config.0.topic_urn",
+						"sbercloud_smn_topic.test", "topic_urn"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "progress"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_num"),
+					resource.TestCheckResourceAttrSet(resourceName, "success_num"),
+					resource.TestCheckResourceAttrSet(resourceName, "fail_num"),
+				),
+			},
+			{
+				Config: testAccOmsMigrationTask_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_policy.0.max_bandwidth", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOmsMigrationTask_basic(rName string, maxBandwidth int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "source" {
+  bucket = "%[1]s-source"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_object" "source" {
+  bucket  = sbercloud_obs_bucket.source.bucket
+  key     = "test.txt"
+  content = "test content"
+}
+
+resource "sbercloud_obs_bucket" "dest" {
+  bucket        = "%[1]s-dest"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "sbercloud_smn_topic" "test" {
+  name = "%[1]s"
+}
+
+resource "sbercloud_oms_migration_task" "test" {
+  task_type   = "object"
+  description = "created by acc test"
+
+  src_node {
+    cloud_type = "HuaweiCloud"
+    region     = "%[2]s"
+    bucket     = sbercloud_obs_bucket.source.bucket
+    ak         = "%[3]s"
+    sk         = "%[4]s"
+    object_key = [""]
+  }
+
+  dst_node {
+    region = "%[2]s"
+    bucket = sbercloud_obs_bucket.dest.bucket
+    ak     = "%[3]s"
+    sk     = "%[4]s"
+  }
+
+  bandwidth_policy {
+    max_bandwidth = %[5]d
+    start         = "15:00"
+    end           = "16:00"
+  }
+
+  smn_config {
+    topic_urn          = sbercloud_smn_topic.test.topic_urn
+    trigger_conditions = ["FAILURE", "SUCCESS"]
+  }
+
+  depends_on = [sbercloud_obs_bucket_object.source]
+}
+`, rName, acceptance.SBC_REGION_NAME, acceptance.SBC_ACCESS_KEY, acceptance.SBC_SECRET_KEY, maxBandwidth)
+}
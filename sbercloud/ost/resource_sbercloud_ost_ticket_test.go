@@ -0,0 +1,85 @@
+package ost
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getTicketResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "osm", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud OSM client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("servicerequest", "cases", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// The closed tickets are still returned by the API, so the destroy of the resource is not checked.
+func TestAccOstTicket_basic(t *testing.T) {
+	var ticket interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_ost_ticket.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&ticket,
+		getTicketResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOstTicket(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOstTicket_basic(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+					resource.TestCheckResourceAttr(resourceName, "business_type_id", acceptance.SBC_OST_BUSINESS_TYPE_ID),
+					resource.TestCheckResourceAttrPair(resourceName, "ticket_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccOstTicket_basic(rName, "the issue is reproduced again"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "comment", "the issue is reproduced again"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOstTicket_basic(rName, comment string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_ost_ticket" "test" {
+  title               = "%[1]s"
+  business_type_id    = "%[2]s"
+  product_category_id = "%[3]s"
+  description         = "Created by acceptance test, please ignore it"
+  contact_way         = 1
+  email               = "test@example.com"
+  comment             = "%[4]s"
+}
+`, rName, acceptance.SBC_OST_BUSINESS_TYPE_ID, acceptance.SBC_OST_PRODUCT_CATEGORY_ID, comment)
+}
@@ -1,13 +1,18 @@
 package sbercloud
 
 import (
+	"fmt"
+	"log"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/mutexkv"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/aom"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/apig"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/as"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cbr"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cce"
@@ -17,14 +22,12 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dcs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dds"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/deprecated"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dis"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dli"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dms"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dws"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ecs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/eip"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/eps"
-	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/evs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/fgs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/iam"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ims"
@@ -32,6 +35,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mrs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/smn"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/swr"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpc"
 )
 
@@ -67,11 +71,11 @@ func Provider() *schema.Provider {
 			},
 
 			"auth_url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				DefaultFunc: schema.EnvDefaultFunc(
-					"SBC_AUTH_URL", "https://iam.ru-moscow-1.hc.sbercloud.ru/v3"),
-				Description: descriptions["auth_url"],
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SBC_AUTH_URL", defaultAuthURL),
+				Description:  descriptions["auth_url"],
+				ValidateFunc: validation.IsURLWithScheme([]string{"https"}),
 			},
 
 			"region": {
@@ -138,153 +142,273 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 				DefaultFunc: schema.EnvDefaultFunc("SBC_MAX_RETRIES", 5),
 			},
+
+			"endpoints": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["endpoints"],
+				Elem:        providerEndpointsSchema(),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"sbercloud_availability_zones":     huaweicloud.DataSourceAvailabilityZones(),
-			"sbercloud_cbr_vaults":             cbr.DataSourceCbrVaultsV3(),
-			"sbercloud_cce_addon_template":     huaweicloud.DataSourceCCEAddonTemplateV3(),
-			"sbercloud_cce_cluster":            huaweicloud.DataSourceCCEClusterV3(),
-			"sbercloud_cce_clusters":           cce.DataSourceCCEClusters(),
-			"sbercloud_cce_node":               huaweicloud.DataSourceCCENodeV3(),
-			"sbercloud_cce_nodes":              cce.DataSourceCCENodes(),
-			"sbercloud_cce_node_pool":          huaweicloud.DataSourceCCENodePoolV3(),
-			"sbercloud_cdm_flavors":            huaweicloud.DataSourceCdmFlavorV1(),
-			"sbercloud_compute_flavors":        huaweicloud.DataSourceEcsFlavors(),
-			"sbercloud_compute_instance":       huaweicloud.DataSourceComputeInstance(),
-			"sbercloud_compute_instances":      huaweicloud.DataSourceComputeInstances(),
-			"sbercloud_dcs_az":                 deprecated.DataSourceDcsAZV1(),
-			"sbercloud_dcs_maintainwindow":     dcs.DataSourceDcsMaintainWindow(),
-			"sbercloud_dcs_product":            deprecated.DataSourceDcsProductV1(),
-			"sbercloud_dds_flavors":            dds.DataSourceDDSFlavorV3(),
-			"sbercloud_dms_az":                 deprecated.DataSourceDmsAZ(),
-			"sbercloud_dms_product":            dms.DataSourceDmsProduct(),
-			"sbercloud_dms_maintainwindow":     dms.DataSourceDmsMaintainWindow(),
-			"sbercloud_enterprise_project":     eps.DataSourceEnterpriseProject(),
-			"sbercloud_identity_role":          iam.DataSourceIdentityRoleV3(),
-			"sbercloud_identity_custom_role":   iam.DataSourceIdentityCustomRole(),
-			"sbercloud_identity_group":         iam.DataSourceIdentityGroup(),
-			"sbercloud_images_image":           ims.DataSourceImagesImageV2(),
-			"sbercloud_kms_key":                huaweicloud.DataSourceKmsKeyV1(),
-			"sbercloud_kms_data_key":           huaweicloud.DataSourceKmsDataKeyV1(),
-			"sbercloud_nat_gateway":            huaweicloud.DataSourceNatGatewayV2(),
-			"sbercloud_networking_port":        vpc.DataSourceNetworkingPortV2(),
-			"sbercloud_networking_secgroup":    huaweicloud.DataSourceNetworkingSecGroup(),
-			"sbercloud_obs_bucket_object":      huaweicloud.DataSourceObsBucketObject(),
-			"sbercloud_rds_flavors":            rds.DataSourceRdsFlavor(),
-			"sbercloud_sfs_file_system":        huaweicloud.DataSourceSFSFileSystemV2(),
-			"sbercloud_vpc":                    vpc.DataSourceVpcV1(),
-			"sbercloud_vpcs":                   vpc.DataSourceVpcs(),
-			"sbercloud_vpc_bandwidth":          eip.DataSourceBandWidth(),
-			"sbercloud_vpc_eip":                eip.DataSourceVpcEip(),
-			"sbercloud_vpc_ids":                vpc.DataSourceVpcIdsV1(),
-			"sbercloud_vpc_peering_connection": vpc.DataSourceVpcPeeringConnectionV2(),
-			"sbercloud_vpc_route":              vpc.DataSourceVpcRouteV2(),
-			"sbercloud_vpc_route_table":        vpc.DataSourceVPCRouteTable(),
-			"sbercloud_vpc_subnet":             vpc.DataSourceVpcSubnetV1(),
-			"sbercloud_vpc_subnets":            vpc.DataSourceVpcSubnets(),
-			"sbercloud_vpc_subnet_ids":         vpc.DataSourceVpcSubnetIdsV1(),
+			"sbercloud_apm_access_key":                    DataSourceApmAccessKey(),
+			"sbercloud_availability_zones":                huaweicloud.DataSourceAvailabilityZones(),
+			"sbercloud_cbr_vaults":                        cbr.DataSourceCbrVaultsV3(),
+			"sbercloud_cce_addon_template":                huaweicloud.DataSourceCCEAddonTemplateV3(),
+			"sbercloud_cce_cluster":                       huaweicloud.DataSourceCCEClusterV3(),
+			"sbercloud_cce_clusters":                      cce.DataSourceCCEClusters(),
+			"sbercloud_cce_node":                          huaweicloud.DataSourceCCENodeV3(),
+			"sbercloud_cce_nodes":                         cce.DataSourceCCENodes(),
+			"sbercloud_cce_node_pool":                     huaweicloud.DataSourceCCENodePoolV3(),
+			"sbercloud_cdm_flavors":                       huaweicloud.DataSourceCdmFlavorV1(),
+			"sbercloud_compute_flavors":                   huaweicloud.DataSourceEcsFlavors(),
+			"sbercloud_compute_instance":                  huaweicloud.DataSourceComputeInstance(),
+			"sbercloud_compute_instance_console":          DataSourceComputeInstanceConsole(),
+			"sbercloud_compute_instance_console_password": DataSourceComputeInstanceConsolePassword(),
+			"sbercloud_compute_instances":                 DataSourceComputeInstances(),
+			"sbercloud_css_snapshots":                     DataSourceCssSnapshots(),
+			"sbercloud_dcs_az":                            deprecated.DataSourceDcsAZV1(),
+			"sbercloud_dcs_maintainwindow":                dcs.DataSourceDcsMaintainWindow(),
+			"sbercloud_dcs_product":                       deprecated.DataSourceDcsProductV1(),
+			"sbercloud_dds_flavors":                       dds.DataSourceDDSFlavorV3(),
+			"sbercloud_dms_az":                            deprecated.DataSourceDmsAZ(),
+			"sbercloud_dms_product":                       dms.DataSourceDmsProduct(),
+			"sbercloud_dms_maintainwindow":                dms.DataSourceDmsMaintainWindow(),
+			"sbercloud_enterprise_project":                eps.DataSourceEnterpriseProject(),
+			"sbercloud_identity_role":                     iam.DataSourceIdentityRoleV3(),
+			"sbercloud_identity_custom_role":              iam.DataSourceIdentityCustomRole(),
+			"sbercloud_identity_group":                    iam.DataSourceIdentityGroup(),
+			"sbercloud_images_image":                      ims.DataSourceImagesImageV2(),
+			"sbercloud_kms_key":                           huaweicloud.DataSourceKmsKeyV1(),
+			"sbercloud_kms_data_key":                      huaweicloud.DataSourceKmsDataKeyV1(),
+			"sbercloud_mapreduce_cluster":                 DataSourceMapreduceCluster(),
+			"sbercloud_mrs_cluster":                       DataSourceMapreduceCluster(),
+			"sbercloud_nat_gateway":                       huaweicloud.DataSourceNatGatewayV2(),
+			"sbercloud_nat_rules":                         DataSourceNatRules(),
+			"sbercloud_networking_port":                   vpc.DataSourceNetworkingPortV2(),
+			"sbercloud_networking_secgroup":               huaweicloud.DataSourceNetworkingSecGroup(),
+			"sbercloud_obs_bucket_object":                 huaweicloud.DataSourceObsBucketObject(),
+			"sbercloud_rds_flavors":                       rds.DataSourceRdsFlavor(),
+			"sbercloud_sfs_file_system":                   huaweicloud.DataSourceSFSFileSystemV2(),
+			"sbercloud_vpc":                               vpc.DataSourceVpcV1(),
+			"sbercloud_vpcs":                              vpc.DataSourceVpcs(),
+			"sbercloud_vpc_bandwidth":                     eip.DataSourceBandWidth(),
+			"sbercloud_vpc_eip":                           eip.DataSourceVpcEip(),
+			"sbercloud_vpc_ids":                           vpc.DataSourceVpcIdsV1(),
+			"sbercloud_vpc_peering_connection":            vpc.DataSourceVpcPeeringConnectionV2(),
+			"sbercloud_vpc_route":                         vpc.DataSourceVpcRouteV2(),
+			"sbercloud_vpc_route_table":                   vpc.DataSourceVPCRouteTable(),
+			"sbercloud_vpc_subnet":                        vpc.DataSourceVpcSubnetV1(),
+			"sbercloud_vpc_subnets":                       vpc.DataSourceVpcSubnets(),
+			"sbercloud_vpc_subnet_ids":                    vpc.DataSourceVpcSubnetIdsV1(),
 			// Legacy
 			"sbercloud_identity_role_v3": iam.DataSourceIdentityRoleV3(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"sbercloud_aom_service_discovery_rule":      aom.ResourceServiceDiscoveryRule(),
-			"sbercloud_api_gateway_api":                 huaweicloud.ResourceAPIGatewayAPI(),
-			"sbercloud_api_gateway_group":               huaweicloud.ResourceAPIGatewayGroup(),
-			"sbercloud_as_configuration":                as.ResourceASConfiguration(),
-			"sbercloud_as_group":                        as.ResourceASGroup(),
-			"sbercloud_as_policy":                       as.ResourceASPolicy(),
-			"sbercloud_cbr_policy":                      cbr.ResourceCBRPolicyV3(),
-			"sbercloud_cbr_vault":                       cbr.ResourceVault(),
-			"sbercloud_css_cluster":                     css.ResourceCssCluster(),
-			"sbercloud_cce_addon":                       huaweicloud.ResourceCCEAddonV3(),
-			"sbercloud_cce_cluster":                     huaweicloud.ResourceCCEClusterV3(),
-			"sbercloud_cce_namespace":                   cce.ResourceCCENamespaceV1(),
-			"sbercloud_cce_node":                        huaweicloud.ResourceCCENodeV3(),
-			"sbercloud_cce_node_attach":                 huaweicloud.ResourceCCENodeAttachV3(),
-			"sbercloud_cce_node_pool":                   huaweicloud.ResourceCCENodePool(),
-			"sbercloud_cce_pvc":                         cce.ResourceCcePersistentVolumeClaimsV1(),
-			"sbercloud_cdm_cluster":                     cdm.ResourceCdmCluster(),
-			"sbercloud_compute_instance":                ResourceComputeInstanceV2(),
-			"sbercloud_compute_interface_attach":        huaweicloud.ResourceComputeInterfaceAttachV2(),
-			"sbercloud_compute_keypair":                 huaweicloud.ResourceComputeKeypairV2(),
-			"sbercloud_compute_servergroup":             huaweicloud.ResourceComputeServerGroupV2(),
-			"sbercloud_compute_eip_associate":           huaweicloud.ResourceComputeFloatingIPAssociateV2(),
-			"sbercloud_compute_volume_attach":           ecs.ResourceComputeVolumeAttach(),
-			"sbercloud_ces_alarmrule":                   ces.ResourceAlarmRule(),
-			"sbercloud_dcs_instance":                    dcs.ResourceDcsInstance(),
-			"sbercloud_dds_instance":                    dds.ResourceDdsInstanceV3(),
-			"sbercloud_dis_stream":                      dis.ResourceDisStream(),
-			"sbercloud_dli_database":                    dli.ResourceDliSqlDatabaseV1(),
-			"sbercloud_dli_package":                     dli.ResourceDliPackageV2(),
-			"sbercloud_dli_queue":                       dli.ResourceDliQueue(),
-			"sbercloud_dli_spark_job":                   dli.ResourceDliSparkJobV2(),
-			"sbercloud_dms_instance":                    ResourceDmsInstancesV1(),
-			"sbercloud_dms_kafka_instance":              dms.ResourceDmsKafkaInstance(),
-			"sbercloud_dms_kafka_topic":                 dms.ResourceDmsKafkaTopic(),
-			"sbercloud_dms_rabbitmq_instance":           dms.ResourceDmsRabbitmqInstance(),
-			"sbercloud_dns_recordset":                   huaweicloud.ResourceDNSRecordSetV2(),
-			"sbercloud_dns_zone":                        huaweicloud.ResourceDNSZoneV2(),
-			"sbercloud_dws_cluster":                     dws.ResourceDwsCluster(),
-			"sbercloud_enterprise_project":              eps.ResourceEnterpriseProject(),
-			"sbercloud_evs_snapshot":                    huaweicloud.ResourceEvsSnapshotV2(),
-			"sbercloud_evs_volume":                      evs.ResourceEvsVolume(),
-			"sbercloud_fgs_function":                    fgs.ResourceFgsFunctionV2(),
-			"sbercloud_ges_graph":                       huaweicloud.ResourceGesGraphV1(),
-			"sbercloud_identity_access_key":             iam.ResourceIdentityKey(),
-			"sbercloud_identity_acl":                    iam.ResourceIdentityACL(),
-			"sbercloud_identity_agency":                 iam.ResourceIAMAgencyV3(),
-			"sbercloud_identity_group":                  iam.ResourceIdentityGroupV3(),
-			"sbercloud_identity_group_membership":       iam.ResourceIdentityGroupMembershipV3(),
-			"sbercloud_identity_project":                iam.ResourceIdentityProjectV3(),
-			"sbercloud_identity_role":                   iam.ResourceIdentityRole(),
-			"sbercloud_identity_role_assignment":        iam.ResourceIdentityRoleAssignmentV3(),
-			"sbercloud_identity_user":                   iam.ResourceIdentityUserV3(),
-			"sbercloud_images_image":                    huaweicloud.ResourceImsImage(),
-			"sbercloud_kms_key":                         huaweicloud.ResourceKmsKeyV1(),
-			"sbercloud_lb_certificate":                  lb.ResourceCertificateV2(),
-			"sbercloud_lb_l7policy":                     lb.ResourceL7PolicyV2(),
-			"sbercloud_lb_l7rule":                       lb.ResourceL7RuleV2(),
-			"sbercloud_lb_listener":                     lb.ResourceListenerV2(),
-			"sbercloud_lb_loadbalancer":                 lb.ResourceLoadBalancerV2(),
-			"sbercloud_lb_member":                       lb.ResourceMemberV2(),
-			"sbercloud_lb_monitor":                      lb.ResourceMonitorV2(),
-			"sbercloud_lb_pool":                         lb.ResourcePoolV2(),
-			"sbercloud_lb_whitelist":                    lb.ResourceWhitelistV2(),
-			"sbercloud_lts_group":                       huaweicloud.ResourceLTSGroupV2(),
-			"sbercloud_lts_stream":                      huaweicloud.ResourceLTSStreamV2(),
-			"sbercloud_mapreduce_cluster":               mrs.ResourceMRSClusterV2(),
-			"sbercloud_mapreduce_job":                   mrs.ResourceMRSJobV2(),
-			"sbercloud_nat_dnat_rule":                   huaweicloud.ResourceNatDnatRuleV2(),
-			"sbercloud_nat_gateway":                     huaweicloud.ResourceNatGatewayV2(),
-			"sbercloud_nat_snat_rule":                   huaweicloud.ResourceNatSnatRuleV2(),
-			"sbercloud_network_acl":                     huaweicloud.ResourceNetworkACL(),
-			"sbercloud_network_acl_rule":                huaweicloud.ResourceNetworkACLRule(),
-			"sbercloud_networking_eip_associate":        eip.ResourceEIPAssociate(),
-			"sbercloud_networking_secgroup":             huaweicloud.ResourceNetworkingSecGroup(),
-			"sbercloud_networking_secgroup_rule":        huaweicloud.ResourceNetworkingSecGroupRule(),
-			"sbercloud_obs_bucket":                      huaweicloud.ResourceObsBucket(),
-			"sbercloud_obs_bucket_object":               huaweicloud.ResourceObsBucketObject(),
-			"sbercloud_obs_bucket_policy":               huaweicloud.ResourceObsBucketPolicy(),
-			"sbercloud_rds_instance":                    rds.ResourceRdsInstance(),
-			"sbercloud_rds_parametergroup":              rds.ResourceRdsConfiguration(),
-			"sbercloud_rds_read_replica_instance":       rds.ResourceRdsReadReplicaInstance(),
-			"sbercloud_sfs_access_rule":                 huaweicloud.ResourceSFSAccessRuleV2(),
-			"sbercloud_sfs_file_system":                 huaweicloud.ResourceSFSFileSystemV2(),
-			"sbercloud_sfs_turbo":                       huaweicloud.ResourceSFSTurbo(),
-			"sbercloud_smn_subscription":                smn.ResourceSubscription(),
-			"sbercloud_smn_topic":                       smn.ResourceTopic(),
-			"sbercloud_vpc":                             vpc.ResourceVirtualPrivateCloudV1(),
-			"sbercloud_vpc_bandwidth":                   eip.ResourceVpcBandWidthV2(),
-			"sbercloud_vpc_eip":                         eip.ResourceVpcEIPV1(),
-			"sbercloud_vpc_peering_connection":          vpc.ResourceVpcPeeringConnectionV2(),
-			"sbercloud_vpc_peering_connection_accepter": vpc.ResourceVpcPeeringConnectionAccepterV2(),
-			"sbercloud_vpc_route":                       vpc.ResourceVPCRouteTableRoute(),
-			"sbercloud_vpc_route_table":                 vpc.ResourceVPCRouteTable(),
-			"sbercloud_vpc_subnet":                      vpc.ResourceVpcSubnetV1(),
+			"sbercloud_aom_alarm_action_rule":             ResourceAomAlarmActionRule(),
+			"sbercloud_aom_alarm_rule":                    ResourceAomAlarmRule(),
+			"sbercloud_aom_service_discovery_rule":        aom.ResourceServiceDiscoveryRule(),
+			"sbercloud_api_gateway_api":                   huaweicloud.ResourceAPIGatewayAPI(),
+			"sbercloud_api_gateway_group":                 huaweicloud.ResourceAPIGatewayGroup(),
+			"sbercloud_apig_api_throttling_policy":        apig.ResourceThrottlingPolicyAssociate(),
+			"sbercloud_apig_application":                  ResourceApigApplication(),
+			"sbercloud_apig_custom_authorizer":            ResourceApigCustomAuthorizer(),
+			"sbercloud_apig_environment":                  ResourceApigEnvironment(),
+			"sbercloud_apig_environment_variable":         ResourceApigEnvironmentVariable(),
+			"sbercloud_apig_throttling_policy":            ResourceApigThrottlingPolicy(),
+			"sbercloud_apm_agent_config":                  ResourceApmAgentConfig(),
+			"sbercloud_apm_application":                   ResourceApmApplication(),
+			"sbercloud_as_bandwidth_policy":               ResourceASBandwidthPolicy(),
+			"sbercloud_as_configuration":                  as.ResourceASConfiguration(),
+			"sbercloud_as_group":                          as.ResourceASGroup(),
+			"sbercloud_as_lifecycle_hook":                 ResourceASLifecycleHook(),
+			"sbercloud_as_policy":                         as.ResourceASPolicy(),
+			"sbercloud_asm_mesh":                          ResourceAsmMesh(),
+			"sbercloud_asm_mesh_kubernetes_cluster":       ResourceAsmMeshKubernetesCluster(),
+			"sbercloud_bcs_peer_node":                     ResourceBcsPeerNode(),
+			"sbercloud_cbh_instance":                      ResourceCbhInstance(),
+			"sbercloud_cbr_backup_share":                  ResourceCBRBackupShare(),
+			"sbercloud_cbr_policy":                        cbr.ResourceCBRPolicyV3(),
+			"sbercloud_cbr_vault":                         cbr.ResourceVault(),
+			"sbercloud_cbr_vault_associate_policy":        ResourceCBRVaultAssociatePolicy(),
+			"sbercloud_cbr_vault_resource_attach":         ResourceCBRVaultResourceAttach(),
+			"sbercloud_cce_autopilot_cluster":             ResourceCCEAutopilotCluster(),
+			"sbercloud_ces_metric_data":                   ResourceCesMetricData(),
+			"sbercloud_cnad_advanced_policy":              ResourceCnadAdvancedPolicy(),
+			"sbercloud_cnad_advanced_protected_object":    ResourceCnadAdvancedProtectedObject(),
+			"sbercloud_compute_floatingip_associate":      ResourceComputeFloatingIPAssociate(),
+			"sbercloud_compute_instance_bandwidth_policy": ResourceComputeInstanceBandwidthPolicy(),
+			"sbercloud_compute_instance_reboot":           ResourceComputeInstanceReboot(),
+			"sbercloud_compute_instance_state":            ResourceComputeInstanceState(),
+			"sbercloud_compute_reserved_instance":         ResourceComputeReservedInstance(),
+			"sbercloud_cse_microservice_engine":           ResourceCseMicroserviceEngine(),
+			"sbercloud_css_cluster":                       css.ResourceCssCluster(),
+			"sbercloud_css_cluster_public_access":         ResourceCssClusterPublicAccess(),
+			"sbercloud_css_cluster_restore":               ResourceCssClusterRestore(),
+			"sbercloud_cce_addon":                         ResourceCCEAddon(),
+			"sbercloud_cce_cluster":                       huaweicloud.ResourceCCEClusterV3(),
+			"sbercloud_cce_cluster_certificate":           ResourceCCEClusterCertificate(),
+			"sbercloud_cce_namespace":                     ResourceCCENamespace(),
+			"sbercloud_cce_node":                          ResourceCCENode(),
+			"sbercloud_cce_node_attach":                   huaweicloud.ResourceCCENodeAttachV3(),
+			"sbercloud_cce_node_pool":                     ResourceCCENodePool(),
+			"sbercloud_cce_pvc":                           ResourceCCEPersistentVolumeClaim(),
+			"sbercloud_cdm_cluster":                       cdm.ResourceCdmCluster(),
+			"sbercloud_codearts_pipeline":                 ResourceCodeArtsPipeline(),
+			"sbercloud_codearts_project":                  ResourceCodeArtsProject(),
+			"sbercloud_codearts_repository":               ResourceCodeArtsRepository(),
+			"sbercloud_compute_instance":                  ResourceComputeInstanceV2(),
+			"sbercloud_compute_interface_attach":          ResourceComputeInterfaceAttach(),
+			"sbercloud_compute_keypair":                   huaweicloud.ResourceComputeKeypairV2(),
+			"sbercloud_compute_servergroup":               huaweicloud.ResourceComputeServerGroupV2(),
+			"sbercloud_compute_eip_associate":             ResourceComputeEIPAssociate(),
+			"sbercloud_compute_volume_attach":             ecs.ResourceComputeVolumeAttach(),
+			"sbercloud_ces_alarmrule":                     ces.ResourceAlarmRule(),
+			"sbercloud_dataarts_studio_connection":        ResourceDataArtsStudioConnection(),
+			"sbercloud_dataarts_studio_workspace":         ResourceDataArtsStudioWorkspace(),
+			"sbercloud_dbss_audit_rule":                   ResourceDbssAuditRule(),
+			"sbercloud_dbss_instance":                     ResourceDbssInstance(),
+			"sbercloud_dcs_instance":                      dcs.ResourceDcsInstance(),
+			"sbercloud_dcs_whitelist":                     ResourceDcsWhitelist(),
+			"sbercloud_dds_instance":                      dds.ResourceDdsInstanceV3(),
+			"sbercloud_dew_keypair":                       ResourceDewKeypair(),
+			"sbercloud_dew_keystore":                      ResourceDewKeystore(),
+			"sbercloud_dgas_datasource":                   ResourceDgasDatasource(),
+			"sbercloud_dgas_job":                          ResourceDgasJob(),
+			"sbercloud_dis_stream":                        ResourceDisStream(),
+			"sbercloud_dli_database":                      dli.ResourceDliSqlDatabaseV1(),
+			"sbercloud_dli_elastic_resource_pool":         ResourceDliElasticResourcePool(),
+			"sbercloud_dli_flink_job":                     dli.ResourceFlinkSqlJob(),
+			"sbercloud_dli_package":                       dli.ResourceDliPackageV2(),
+			"sbercloud_dli_queue":                         ResourceDliQueue(),
+			"sbercloud_dli_spark_job":                     dli.ResourceDliSparkJobV2(),
+			"sbercloud_dms_instance":                      ResourceDmsInstancesV1(),
+			"sbercloud_dms_kafka_instance":                dms.ResourceDmsKafkaInstance(),
+			"sbercloud_dms_kafka_topic":                   dms.ResourceDmsKafkaTopic(),
+			"sbercloud_dms_rabbitmq_instance":             ResourceDmsRabbitmqInstance(),
+			"sbercloud_dns_recordset":                     huaweicloud.ResourceDNSRecordSetV2(),
+			"sbercloud_dns_zone":                          huaweicloud.ResourceDNSZoneV2(),
+			"sbercloud_dns_zone_vpc_association":          ResourceDNSZoneVpcAssociation(),
+			"sbercloud_dss_dedicated_storage":             ResourceDssDedicatedStorage(),
+			"sbercloud_dss_disk":                          ResourceDssDisk(),
+			"sbercloud_dws_cluster":                       dws.ResourceDwsCluster(),
+			"sbercloud_eg_custom_event_channel":           ResourceEgCustomEventChannel(),
+			"sbercloud_eg_custom_event_source":            ResourceEgCustomEventSource(),
+			"sbercloud_eg_event_subscription":             ResourceEgEventSubscription(),
+			"sbercloud_enterprise_project":                ResourceEnterpriseProject(),
+			"sbercloud_evs_snapshot":                      ResourceEvsSnapshot(),
+			"sbercloud_evs_volume":                        ResourceEvsVolume(),
+			"sbercloud_fgs_function":                      fgs.ResourceFgsFunctionV2(),
+			"sbercloud_ges_backup":                        ResourceGesBackup(),
+			"sbercloud_ges_graph":                         ResourceGesGraph(),
+			"sbercloud_hss_host_group":                    ResourceHssHostGroup(),
+			"sbercloud_hss_host_protection":               ResourceHssHostProtection(),
+			"sbercloud_identity_access_key":               ResourceIdentityAccessKey(),
+			"sbercloud_identity_acl":                      iam.ResourceIdentityACL(),
+			"sbercloud_identity_agency":                   iam.ResourceIAMAgencyV3(),
+			"sbercloud_identity_group":                    iam.ResourceIdentityGroupV3(),
+			"sbercloud_identity_group_membership":         iam.ResourceIdentityGroupMembershipV3(),
+			"sbercloud_identity_project":                  ResourceIdentityProject(),
+			"sbercloud_identity_role":                     ResourceIdentityRole(),
+			"sbercloud_identity_role_assignment":          ResourceIdentityRoleAssignment(),
+			"sbercloud_identity_user":                     iam.ResourceIdentityUserV3(),
+			"sbercloud_images_image":                      huaweicloud.ResourceImsImage(),
+			"sbercloud_ims_image_share":                   ResourceImsImageShare(),
+			"sbercloud_ims_image_share_accepter":          ResourceImsImageShareAccepter(),
+			"sbercloud_ivs_standard":                      ResourceIvsStandard(),
+			"sbercloud_kms_key":                           huaweicloud.ResourceKmsKeyV1(),
+			"sbercloud_lb_certificate":                    lb.ResourceCertificateV2(),
+			"sbercloud_lb_l7policy":                       ResourceL7PolicyV3(),
+			"sbercloud_lb_l7rule":                         ResourceL7RuleV3(),
+			"sbercloud_lb_listener":                       ResourceListenerV2(),
+			"sbercloud_lb_loadbalancer":                   lb.ResourceLoadBalancerV2(),
+			"sbercloud_lb_member":                         lb.ResourceMemberV2(),
+			"sbercloud_lb_monitor":                        lb.ResourceMonitorV2(),
+			"sbercloud_lb_pool":                           lb.ResourcePoolV2(),
+			"sbercloud_lb_security_policy":                ResourceLBSecurityPolicy(),
+			"sbercloud_lb_whitelist":                      ResourceWhitelistV2(),
+			"sbercloud_live_domain":                       ResourceLiveDomain(),
+			"sbercloud_live_record_config":                ResourceLiveRecordConfig(),
+			"sbercloud_live_transcoding":                  ResourceLiveTranscoding(),
+			"sbercloud_lts_group":                         huaweicloud.ResourceLTSGroupV2(),
+			"sbercloud_lts_stream":                        huaweicloud.ResourceLTSStreamV2(),
+			"sbercloud_mapreduce_cluster":                 mrs.ResourceMRSClusterV2(),
+			"sbercloud_mapreduce_job":                     mrs.ResourceMRSJobV2(),
+			"sbercloud_meeting_conference":                ResourceMeetingConference(),
+			"sbercloud_mls_instance":                      ResourceMlsInstance(),
+			"sbercloud_mpc_transcoding_task":              ResourceMpcTranscodingTask(),
+			"sbercloud_mrs_job":                           ResourceMrsJob(),
+			"sbercloud_nat_dnat_rule":                     huaweicloud.ResourceNatDnatRuleV2(),
+			"sbercloud_nat_gateway":                       huaweicloud.ResourceNatGatewayV2(),
+			"sbercloud_nat_snat_rule":                     huaweicloud.ResourceNatSnatRuleV2(),
+			"sbercloud_network_acl":                       huaweicloud.ResourceNetworkACL(),
+			"sbercloud_network_acl_rule":                  huaweicloud.ResourceNetworkACLRule(),
+			"sbercloud_networking_eip_associate":          eip.ResourceEIPAssociate(),
+			"sbercloud_networking_secgroup":               ResourceNetworkingSecGroup(),
+			"sbercloud_networking_secgroup_rule":          huaweicloud.ResourceNetworkingSecGroupRule(),
+			"sbercloud_networking_vip":                    ResourceNetworkingVip(),
+			"sbercloud_networking_vip_associate":          ResourceNetworkingVipAssociate(),
+			"sbercloud_obs_bucket":                        huaweicloud.ResourceObsBucket(),
+			"sbercloud_obs_bucket_cors_rule":              ResourceObsBucketCorsRule(),
+			"sbercloud_obs_bucket_intelligent_tiering":    ResourceObsBucketIntelligentTiering(),
+			"sbercloud_obs_bucket_inventory":              ResourceObsBucketInventory(),
+			"sbercloud_obs_bucket_object":                 huaweicloud.ResourceObsBucketObject(),
+			"sbercloud_obs_bucket_object_acl":             ResourceObsBucketObjectAcl(),
+			"sbercloud_obs_bucket_policy":                 huaweicloud.ResourceObsBucketPolicy(),
+			"sbercloud_obs_bucket_request_payment":        ResourceObsBucketRequestPayment(),
+			"sbercloud_obs_bucket_worm_policy":            ResourceObsBucketWormPolicy(),
+			"sbercloud_oms_migration_task":                ResourceOmsMigrationTask(),
+			"sbercloud_ost_ticket":                        ResourceOstTicket(),
+			"sbercloud_quota":                             ResourceQuota(),
+			"sbercloud_rds_account":                       ResourceRdsAccount(),
+			"sbercloud_rds_database":                      ResourceRdsDatabase(),
+			"sbercloud_rds_instance":                      rds.ResourceRdsInstance(),
+			"sbercloud_rds_parametergroup":                rds.ResourceRdsConfiguration(),
+			"sbercloud_rds_read_replica_instance":         rds.ResourceRdsReadReplicaInstance(),
+			"sbercloud_rds_restore":                       ResourceRdsRestore(),
+			"sbercloud_rms_policy_assignment":             ResourceRmsPolicyAssignment(),
+			"sbercloud_rms_remediation_configuration":     ResourceRmsRemediationConfiguration(),
+			"sbercloud_roma_connect_api":                  ResourceRomaConnectApi(),
+			"sbercloud_roma_connect_app":                  ResourceRomaConnectApp(),
+			"sbercloud_roma_connect_instance":             ResourceRomaConnectInstance(),
+			"sbercloud_sdrs_protectedinstance":            ResourceSdrsProtectedInstance(),
+			"sbercloud_sdrs_protectiongroup":              ResourceSdrsProtectionGroup(),
+			"sbercloud_sdrs_replication_attach":           ResourceSdrsReplicationAttach(),
+			"sbercloud_secmaster_alert":                   ResourceSecMasterAlert(),
+			"sbercloud_secmaster_workspace":               ResourceSecMasterWorkspace(),
+			"sbercloud_sfs_access_rule":                   huaweicloud.ResourceSFSAccessRuleV2(),
+			"sbercloud_sfs_file_system":                   huaweicloud.ResourceSFSFileSystemV2(),
+			"sbercloud_sfs_turbo":                         huaweicloud.ResourceSFSTurbo(),
+			"sbercloud_sis_vocabulary":                    ResourceSisVocabulary(),
+			"sbercloud_smn_subscription":                  smn.ResourceSubscription(),
+			"sbercloud_smn_topic":                         smn.ResourceTopic(),
+			"sbercloud_swr_image_retention_policy":        ResourceSwrImageRetentionPolicy(),
+			"sbercloud_swr_organization":                  ResourceSWROrganization(),
+			"sbercloud_swr_organization_permissions":      swr.ResourceSWROrganizationPermissions(),
+			"sbercloud_swr_repository":                    ResourceSWRRepository(),
+			"sbercloud_tms_tags":                          ResourceTmsTags(),
+			"sbercloud_ucs_cluster":                       ResourceUcsCluster(),
+			"sbercloud_ucs_fleet":                         ResourceUcsFleet(),
+			"sbercloud_ucs_policy":                        ResourceUcsPolicy(),
+			"sbercloud_vod_media_asset":                   ResourceVodMediaAsset(),
+			"sbercloud_vpc":                               vpc.ResourceVirtualPrivateCloudV1(),
+			"sbercloud_vpc_bandwidth":                     eip.ResourceVpcBandWidthV2(),
+			"sbercloud_vpc_eip":                           eip.ResourceVpcEIPV1(),
+			"sbercloud_vpc_flow_log":                      ResourceVpcFlowLog(),
+			"sbercloud_vpc_internet_gateway":              ResourceVpcInternetGateway(),
+			"sbercloud_vpc_peering_connection":            vpc.ResourceVpcPeeringConnectionV2(),
+			"sbercloud_vpc_peering_connection_accepter":   vpc.ResourceVpcPeeringConnectionAccepterV2(),
+			"sbercloud_vpc_route":                         vpc.ResourceVPCRouteTableRoute(),
+			"sbercloud_vpc_route_table":                   vpc.ResourceVPCRouteTable(),
+			"sbercloud_vpc_subnet":                        vpc.ResourceVpcSubnetV1(),
+			"sbercloud_vpc_subnet_dhcp_options":           ResourceVpcSubnetDhcpOptions(),
+			"sbercloud_workspace_desktop":                 ResourceWorkspaceDesktop(),
+			"sbercloud_workspace_service":                 ResourceWorkspaceService(),
 			// Legacy
-			"sbercloud_identity_role_assignment_v3":  iam.ResourceIdentityRoleAssignmentV3(),
+			"sbercloud_identity_role_assignment_v3":  ResourceIdentityRoleAssignment(),
 			"sbercloud_identity_user_v3":             iam.ResourceIdentityUserV3(),
 			"sbercloud_identity_group_v3":            iam.ResourceIdentityGroupV3(),
 			"sbercloud_identity_group_membership_v3": iam.ResourceIdentityGroupMembershipV3(),
@@ -327,9 +451,102 @@ func init() {
 		"account_name": "The name of the Account to login with.",
 
 		"insecure": "Trust self-signed certificates.",
+
+		"endpoints": "The custom endpoints used to override the default endpoint URLs.",
 	}
 }
 
+// defaultAuthURL is the IAM endpoint of the public cloud.
+const defaultAuthURL = "https://iam.ru-moscow-1.hc.sbercloud.ru/v3"
+
+// providerEndpointKeys maps the fields of the endpoints block to the primary catalog keys of the services.
+var providerEndpointKeys = map[string]string{
+	"ecs":  "ecs",
+	"vpc":  "vpc",
+	"obs":  "obs",
+	"evs":  "evs",
+	"rds":  "rds",
+	"dcs":  "dcs",
+	"cce":  "cce",
+	"dns":  "dns",
+	"iam":  "iam",
+	"kms":  "kms",
+	"fgs":  "fgs",
+	"dms":  "dms",
+	"smn":  "smn",
+	"lts":  "lts",
+	"ces":  "ces",
+	"as":   "autoscaling",
+	"elb":  "elb",
+	"nat":  "nat",
+	"vpn":  "vpn",
+	"cts":  "cts",
+	"cbr":  "cbr",
+	"apig": "apig",
+	"sfs":  "sfs",
+}
+
+func providerEndpointsSchema() *schema.Resource {
+	endpointSchema := make(map[string]*schema.Schema, len(providerEndpointKeys))
+	for key := range providerEndpointKeys {
+		endpointSchema[key] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateProviderEndpoint,
+		}
+	}
+	return &schema.Resource{
+		Schema: endpointSchema,
+	}
+}
+
+func validateProviderEndpoint(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+		errors = append(errors, fmt.Errorf("%q must start with http:// or https://, got: %s", k, value))
+	}
+	if !strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must end with a slash, got: %s", k, value))
+	}
+	return
+}
+
+// buildProviderEndpoints returns the custom endpoints keyed by the catalog keys, the derived catalog keys of the
+// service share the same endpoint.
+func buildProviderEndpoints(d *schema.ResourceData) map[string]string {
+	endpoints := make(map[string]string)
+	// a custom auth_url, e.g. the Keystone of a private deployment, is used by the IAM clients as well instead of the
+	// region-based endpoint, the iam field of the endpoints block still takes precedence
+	if authURL := d.Get("auth_url").(string); authURL != "" && authURL != defaultAuthURL {
+		iamEndpoint := strings.TrimSuffix(strings.TrimSuffix(authURL, "/"), "/v3") + "/"
+		endpoints["iam"] = iamEndpoint
+		for _, derived := range config.GetServiceDerivedCatalogKeys("iam") {
+			endpoints[derived] = iamEndpoint
+		}
+	}
+
+	rawEndpoints := d.Get("endpoints").([]interface{})
+	if len(rawEndpoints) == 0 || rawEndpoints[0] == nil {
+		return endpoints
+	}
+
+	for field, value := range rawEndpoints[0].(map[string]interface{}) {
+		endpoint := value.(string)
+		if endpoint == "" {
+			continue
+		}
+
+		key := providerEndpointKeys[field]
+		endpoints[key] = endpoint
+		for _, derived := range config.GetServiceDerivedCatalogKeys(key) {
+			endpoints[derived] = endpoint
+		}
+	}
+
+	log.Printf("[DEBUG] custom endpoints: %+v", endpoints)
+	return endpoints
+}
+
 func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	var project_name string
 
@@ -358,6 +575,7 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		RegionClient:        true,
 		RegionProjectIDMap:  make(map[string]string),
 		RPLock:              new(sync.Mutex),
+		Endpoints:           buildProviderEndpoints(d),
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
@@ -3,10 +3,14 @@ package sbercloud
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/chnsz/golangsdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/pathorcontents"
 )
 
@@ -14,10 +18,21 @@ var (
 	SBC_ACCESS_KEY                 = os.Getenv("SBC_ACCESS_KEY")
 	SBC_ACCOUNT_NAME               = os.Getenv("SBC_ACCOUNT_NAME")
 	SBC_ADMIN                      = os.Getenv("SBC_ADMIN")
+	SBC_APIG_GROUP_ID              = os.Getenv("SBC_APIG_GROUP_ID")
+	SBC_APIG_INSTANCE_ID           = os.Getenv("SBC_APIG_INSTANCE_ID")
+	SBC_APIG_PUBLISH_ID            = os.Getenv("SBC_APIG_PUBLISH_ID")
+	SBC_CSS_CLUSTER_ID             = os.Getenv("SBC_CSS_CLUSTER_ID")
+	SBC_CSS_SNAPSHOT_ID            = os.Getenv("SBC_CSS_SNAPSHOT_ID")
 	SBC_DOMAIN_ID                  = os.Getenv("SBC_DOMAIN_ID")
 	SBC_DOMAIN_NAME                = os.Getenv("SBC_DOMAIN_NAME")
+	SBC_ECS_FAULT_DOMAIN           = os.Getenv("SBC_ECS_FAULT_DOMAIN")
+	SBC_ECS_RESERVED_INSTANCE      = os.Getenv("SBC_ECS_RESERVED_INSTANCE")
 	SBC_ENTERPRISE_PROJECT_ID_TEST = os.Getenv("SBC_ENTERPRISE_PROJECT_ID_TEST")
+	SBC_IMS_SHARED_IMAGE_ID        = os.Getenv("SBC_IMS_SHARED_IMAGE_ID")
+	SBC_IMS_SHARE_PROJECT_ID       = os.Getenv("SBC_IMS_SHARE_PROJECT_ID")
 	SBC_PROJECT_ID                 = os.Getenv("SBC_PROJECT_ID")
+	SBC_RDS_BACKUP_ID              = os.Getenv("SBC_RDS_BACKUP_ID")
+	SBC_RDS_INSTANCE_ID            = os.Getenv("SBC_RDS_INSTANCE_ID")
 	SBC_REGION_NAME                = os.Getenv("SBC_REGION_NAME")
 	SBC_SECRET_KEY                 = os.Getenv("SBC_SECRET_KEY")
 )
@@ -48,18 +63,85 @@ func testAccPreCheckAdminOnly(t *testing.T) {
 	}
 }
 
+func testAccPreCheckApigInstance(t *testing.T) {
+	if SBC_APIG_INSTANCE_ID == "" {
+		t.Skip("SBC_APIG_INSTANCE_ID must be set for APIG acceptance tests")
+	}
+}
+
+// testAccPreCheckApigGroup requires an API group of the APIG instance.
+func testAccPreCheckApigGroup(t *testing.T) {
+	if SBC_APIG_INSTANCE_ID == "" || SBC_APIG_GROUP_ID == "" {
+		t.Skip("SBC_APIG_INSTANCE_ID and SBC_APIG_GROUP_ID must be set for APIG environment variable acceptance tests")
+	}
+}
+
+// testAccPreCheckApigPublish requires an API published to an environment of the APIG instance.
+func testAccPreCheckApigPublish(t *testing.T) {
+	if SBC_APIG_INSTANCE_ID == "" || SBC_APIG_PUBLISH_ID == "" {
+		t.Skip("SBC_APIG_INSTANCE_ID and SBC_APIG_PUBLISH_ID must be set for APIG throttling policy associate " +
+			"acceptance tests")
+	}
+}
+
 func testAccPreCheckEpsID(t *testing.T) {
 	if SBC_ENTERPRISE_PROJECT_ID_TEST == "" {
 		t.Skip("This environment does not support EPS_ID tests")
 	}
 }
 
+func testAccPreCheckEcsFaultDomain(t *testing.T) {
+	if SBC_ECS_FAULT_DOMAIN == "" {
+		t.Skip("SBC_ECS_FAULT_DOMAIN must be set for ECS fault domain acceptance tests")
+	}
+}
+
+// testAccPreCheckEcsReservedInstance requires an explicit opt-in, the purchased reservation can not be unsubscribed.
+func testAccPreCheckEcsReservedInstance(t *testing.T) {
+	if SBC_ECS_RESERVED_INSTANCE == "" {
+		t.Skip("SBC_ECS_RESERVED_INSTANCE must be set for ECS reserved instance acceptance tests")
+	}
+}
+
+// testAccPreCheckCssSnapshot requires a CSS cluster with a snapshot, the restoration overwrites its indices.
+func testAccPreCheckCssSnapshot(t *testing.T) {
+	if SBC_CSS_CLUSTER_ID == "" || SBC_CSS_SNAPSHOT_ID == "" {
+		t.Skip("SBC_CSS_CLUSTER_ID and SBC_CSS_SNAPSHOT_ID must be set for CSS cluster restore acceptance tests")
+	}
+}
+
+// testAccPreCheckCssCluster requires an available CSS cluster whose public access is disabled.
+func testAccPreCheckCssCluster(t *testing.T) {
+	if SBC_CSS_CLUSTER_ID == "" {
+		t.Skip("SBC_CSS_CLUSTER_ID must be set for CSS cluster public access acceptance tests")
+	}
+}
+
+func testAccPreCheckImsShareProject(t *testing.T) {
+	if SBC_IMS_SHARE_PROJECT_ID == "" {
+		t.Skip("SBC_IMS_SHARE_PROJECT_ID must be set for IMS image share acceptance tests")
+	}
+}
+
+func testAccPreCheckImsSharedImage(t *testing.T) {
+	if SBC_IMS_SHARED_IMAGE_ID == "" {
+		t.Skip("SBC_IMS_SHARED_IMAGE_ID must be set for IMS image share accepter acceptance tests")
+	}
+}
+
 func testAccPreCheckOBS(t *testing.T) {
 	if SBC_ACCESS_KEY == "" || SBC_SECRET_KEY == "" {
 		t.Skip("SBC_ACCESS_KEY and SBC_SECRET_KEY must be set for OBS acceptance tests")
 	}
 }
 
+// testAccPreCheckRdsBackup requires an RDS instance with a backup, which is restored to new instances.
+func testAccPreCheckRdsBackup(t *testing.T) {
+	if SBC_RDS_INSTANCE_ID == "" || SBC_RDS_BACKUP_ID == "" {
+		t.Skip("SBC_RDS_INSTANCE_ID and SBC_RDS_BACKUP_ID must be set for RDS restore acceptance tests")
+	}
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -70,6 +152,100 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProviderEndpoints_mock(t *testing.T) {
+	var requestPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"vpcs": []}`))
+	}))
+	defer server.Close()
+
+	raw := map[string]interface{}{
+		"region": "ru-moscow-1",
+		"endpoints": []interface{}{
+			map[string]interface{}{
+				"vpc": server.URL + "/",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	conf := &config.Config{
+		Endpoints: buildProviderEndpoints(d),
+		HwClient: &golangsdk.ProviderClient{
+			HTTPClient: *server.Client(),
+		},
+	}
+
+	// the derived catalog keys of the service should share the custom endpoint
+	if conf.Endpoints["networkv2"] != server.URL+"/" {
+		t.Fatalf("the endpoint of networkv2 is not overridden: %v", conf.Endpoints)
+	}
+	if _, ok := conf.Endpoints["ecs"]; ok {
+		t.Fatalf("the endpoint of ecs should not be overridden: %v", conf.Endpoints)
+	}
+
+	client, err := conf.NetworkingV1Client("ru-moscow-1")
+	if err != nil {
+		t.Fatalf("error creating VPC client: %s", err)
+	}
+	_, err = client.Request("GET", client.ServiceURL("vpcs"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		t.Fatalf("error sending request to the mock server: %s", err)
+	}
+	if requestPath != "/v1/vpcs" {
+		t.Fatalf("the mock server received an unexpected request path: %s", requestPath)
+	}
+}
+
+func TestProviderEndpoints_validation(t *testing.T) {
+	cases := map[string]bool{
+		"https://vpc.example.com/": true,
+		"http://127.0.0.1:8080/":   true,
+		"https://vpc.example.com":  false,
+		"vpc.example.com/":         false,
+	}
+	for endpoint, valid := range cases {
+		_, errs := validateProviderEndpoint(endpoint, "vpc")
+		if (len(errs) == 0) != valid {
+			t.Errorf("unexpected validation result of %s: %v", endpoint, errs)
+		}
+	}
+}
+
+func TestProviderEndpoints_authURL(t *testing.T) {
+	raw := map[string]interface{}{
+		"region":   "ru-moscow-1",
+		"auth_url": "https://iam.private.example.com/v3",
+	}
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	endpoints := buildProviderEndpoints(d)
+
+	// the IAM clients should use the custom auth_url instead of the region-based endpoint
+	for _, key := range []string{"iam", "identity", "iam_no_version"} {
+		if endpoints[key] != "https://iam.private.example.com/" {
+			t.Fatalf("the endpoint of %s is not overridden by auth_url: %v", key, endpoints)
+		}
+	}
+
+	raw["endpoints"] = []interface{}{
+		map[string]interface{}{
+			"iam": "https://iam.custom.example.com/",
+		},
+	}
+	d = schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	if endpoints := buildProviderEndpoints(d); endpoints["iam"] != "https://iam.custom.example.com/" {
+		t.Fatalf("the iam endpoint should take precedence over auth_url: %v", endpoints)
+	}
+
+	_, errs := Provider().Schema["auth_url"].ValidateFunc("http://iam.private.example.com/v3", "auth_url")
+	if len(errs) == 0 {
+		t.Fatalf("the auth_url without https scheme should be rejected")
+	}
+}
+
 func envVarContents(varName string) (string, error) {
 	contents, _, err := pathorcontents.Read(os.Getenv(varName))
 	if err != nil {
@@ -0,0 +1,189 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceAomAlarmActionRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAomAlarmActionRuleCreate,
+		ReadContext:   resourceAomAlarmActionRuleRead,
+		UpdateContext: resourceAomAlarmActionRuleUpdate,
+		DeleteContext: resourceAomAlarmActionRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			// 1: notification, 2: user
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "2"}, false),
+			},
+			"notification_template": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"smn_topics": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildAomAlarmActionRuleBodyParams(d *schema.ResourceData) map[string]interface{} {
+	topics := d.Get("smn_topics").([]interface{})
+	smnTopics := make([]map[string]interface{}, len(topics))
+	for i, topic := range topics {
+		smnTopics[i] = map[string]interface{}{
+			"topic_urn": topic,
+		}
+	}
+
+	return map[string]interface{}{
+		"rule_name":             d.Get("name"),
+		"type":                  d.Get("type"),
+		"notification_template": d.Get("notification_template"),
+		"smn_topics":            smnTopics,
+		"desc":                  d.Get("description"),
+	}
+}
+
+func resourceAomAlarmActionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "aomv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	_, err = client.Request("POST", client.ServiceURL("alert", "action-rules"), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(buildAomAlarmActionRuleBodyParams(d)),
+		OkCodes:  []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating AOM alarm action rule: %s", err)
+	}
+
+	// the action rule is identified by its name
+	d.SetId(d.Get("name").(string))
+
+	return resourceAomAlarmActionRuleRead(ctx, d, meta)
+}
+
+func resourceAomAlarmActionRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "aomv2", region)
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("alert", "action-rules", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving AOM alarm action rule")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("rule_name", respBody, nil)),
+		d.Set("type", pathSearch("type", respBody, nil)),
+		d.Set("notification_template", pathSearch("notification_template", respBody, nil)),
+		d.Set("smn_topics", pathSearch("smn_topics[*].topic_urn", respBody, nil)),
+		d.Set("description", pathSearch("desc", respBody, nil)),
+		d.Set("created_at", flattenAomTimestamp(pathSearch("create_time", respBody, float64(0)).(float64))),
+		d.Set("updated_at", flattenAomTimestamp(pathSearch("update_time", respBody, float64(0)).(float64))),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting AOM alarm action rule fields: %s", err)
+	}
+
+	return nil
+}
+
+// flattenAomTimestamp converts the time in milliseconds which is returned by the AOM API.
+func flattenAomTimestamp(timestamp float64) string {
+	if timestamp == 0 {
+		return ""
+	}
+	return utils.FormatTimeStampRFC3339(int64(timestamp) / 1000)
+}
+
+func resourceAomAlarmActionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "aomv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL("alert", "action-rules"), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(buildAomAlarmActionRuleBodyParams(d)),
+	})
+	if err != nil {
+		return diag.Errorf("error updating AOM alarm action rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceAomAlarmActionRuleRead(ctx, d, meta)
+}
+
+func resourceAomAlarmActionRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "aomv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	// the names of the rules to delete are passed in the request body
+	if _, err := client.Request("DELETE", client.ServiceURL("alert", "action-rules"), &golangsdk.RequestOpts{
+		JSONBody: []string{d.Id()},
+		OkCodes:  []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting AOM alarm action rule")
+	}
+
+	return nil
+}
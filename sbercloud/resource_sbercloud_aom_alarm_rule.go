@@ -0,0 +1,292 @@
+package sbercloud
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceAomAlarmRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAomAlarmRuleCreate,
+		ReadContext:   resourceAomAlarmRuleRead,
+		UpdateContext: resourceAomAlarmRuleUpdate,
+		DeleteContext: resourceAomAlarmRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sub_namespace": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dimension_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dimension_value": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"condition_expression": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"alarm_level": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(1, 4),
+			},
+			"notification_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"alarm_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"action_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"alarm_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildAomAlarmRuleBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"alarm_rule_name":      d.Get("name"),
+		"alarm_description":    d.Get("description"),
+		"alarm_level":          d.Get("alarm_level"),
+		"namespace":            d.Get("namespace"),
+		"sub_namespace":        valueIgnoreEmpty(d.Get("sub_namespace")),
+		"condition_expression": d.Get("condition_expression"),
+		"alarm_actions":        d.Get("notification_groups"),
+		"id_turn_on":           d.Get("alarm_enabled"),
+		"action_enabled":       d.Get("action_enabled"),
+		"dimensions": []map[string]interface{}{
+			{
+				"name":  d.Get("dimension_name"),
+				"value": d.Get("dimension_value"),
+			},
+		},
+	}
+}
+
+func resourceAomAlarmRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "aomv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	createOpts := buildAomAlarmRuleBodyParams(d)
+	createOpts["enterprise_project_id"] = valueIgnoreEmpty(GetEnterpriseProjectID(d, conf))
+	resp, err := client.Request("POST", client.ServiceURL("alarm-rules"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating AOM alarm rule: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the alarm rule ID is a number in the API response
+	id := pathSearch("alarm_rule_id", respBody, float64(0)).(float64)
+	if id == 0 {
+		return diag.Errorf("unable to find the AOM alarm rule ID from the API response")
+	}
+	d.SetId(strconv.FormatInt(int64(id), 10))
+
+	return resourceAomAlarmRuleRead(ctx, d, meta)
+}
+
+func getAomAlarmRule(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("alarm-rules", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := pathSearch("thresholds|[0]", respBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func aomAlarmRuleStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := getAomAlarmRule(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return rule, "PENDING", nil
+	}
+}
+
+func resourceAomAlarmRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "aomv2", region)
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	rule, err := getAomAlarmRule(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving AOM alarm rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("alarm_rule_name", rule, nil)),
+		d.Set("namespace", pathSearch("namespace", rule, nil)),
+		d.Set("sub_namespace", pathSearch("sub_namespace", rule, nil)),
+		d.Set("dimension_name", pathSearch("dimensions|[0].name", rule, nil)),
+		d.Set("dimension_value", pathSearch("dimensions|[0].value", rule, nil)),
+		d.Set("condition_expression", pathSearch("condition_expression", rule, nil)),
+		d.Set("description", pathSearch("alarm_description", rule, nil)),
+		d.Set("alarm_level", pathSearch("alarm_level", rule, nil)),
+		d.Set("notification_groups", pathSearch("alarm_actions", rule, nil)),
+		d.Set("alarm_enabled", pathSearch("id_turn_on", rule, nil)),
+		d.Set("action_enabled", pathSearch("action_enabled", rule, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", rule, nil)),
+		d.Set("alarm_rule_id", d.Id()),
+		d.Set("state", pathSearch("state_value", rule, nil)),
+		d.Set("state_reason", pathSearch("state_reason", rule, nil)),
+		d.Set("update_time", pathSearch("update_time", rule, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting AOM alarm rule fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAomAlarmRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "aomv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	// the rule to update is identified by the name in the request body
+	_, err = client.Request("PUT", client.ServiceURL("alarm-rules"), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(buildAomAlarmRuleBodyParams(d)),
+	})
+	if err != nil {
+		return diag.Errorf("error updating AOM alarm rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceAomAlarmRuleRead(ctx, d, meta)
+}
+
+func resourceAomAlarmRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "aomv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AOM client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("alarm-rules", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting AOM alarm rule")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"DELETED"},
+		Refresh:      aomAlarmRuleStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the AOM alarm rule (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
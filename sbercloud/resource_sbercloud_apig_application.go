@@ -0,0 +1,246 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/applications"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceApigApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApigApplicationCreate,
+		ReadContext:   resourceApigApplicationRead,
+		UpdateContext: resourceApigApplicationUpdate,
+		DeleteContext: resourceApigApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApigInstanceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z]\w{2,63}$`),
+					"the name consists of 3 to 64 characters, starting with a letter, only letters, digits and "+
+						"underscores (_) are allowed"),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^<>]{1,255}$`),
+					"the description contains a maximum of 255 characters and can't contain angle brackets (< and >)"),
+			},
+			"app_codes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9+/][\w!@#$%+/=-]{63,179}$`),
+						"the code consists of 64 to 180 characters, starting with a letter, digit, plus sign (+) "+
+							"or slash (/), only letters, digits and special characters (!@#$%+-_/=) are allowed"),
+				},
+			},
+			// the secret is reset each time the value is changed to true
+			"reset_secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"app_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"app_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"registration_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func listApigApplicationCodes(client *golangsdk.ServiceClient, instanceID,
+	appID string) ([]applications.AppCode, error) {
+	pages, err := applications.ListAppCode(client, instanceID, appID, applications.ListCodeOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return applications.ExtractAppCodes(pages)
+}
+
+func updateApigApplicationCodes(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	instanceID := d.Get("instance_id").(string)
+	oldRaw, newRaw := d.GetChange("app_codes")
+	removeCodes := oldRaw.(*schema.Set).Difference(newRaw.(*schema.Set))
+	addCodes := newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set))
+
+	if removeCodes.Len() > 0 {
+		codes, err := listApigApplicationCodes(client, instanceID, d.Id())
+		if err != nil {
+			return fmt.Errorf("error retrieving the codes: %s", err)
+		}
+		for _, code := range codes {
+			if !removeCodes.Contains(code.Code) {
+				continue
+			}
+			if err := applications.RemoveAppCode(client, instanceID, d.Id(), code.Id).ExtractErr(); err != nil {
+				return fmt.Errorf("error removing the code (%s): %s", code.Id, err)
+			}
+		}
+	}
+
+	for _, code := range addCodes.List() {
+		opts := applications.AppCodeOpts{
+			AppCode: code.(string),
+		}
+		if _, err := applications.CreateAppCode(client, instanceID, d.Id(), opts).Extract(); err != nil {
+			return fmt.Errorf("error adding the code: %s", err)
+		}
+	}
+	return nil
+}
+
+func resourceApigApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := applications.AppOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+	app, err := applications.Create(client, instanceID, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating APIG application in instance (%s): %s", instanceID, err)
+	}
+	d.SetId(app.Id)
+
+	if _, ok := d.GetOk("app_codes"); ok {
+		if err := updateApigApplicationCodes(client, d); err != nil {
+			return diag.Errorf("error setting the codes of APIG application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceApigApplicationRead(ctx, d, meta)
+}
+
+// resourceApigApplicationRead refreshes the credentials as well, the API returns the key and the secret on each query.
+func resourceApigApplicationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ApigV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	app, err := applications.Get(client, instanceID, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving APIG application")
+	}
+	codes, err := listApigApplicationCodes(client, instanceID, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the codes of APIG application (%s): %s", d.Id(), err)
+	}
+	appCodes := make([]string, len(codes))
+	for i, code := range codes {
+		appCodes[i] = code.Code
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", app.Name),
+		d.Set("description", app.Description),
+		d.Set("app_codes", appCodes),
+		d.Set("app_key", app.AppKey),
+		d.Set("app_secret", app.AppSecret),
+		d.Set("registration_time", app.RegistraionTime),
+		d.Set("updated_at", app.UpdateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting APIG application fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApigApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	if d.HasChanges("name", "description") {
+		updateOpts := applications.AppOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		if _, err := applications.Update(client, instanceID, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("error updating APIG application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("app_codes") {
+		if err := updateApigApplicationCodes(client, d); err != nil {
+			return diag.Errorf("error updating the codes of APIG application (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("reset_secret") && d.Get("reset_secret").(bool) {
+		_, err := applications.ResetAppSecret(client, instanceID, d.Id(), applications.SecretResetOpts{}).Extract()
+		if err != nil {
+			return diag.Errorf("error resetting the secret of APIG application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceApigApplicationRead(ctx, d, meta)
+}
+
+func resourceApigApplicationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	if err := applications.Delete(client, d.Get("instance_id").(string), d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting APIG application")
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/applications"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccApigApplication_basic(t *testing.T) {
+	var secret string
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "sbercloud_apig_application.test"
+	appCode := acctest.RandStringFromCharSet(64, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckApigInstance(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApigApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApigApplication_basic(rName, appCode),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApigApplicationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "app_codes.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_secret"),
+					resource.TestCheckResourceAttrSet(resourceName, "registration_time"),
+				),
+			},
+			{
+				Config: testAccApigApplication_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "app_codes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "reset_secret", "true"),
+					testAccCheckApigApplicationSecretReset(resourceName, &secret),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccApigInstanceSubResourceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"reset_secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckApigApplicationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.ApigV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_apig_application" {
+			continue
+		}
+
+		_, err := applications.Get(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("APIG application still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckApigApplicationExists(n string, secret *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		client, err := config.ApigV2Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+		}
+
+		app, err := applications.Get(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+		*secret = app.AppSecret
+		return nil
+	}
+}
+
+func testAccCheckApigApplicationSecretReset(n string, secret *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["app_secret"] == *secret {
+			return fmt.Errorf("The secret of APIG application is not reset")
+		}
+		return nil
+	}
+}
+
+func testAccApigApplication_basic(rName, appCode string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_apig_application" "test" {
+  instance_id = "%s"
+  name        = "%s"
+  description = "created by acc test"
+  app_codes   = ["%s"]
+}
+`, SBC_APIG_INSTANCE_ID, rName, appCode)
+}
+
+func testAccApigApplication_update(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_apig_application" "test" {
+  instance_id  = "%s"
+  name         = "%s_update"
+  description  = "updated by acc test"
+  reset_secret = true
+}
+`, SBC_APIG_INSTANCE_ID, rName)
+}
@@ -0,0 +1,277 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceApigCustomAuthorizer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApigCustomAuthorizerCreate,
+		ReadContext:   resourceApigCustomAuthorizerRead,
+		UpdateContext: resourceApigCustomAuthorizerUpdate,
+		DeleteContext: resourceApigCustomAuthorizerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApigInstanceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z]\w{2,63}$`),
+					"the name consists of 3 to 64 characters, starting with a letter, only letters, digits and "+
+						"underscores (_) are allowed"),
+			},
+			"function_urn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// the API doesn't support changing the type of the authorizer
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "FRONTEND",
+				ValidateFunc: validation.StringInSlice([]string{"FRONTEND", "BACKEND"}, false),
+			},
+			"function_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 3600),
+			},
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"location": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"HEADER", "QUERY"}, false),
+						},
+						"validation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildApigAuthorizerIdentities(identities []interface{}) []map[string]interface{} {
+	if len(identities) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(identities))
+	for i, v := range identities {
+		identity := v.(map[string]interface{})
+		result[i] = map[string]interface{}{
+			"name":       identity["name"],
+			"location":   identity["location"],
+			"validation": valueIgnoreEmpty(identity["validation"]),
+		}
+	}
+	return result
+}
+
+func buildApigCustomAuthorizerBodyParams(d *schema.ResourceData) (map[string]interface{}, error) {
+	authType := d.Get("type").(string)
+	identities := d.Get("identity").([]interface{})
+	if len(identities) > 0 && authType != "FRONTEND" {
+		return nil, fmt.Errorf("the identities can only be set when the type is FRONTEND")
+	}
+
+	// the custom authorizers only support the FunctionGraph functions
+	bodyParams := map[string]interface{}{
+		"name":               d.Get("name"),
+		"type":               authType,
+		"authorizer_type":    "FUNC",
+		"authorizer_uri":     d.Get("function_urn"),
+		"authorizer_version": valueIgnoreEmpty(d.Get("function_version")),
+		"ttl":                d.Get("ttl"),
+		"user_data":          d.Get("user_data"),
+		"identities":         buildApigAuthorizerIdentities(identities),
+	}
+	return utils.RemoveNil(bodyParams), nil
+}
+
+func resourceApigCustomAuthorizerCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	bodyParams, err := buildApigCustomAuthorizerBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := d.Get("instance_id").(string)
+	resp, err := client.Request("POST", client.ServiceURL("instances", instanceID, "authorizers"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         bodyParams,
+			OkCodes:          []int{201},
+		})
+	if err != nil {
+		return diag.Errorf("error creating APIG custom authorizer in instance (%s): %s", instanceID, err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the APIG custom authorizer ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceApigCustomAuthorizerRead(ctx, d, meta)
+}
+
+func flattenApigAuthorizerIdentities(identities []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(identities))
+	for i, identity := range identities {
+		result[i] = map[string]interface{}{
+			"name":       pathSearch("name", identity, nil),
+			"location":   pathSearch("location", identity, nil),
+			"validation": pathSearch("validation", identity, nil),
+		}
+	}
+	return result
+}
+
+func resourceApigCustomAuthorizerRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ApigV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("instances", d.Get("instance_id").(string), "authorizers",
+		d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving APIG custom authorizer")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("function_urn", pathSearch("authorizer_uri", respBody, nil)),
+		d.Set("type", pathSearch("type", respBody, nil)),
+		d.Set("function_version", pathSearch("authorizer_version", respBody, nil)),
+		d.Set("ttl", pathSearch("ttl", respBody, nil)),
+		d.Set("user_data", pathSearch("user_data", respBody, nil)),
+		d.Set("identity", flattenApigAuthorizerIdentities(
+			pathSearch("identities", respBody, []interface{}{}).([]interface{}))),
+		d.Set("created_at", pathSearch("create_time", respBody, nil)),
+		d.Set("updated_at", pathSearch("update_time", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting APIG custom authorizer fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApigCustomAuthorizerUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	// the API replaces the whole authorizer, so all the parameters are sent
+	bodyParams, err := buildApigCustomAuthorizerBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.Request("PUT", client.ServiceURL("instances", d.Get("instance_id").(string), "authorizers",
+		d.Id()), &golangsdk.RequestOpts{
+		JSONBody: bodyParams,
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating APIG custom authorizer (%s): %s", d.Id(), err)
+	}
+
+	return resourceApigCustomAuthorizerRead(ctx, d, meta)
+}
+
+func resourceApigCustomAuthorizerDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("instances", d.Get("instance_id").(string), "authorizers",
+		d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting APIG custom authorizer")
+	}
+
+	return nil
+}
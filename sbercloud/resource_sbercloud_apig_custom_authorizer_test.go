@@ -0,0 +1,150 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccApigCustomAuthorizer_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "sbercloud_apig_custom_authorizer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckApigInstance(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApigCustomAuthorizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApigCustomAuthorizer_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApigCustomAuthorizerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "FRONTEND"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_urn", "sbercloud_fgs_function.test", "urn"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "user_data", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.name", "X-Auth-Token"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.location", "HEADER"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccApigCustomAuthorizer_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApigCustomAuthorizerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_data", "updated by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "identity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "identity.1.location", "QUERY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApigInstanceSubResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func getApigCustomAuthorizer(rs *terraform.ResourceState) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.ApigV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+	}
+
+	_, err = client.Request("GET", client.ServiceURL("instances", rs.Primary.Attributes["instance_id"],
+		"authorizers", rs.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	return err
+}
+
+func testAccCheckApigCustomAuthorizerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_apig_custom_authorizer" {
+			continue
+		}
+
+		if err := getApigCustomAuthorizer(rs); err == nil {
+			return fmt.Errorf("APIG custom authorizer still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckApigCustomAuthorizerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return getApigCustomAuthorizer(rs)
+	}
+}
+
+func testAccApigCustomAuthorizer_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_apig_custom_authorizer" "test" {
+  instance_id  = "%s"
+  name         = "%s"
+  function_urn = sbercloud_fgs_function.test.urn
+  type         = "FRONTEND"
+  ttl          = 60
+  user_data    = "created by acc test"
+
+  identity {
+    name     = "X-Auth-Token"
+    location = "HEADER"
+  }
+}
+`, testAccFgsV2Function_basic(rName), SBC_APIG_INSTANCE_ID, rName)
+}
+
+func testAccApigCustomAuthorizer_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_apig_custom_authorizer" "test" {
+  instance_id  = "%s"
+  name         = "%s_update"
+  function_urn = sbercloud_fgs_function.test.urn
+  type         = "FRONTEND"
+  ttl          = 0
+  user_data    = "updated by acc test"
+
+  identity {
+    name     = "X-Auth-Token"
+    location = "HEADER"
+  }
+  identity {
+    name       = "tenant"
+    location   = "QUERY"
+    validation = "^[a-z]+$"
+  }
+}
+`, testAccFgsV2Function_basic(rName), SBC_APIG_INSTANCE_ID, rName)
+}
@@ -0,0 +1,174 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/environments"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceApigEnvironment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApigEnvironmentCreate,
+		ReadContext:   resourceApigEnvironmentRead,
+		UpdateContext: resourceApigEnvironmentUpdate,
+		DeleteContext: resourceApigEnvironmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApigInstanceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z]\w{2,63}$`),
+					"the name consists of 3 to 64 characters, starting with a letter, only letters, digits and "+
+						"underscores (_) are allowed"),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^<>]{1,255}$`),
+					"the description contains a maximum of 255 characters and can't contain angle brackets (< and >)"),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceApigInstanceSubResourceImportState imports the resources which belong to an APIG instance, the import ID
+// consists of the instance ID and the resource ID.
+func resourceApigInstanceSubResourceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
+
+// getApigEnvironment returns the environment of the instance, the API can only list the environments.
+func getApigEnvironment(client *golangsdk.ServiceClient, instanceID, envID string) (*environments.Environment, error) {
+	pages, err := environments.List(client, instanceID, environments.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	envs, err := environments.ExtractEnvironments(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, env := range envs {
+		if env.Id == envID {
+			return &env, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func buildApigEnvironmentOpts(d *schema.ResourceData) environments.EnvironmentOpts {
+	description := d.Get("description").(string)
+	return environments.EnvironmentOpts{
+		Name:        d.Get("name").(string),
+		Description: &description,
+	}
+}
+
+func resourceApigEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	env, err := environments.Create(client, instanceID, buildApigEnvironmentOpts(d)).Extract()
+	if err != nil {
+		return diag.Errorf("error creating APIG environment in instance (%s): %s", instanceID, err)
+	}
+	d.SetId(env.Id)
+
+	return resourceApigEnvironmentRead(ctx, d, meta)
+}
+
+func resourceApigEnvironmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ApigV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	env, err := getApigEnvironment(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving APIG environment")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", env.Name),
+		d.Set("description", env.Description),
+		d.Set("created_at", env.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting APIG environment fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApigEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	// the name is required by the API even if only the description is changed
+	_, err = environments.Update(client, d.Get("instance_id").(string), d.Id(), buildApigEnvironmentOpts(d)).Extract()
+	if err != nil {
+		return diag.Errorf("error updating APIG environment (%s): %s", d.Id(), err)
+	}
+
+	return resourceApigEnvironmentRead(ctx, d, meta)
+}
+
+func resourceApigEnvironmentDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	if err := environments.Delete(client, d.Get("instance_id").(string), d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting APIG environment")
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/environments"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccApigEnvironment_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "sbercloud_apig_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckApigInstance(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApigEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApigEnvironment_basic(rName, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccApigEnvironment_basic(rName+"_update", "updated by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApigEnvironmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApigInstanceSubResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccApigEnvironmentVariable_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "sbercloud_apig_environment_variable.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckApigGroup(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApigEnvironmentVariableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApigEnvironmentVariable_basic(rName, "https://backend.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id",
+						"sbercloud_apig_environment.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", SBC_APIG_GROUP_ID),
+					resource.TestCheckResourceAttr(resourceName, "variable_name", "backend_url"),
+					resource.TestCheckResourceAttr(resourceName, "variable_value", "https://backend.example.com"),
+				),
+			},
+			{
+				Config: testAccApigEnvironmentVariable_basic(rName, "https://backend.example.com:8443/v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "variable_value",
+						"https://backend.example.com:8443/v2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApigInstanceSubResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckApigEnvironmentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.ApigV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_apig_environment" {
+			continue
+		}
+
+		if _, err := getApigEnvironment(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID); err == nil {
+			return fmt.Errorf("APIG environment still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckApigEnvironmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		client, err := config.ApigV2Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+		}
+
+		_, err = getApigEnvironment(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCheckApigEnvironmentVariableDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.ApigV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_apig_environment_variable" {
+			continue
+		}
+
+		_, err := environments.GetVariable(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("APIG environment variable still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return testAccCheckApigEnvironmentDestroy(s)
+}
+
+func testAccApigInstanceSubResourceImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccApigEnvironment_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_apig_environment" "test" {
+  instance_id = "%s"
+  name        = "%s"
+  description = "%s"
+}
+`, SBC_APIG_INSTANCE_ID, rName, description)
+}
+
+func testAccApigEnvironmentVariable_basic(rName, value string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_apig_environment_variable" "test" {
+  instance_id    = "%s"
+  environment_id = sbercloud_apig_environment.test.id
+  group_id       = "%s"
+  variable_name  = "backend_url"
+  variable_value = "%s"
+}
+`, testAccApigEnvironment_basic(rName, "created by acc test"), SBC_APIG_INSTANCE_ID, SBC_APIG_GROUP_ID, value)
+}
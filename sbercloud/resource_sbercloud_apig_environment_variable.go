@@ -0,0 +1,157 @@
+package sbercloud
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/environments"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceApigEnvironmentVariable manages the variable of an API group in an environment, the #Name# placeholders in
+// the API definitions are replaced by the variable values of the environment to which the API is published.
+func ResourceApigEnvironmentVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApigEnvironmentVariableCreate,
+		ReadContext:   resourceApigEnvironmentVariableRead,
+		UpdateContext: resourceApigEnvironmentVariableUpdate,
+		DeleteContext: resourceApigEnvironmentVariableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApigInstanceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"variable_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z][\w-]{2,31}$`),
+					"the name consists of 3 to 32 characters, starting with a letter, only letters, digits, "+
+						"hyphens (-) and underscores (_) are allowed"),
+			},
+			"variable_value": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[\w./:-]{1,255}$`),
+					"the value consists of 1 to 255 characters, only letters, digits and special characters "+
+						"(_-/.:) are allowed"),
+			},
+		},
+	}
+}
+
+func resourceApigEnvironmentVariableCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	createOpts := environments.CreateVariableOpts{
+		Name:    d.Get("variable_name").(string),
+		Value:   d.Get("variable_value").(string),
+		EnvId:   d.Get("environment_id").(string),
+		GroupId: d.Get("group_id").(string),
+	}
+	variable, err := environments.CreateVariable(client, d.Get("instance_id").(string), createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating APIG environment variable: %s", err)
+	}
+	d.SetId(variable.Id)
+
+	return resourceApigEnvironmentVariableRead(ctx, d, meta)
+}
+
+func resourceApigEnvironmentVariableRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ApigV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	variable, err := environments.GetVariable(client, d.Get("instance_id").(string), d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving APIG environment variable")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("environment_id", variable.EnvId),
+		d.Set("group_id", variable.GroupId),
+		d.Set("variable_name", variable.Name),
+		d.Set("variable_value", variable.Value),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting APIG environment variable fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApigEnvironmentVariableUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	// the value is the only parameter which can be modified
+	_, err = client.Request("PUT", client.ServiceURL("instances", d.Get("instance_id").(string), "env-variables",
+		d.Id()), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"variable_value": d.Get("variable_value"),
+		},
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating APIG environment variable (%s): %s", d.Id(), err)
+	}
+
+	return resourceApigEnvironmentVariableRead(ctx, d, meta)
+}
+
+func resourceApigEnvironmentVariableDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ApigV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	if err := environments.DeleteVariable(client, d.Get("instance_id").(string), d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting APIG environment variable")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/apig"
+)
+
+// ResourceApigThrottlingPolicy extends the throttling policy resource of the apig package with the plan-time check
+// of the request limits, the API only rejects the invalid limits when the policy is created or updated.
+func ResourceApigThrottlingPolicy() *schema.Resource {
+	policy := apig.ResourceApigThrottlingPolicyV2()
+	policy.CustomizeDiff = resourceApigThrottlingPolicyCustomizeDiff
+	return policy
+}
+
+// resourceApigThrottlingPolicyCustomizeDiff makes sure that the limit of a single user doesn't exceed the limit of
+// the API.
+func resourceApigThrottlingPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("max_api_requests") || !d.NewValueKnown("max_user_requests") {
+		return nil
+	}
+
+	apiRequests, userRequests := d.Get("max_api_requests").(int), d.Get("max_user_requests").(int)
+	if userRequests > apiRequests {
+		return fmt.Errorf("max_user_requests (%d) can't be greater than max_api_requests (%d)",
+			userRequests, apiRequests)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package sbercloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/apigw/dedicated/v2/throttles"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccApigThrottlingPolicy_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "sbercloud_apig_throttling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckApigInstance(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApigThrottlingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApigThrottlingPolicy_basic(rName, 100, 200),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`max_user_requests \(200\) can't be greater than max_api_requests \(100\)`),
+			},
+			{
+				Config: testAccApigThrottlingPolicy_basic(rName, 100, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApigThrottlingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "API-based"),
+					resource.TestCheckResourceAttr(resourceName, "period", "10"),
+					resource.TestCheckResourceAttr(resourceName, "period_unit", "SECOND"),
+					resource.TestCheckResourceAttr(resourceName, "max_api_requests", "100"),
+					resource.TestCheckResourceAttr(resourceName, "max_user_requests", "50"),
+					resource.TestCheckResourceAttr(resourceName, "max_app_requests", "50"),
+					resource.TestCheckResourceAttr(resourceName, "max_ip_requests", "20"),
+				),
+			},
+			{
+				Config: testAccApigThrottlingPolicy_basic(rName, 200, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApigThrottlingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_api_requests", "200"),
+					resource.TestCheckResourceAttr(resourceName, "max_user_requests", "200"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccApigThrottlingPolicyImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccApigApiThrottlingPolicy_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "sbercloud_apig_api_throttling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckApigPublish(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApigThrottlingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApigApiThrottlingPolicy_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "instance_id", SBC_APIG_INSTANCE_ID),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id",
+						"sbercloud_apig_throttling_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "publish_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApigThrottlingPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.ApigV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_apig_throttling_policy" {
+			continue
+		}
+
+		_, err := throttles.Get(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("APIG throttling policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckApigThrottlingPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		client, err := config.ApigV2Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating sbercloud APIG v2 client: %s", err)
+		}
+
+		_, err = throttles.Get(client, rs.Primary.Attributes["instance_id"], rs.Primary.ID).Extract()
+		return err
+	}
+}
+
+func testAccApigThrottlingPolicyImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
+func testAccApigThrottlingPolicy_basic(rName string, apiRequests, userRequests int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_apig_throttling_policy" "test" {
+  instance_id       = "%s"
+  name              = "%s"
+  description       = "created by acc test"
+  type              = "API-based"
+  period            = 10
+  period_unit       = "SECOND"
+  max_api_requests  = %d
+  max_user_requests = %d
+  max_app_requests  = 50
+  max_ip_requests   = 20
+}
+`, SBC_APIG_INSTANCE_ID, rName, apiRequests, userRequests)
+}
+
+func testAccApigApiThrottlingPolicy_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_apig_api_throttling_policy" "test" {
+  instance_id = "%s"
+  policy_id   = sbercloud_apig_throttling_policy.test.id
+  publish_ids = ["%s"]
+}
+`, testAccApigThrottlingPolicy_basic(rName, 100, 50), SBC_APIG_INSTANCE_ID, SBC_APIG_PUBLISH_ID)
+}
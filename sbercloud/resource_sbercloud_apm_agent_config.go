@@ -0,0 +1,239 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceApmAgentConfig manages the agent configuration of an APM component. The configuration is a singleton of
+// the component, so the creation adopts the existing configuration and the deletion restores the default one.
+func ResourceApmAgentConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApmAgentConfigCreate,
+		ReadContext:   resourceApmAgentConfigRead,
+		UpdateContext: resourceApmAgentConfigUpdate,
+		DeleteContext: resourceApmAgentConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApmAgentConfigImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"component_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"categories": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sample_rate": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"log_sample_rate": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"slow_request_threshold_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"error_rate_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"db_slow_query_threshold_ms": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func buildApmAgentConfigPath(client *golangsdk.ServiceClient, d *schema.ResourceData) string {
+	return client.ServiceURL("apm-service", "apps", d.Get("application_id").(string), "components",
+		d.Get("component_id").(string), "envs", d.Get("environment_id").(string), "agent-config")
+}
+
+func buildApmAgentConfigBodyParams(d *schema.ResourceData, conf *config.Config) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"eps_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	if v, ok := d.GetOk("categories"); ok {
+		bodyParams["categories"] = utils.ExpandToStringList(v.([]interface{}))
+	}
+	for param, key := range map[string]string{
+		"sample_rate":             "sample_rate",
+		"log_sample_rate":         "log_sample_rate",
+		"slow_request_threshold":  "slow_request_threshold_ms",
+		"error_rate_threshold":    "error_rate_threshold",
+		"db_slow_query_threshold": "db_slow_query_threshold_ms",
+	} {
+		// the zero rates are valid, so the values are sent whenever they are configured
+		if v, ok := d.GetOkExists(key); ok {
+			bodyParams[param] = v
+		}
+	}
+	return utils.RemoveNil(bodyParams)
+}
+
+func resourceApmAgentConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "apm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	configPath := buildApmAgentConfigPath(client, d)
+	bodyParams := buildApmAgentConfigBodyParams(d, conf)
+	_, err = client.Request("POST", configPath, &golangsdk.RequestOpts{
+		JSONBody: bodyParams,
+		OkCodes:  []int{200, 201},
+	})
+	// the component already has the configuration, adopt it and apply the arguments
+	if e, ok := err.(golangsdk.ErrUnexpectedResponseCode); ok && e.Actual == 409 {
+		_, err = client.Request("PUT", configPath, &golangsdk.RequestOpts{
+			JSONBody: bodyParams,
+		})
+	}
+	if err != nil {
+		return diag.Errorf("error creating APM agent configuration: %s", err)
+	}
+	d.SetId(d.Get("component_id").(string))
+
+	return resourceApmAgentConfigRead(ctx, d, meta)
+}
+
+func resourceApmAgentConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "apm", region)
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	// the configuration is gone with the application, the 404 error clears the state
+	resp, err := client.Request("GET", buildApmAgentConfigPath(client, d), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving APM agent configuration")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("categories", pathSearch("categories", respBody, nil)),
+		d.Set("sample_rate", pathSearch("sample_rate", respBody, nil)),
+		d.Set("log_sample_rate", pathSearch("log_sample_rate", respBody, nil)),
+		d.Set("slow_request_threshold_ms", pathSearch("slow_request_threshold", respBody, nil)),
+		d.Set("error_rate_threshold", pathSearch("error_rate_threshold", respBody, nil)),
+		d.Set("db_slow_query_threshold_ms", pathSearch("db_slow_query_threshold", respBody, nil)),
+		d.Set("enterprise_project_id", pathSearch("eps_id", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting APM agent configuration fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApmAgentConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "apm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	_, err = client.Request("PUT", buildApmAgentConfigPath(client, d), &golangsdk.RequestOpts{
+		JSONBody: buildApmAgentConfigBodyParams(d, conf),
+	})
+	if err != nil {
+		return diag.Errorf("error updating APM agent configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceApmAgentConfigRead(ctx, d, meta)
+}
+
+// resourceApmAgentConfigDelete restores the default configuration of the component.
+func resourceApmAgentConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "apm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", buildApmAgentConfigPath(client, d), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting APM agent configuration")
+	}
+
+	return nil
+}
+
+func resourceApmAgentConfigImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid format specified for import ID, want "+
+			"'<application_id>/<environment_id>/<component_id>', but got '%s'", d.Id())
+	}
+
+	d.SetId(parts[2])
+	mErr := multierror.Append(nil,
+		d.Set("application_id", parts[0]),
+		d.Set("environment_id", parts[1]),
+		d.Set("component_id", parts[2]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
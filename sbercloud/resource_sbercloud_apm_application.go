@@ -0,0 +1,187 @@
+package sbercloud
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceApmApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApmApplicationCreate,
+		ReadContext:   resourceApmApplicationRead,
+		UpdateContext: resourceApmApplicationUpdate,
+		DeleteContext: resourceApmApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"eps_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApmApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "apm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	epsID := d.Get("eps_id").(string)
+	if epsID == "" {
+		epsID = conf.EnterpriseProjectID
+	}
+	createOpts := map[string]interface{}{
+		"app_name": d.Get("name"),
+		"descp":    valueIgnoreEmpty(d.Get("description")),
+		"eps_id":   valueIgnoreEmpty(epsID),
+		"tags":     valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("cmdb", "apps"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating APM application: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the application ID is a number in the API response
+	id := pathSearch("id", respBody, float64(0)).(float64)
+	if id == 0 {
+		return diag.Errorf("unable to find the APM application ID from the API response")
+	}
+	d.SetId(strconv.FormatInt(int64(id), 10))
+
+	return resourceApmApplicationRead(ctx, d, meta)
+}
+
+func resourceApmApplicationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "apm", region)
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("cmdb", "apps", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving APM application")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("app_name", respBody, nil)),
+		d.Set("description", pathSearch("descp", respBody, nil)),
+		d.Set("eps_id", pathSearch("eps_id", respBody, nil)),
+		d.Set("tags", flattenResponseTags("tags", respBody)),
+		d.Set("app_id", d.Id()),
+		d.Set("created_at", flattenApmApplicationCreatedAt(respBody)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting APM application fields: %s", err)
+	}
+
+	return nil
+}
+
+// flattenApmApplicationCreatedAt converts the creation time, which is a unix timestamp in milliseconds.
+func flattenApmApplicationCreatedAt(respBody interface{}) string {
+	createTime := pathSearch("create_time", respBody, float64(0)).(float64)
+	if createTime == 0 {
+		return ""
+	}
+	return utils.FormatTimeStampRFC3339(int64(createTime) / 1000)
+}
+
+func resourceApmApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "apm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"app_name": d.Get("name"),
+		"descp":    d.Get("description"),
+		"tags":     utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("cmdb", "apps", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating APM application (%s): %s", d.Id(), err)
+	}
+
+	return resourceApmApplicationRead(ctx, d, meta)
+}
+
+func resourceApmApplicationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "apm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating APM client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("cmdb", "apps", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting APM application")
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// scalingPolicySchema returns the schema shared by the AS policies. The action block differs between the scaling
+// resources, so it's specified by the caller.
+func scalingPolicySchema(action *schema.Resource) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"scaling_policy_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ALARM", "SCHEDULED", "RECURRENCE"}, false),
+		},
+		"alarm_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"scheduled_policy": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"launch_time": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"recurrence_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"Daily", "Weekly", "Monthly"}, false),
+					},
+					"recurrence_value": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"start_time": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"end_time": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"scaling_policy_action": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem:     action,
+		},
+		"cool_down_time": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  900,
+		},
+	}
+}
+
+// checkScalingPolicyParameters checks the trigger of the policy, which depends on the policy type.
+func checkScalingPolicyParameters(d *schema.ResourceData) error {
+	policyType := d.Get("scaling_policy_type").(string)
+	if policyType == "ALARM" && d.Get("alarm_id").(string) == "" {
+		return fmt.Errorf("alarm_id is required when the scaling_policy_type is ALARM")
+	}
+	if policyType != "ALARM" && len(d.Get("scheduled_policy").([]interface{})) == 0 {
+		return fmt.Errorf("scheduled_policy is required when the scaling_policy_type is %s", policyType)
+	}
+	if policyType == "RECURRENCE" && d.Get("scheduled_policy.0.recurrence_type").(string) == "" {
+		return fmt.Errorf("recurrence_type is required when the scaling_policy_type is RECURRENCE")
+	}
+	return nil
+}
+
+func expandScalingScheduledPolicy(rawPolicies []interface{}) map[string]interface{} {
+	if len(rawPolicies) == 0 || rawPolicies[0] == nil {
+		return nil
+	}
+
+	policy := rawPolicies[0].(map[string]interface{})
+	return map[string]interface{}{
+		"launch_time":      policy["launch_time"],
+		"recurrence_type":  valueIgnoreEmpty(policy["recurrence_type"]),
+		"recurrence_value": valueIgnoreEmpty(policy["recurrence_value"]),
+		"start_time":       valueIgnoreEmpty(policy["start_time"]),
+		"end_time":         valueIgnoreEmpty(policy["end_time"]),
+	}
+}
+
+func flattenScalingScheduledPolicy(policy interface{}) []map[string]interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"launch_time":      pathSearch("launch_time", policy, nil),
+			"recurrence_type":  pathSearch("recurrence_type", policy, nil),
+			"recurrence_value": pathSearch("recurrence_value", policy, nil),
+			"start_time":       pathSearch("start_time", policy, nil),
+			"end_time":         pathSearch("end_time", policy, nil),
+		},
+	}
+}
+
+func ResourceASBandwidthPolicy() *schema.Resource {
+	policySchema := scalingPolicySchema(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"operation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ADD", "REDUCE", "SET"}, false),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	})
+	policySchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 64),
+	}
+	policySchema["bandwidth_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	policySchema["scaling_resource_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "BANDWIDTH",
+		ValidateFunc: validation.StringInSlice([]string{"BANDWIDTH"}, false),
+	}
+	policySchema["status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceASBandwidthPolicyCreate,
+		ReadContext:   resourceASBandwidthPolicyRead,
+		UpdateContext: resourceASBandwidthPolicyUpdate,
+		DeleteContext: resourceASBandwidthPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: policySchema,
+	}
+}
+
+func buildASBandwidthPolicyBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"scaling_policy_name":   d.Get("name"),
+		"scaling_resource_id":   d.Get("bandwidth_id"),
+		"scaling_resource_type": d.Get("scaling_resource_type"),
+		"scaling_policy_type":   d.Get("scaling_policy_type"),
+		"alarm_id":              valueIgnoreEmpty(d.Get("alarm_id")),
+		"scheduled_policy":      expandScalingScheduledPolicy(d.Get("scheduled_policy").([]interface{})),
+		"cool_down_time":        d.Get("cool_down_time"),
+	}
+	if rawActions := d.Get("scaling_policy_action").([]interface{}); len(rawActions) > 0 && rawActions[0] != nil {
+		action := rawActions[0].(map[string]interface{})
+		params["scaling_policy_action"] = utils.RemoveNil(map[string]interface{}{
+			"operation": valueIgnoreEmpty(action["operation"]),
+			"size":      valueIgnoreEmpty(action["size"]),
+		})
+	}
+	return utils.RemoveNil(params)
+}
+
+func resourceASBandwidthPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "asv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AS v2 client: %s", err)
+	}
+
+	if err := checkScalingPolicyParameters(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("scaling_policy"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildASBandwidthPolicyBodyParams(d),
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error creating AS bandwidth policy: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("scaling_policy_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the AS bandwidth policy ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceASBandwidthPolicyRead(ctx, d, meta)
+}
+
+func resourceASBandwidthPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "asv2", region)
+	if err != nil {
+		return diag.Errorf("error creating AS v2 client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("scaling_policy", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving AS bandwidth policy")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := pathSearch("scaling_policy", respBody, nil)
+	action := pathSearch("scaling_policy_action", policy, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("scaling_policy_name", policy, nil)),
+		d.Set("bandwidth_id", pathSearch("scaling_resource_id", policy, nil)),
+		d.Set("scaling_resource_type", pathSearch("scaling_resource_type", policy, nil)),
+		d.Set("scaling_policy_type", pathSearch("scaling_policy_type", policy, nil)),
+		d.Set("alarm_id", pathSearch("alarm_id", policy, nil)),
+		d.Set("scheduled_policy", flattenScalingScheduledPolicy(pathSearch("scheduled_policy", policy, nil))),
+		d.Set("scaling_policy_action", []map[string]interface{}{
+			{
+				"operation": pathSearch("operation", action, nil),
+				"size":      pathSearch("size", action, nil),
+			},
+		}),
+		d.Set("cool_down_time", pathSearch("cool_down_time", policy, nil)),
+		d.Set("status", pathSearch("policy_status", policy, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting AS bandwidth policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceASBandwidthPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "asv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating AS v2 client: %s", err)
+	}
+
+	if err := checkScalingPolicyParameters(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL("scaling_policy", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: buildASBandwidthPolicyBodyParams(d),
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating AS bandwidth policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceASBandwidthPolicyRead(ctx, d, meta)
+}
+
+func resourceASBandwidthPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	// the policies of all the scaling resource types are deleted by the v1 API
+	client, err := conf.AutoscalingV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("scaling_policy", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting AS bandwidth policy")
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccASBandwidthPolicy_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_as_bandwidth_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASBandwidthPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASBandwidthPolicy_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASBandwidthPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_resource_type", "BANDWIDTH"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_policy_type", "RECURRENCE"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_policy_action.0.operation", "ADD"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_policy_action.0.size", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "bandwidth_id",
+						"sbercloud_vpc_bandwidth.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testASBandwidthPolicy_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASBandwidthPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scaling_policy_action.0.size", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getASBandwidthPolicy(rs *terraform.ResourceState) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := NewServiceClient(config, "asv2", SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud autoscaling v2 client: %s", err)
+	}
+
+	_, err = client.Request("GET", client.ServiceURL("scaling_policy", rs.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	return err
+}
+
+func testAccCheckASBandwidthPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_as_bandwidth_policy" {
+			continue
+		}
+
+		if err := getASBandwidthPolicy(rs); err == nil {
+			return fmt.Errorf("AS bandwidth policy still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASBandwidthPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return getASBandwidthPolicy(rs)
+	}
+}
+
+func testASBandwidthPolicy_basic(rName string, size int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc_bandwidth" "test" {
+  name = "%[1]s"
+  size = 5
+}
+
+resource "sbercloud_as_bandwidth_policy" "test" {
+  name                = "%[1]s"
+  bandwidth_id        = sbercloud_vpc_bandwidth.test.id
+  scaling_policy_type = "RECURRENCE"
+  cool_down_time      = 600
+
+  scaling_policy_action {
+    operation = "ADD"
+    size      = %[2]d
+  }
+
+  scheduled_policy {
+    launch_time     = "07:00"
+    recurrence_type = "Daily"
+    end_time        = "2099-12-30T12:00Z"
+  }
+}
+`, rName, size)
+}
@@ -0,0 +1,234 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/lifecyclehooks"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceASLifecycleHook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceASLifecycleHookCreate,
+		ReadContext:   resourceASLifecycleHookRead,
+		UpdateContext: resourceASLifecycleHookUpdate,
+		DeleteContext: resourceASLifecycleHookDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceASLifecycleHookImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"scaling_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"lifecycle_hook_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 32),
+					validation.StringMatch(regexp.MustCompile(`^[\w-]+$`),
+						"only letters, digits, underscores (_) and hyphens (-) are allowed"),
+				),
+			},
+			"lifecycle_hook_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INSTANCE_LAUNCHING", "INSTANCE_TERMINATING"}, false),
+			},
+			"notification_topic_urn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"notification_metadata": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^()<>&']{1,256}$`),
+					"the notification metadata can't exceed 256 characters or contain any of the characters <>&'()"),
+			},
+			"heartbeat_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(300, 86400),
+			},
+			"default_result": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ABANDON",
+				ValidateFunc: validation.StringInSlice([]string{"ABANDON", "CONTINUE"}, false),
+			},
+			"notification_topic_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceASLifecycleHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.AutoscalingV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	createOpts := lifecyclehooks.CreateOpts{
+		Name:                 d.Get("lifecycle_hook_name").(string),
+		Type:                 d.Get("lifecycle_hook_type").(string),
+		DefaultResult:        d.Get("default_result").(string),
+		Timeout:              d.Get("heartbeat_timeout").(int),
+		NotificationTopicURN: d.Get("notification_topic_urn").(string),
+		NotificationMetadata: d.Get("notification_metadata").(string),
+	}
+	hook, err := lifecyclehooks.Create(client, createOpts, groupID).Extract()
+	if err != nil {
+		return diag.Errorf("error creating AS lifecycle hook: %s", err)
+	}
+	d.SetId(hook.Name)
+
+	return resourceASLifecycleHookRead(ctx, d, meta)
+}
+
+func resourceASLifecycleHookRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	hook, err := lifecyclehooks.Get(client, d.Get("scaling_group_id").(string), d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving AS lifecycle hook")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("lifecycle_hook_name", hook.Name),
+		d.Set("lifecycle_hook_type", hook.Type),
+		d.Set("notification_topic_urn", hook.NotificationTopicURN),
+		d.Set("notification_metadata", hook.NotificationMetadata),
+		d.Set("heartbeat_timeout", hook.Timeout),
+		d.Set("default_result", hook.DefaultResult),
+		d.Set("notification_topic_name", hook.NotificationTopicName),
+		d.Set("create_time", hook.CreateTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting AS lifecycle hook fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceASLifecycleHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.AutoscalingV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	updateOpts := lifecyclehooks.UpdateOpts{
+		Type:                 d.Get("lifecycle_hook_type").(string),
+		DefaultResult:        d.Get("default_result").(string),
+		Timeout:              d.Get("heartbeat_timeout").(int),
+		NotificationTopicURN: d.Get("notification_topic_urn").(string),
+		NotificationMetadata: d.Get("notification_metadata").(string),
+	}
+	_, err = lifecyclehooks.Update(client, updateOpts, d.Get("scaling_group_id").(string), d.Id()).Extract()
+	if err != nil {
+		return diag.Errorf("error updating AS lifecycle hook (%s): %s", d.Id(), err)
+	}
+
+	return resourceASLifecycleHookRead(ctx, d, meta)
+}
+
+// listASLifecycleHookPendingInstances returns the IDs of the instances suspended by the lifecycle hook and waiting
+// for the callback.
+func listASLifecycleHookPendingInstances(client *golangsdk.ServiceClient, groupID, hookName string) ([]string, error) {
+	resp, err := client.Request("GET", client.ServiceURL("scaling_instance_hook", groupID, "list"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("instance_hanging_info[?lifecycle_hook_name=='%s'&&lifecycle_hook_status=='HANGING']"+
+		".instance_id", hookName)
+	return utils.ExpandToStringList(pathSearch(expression, respBody, []interface{}{}).([]interface{})), nil
+}
+
+func resourceASLifecycleHookDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.AutoscalingV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	// the suspended instances don't block the deletion, they proceed with the default result once the hook is gone
+	var diags diag.Diagnostics
+	groupID := d.Get("scaling_group_id").(string)
+	instances, err := listASLifecycleHookPendingInstances(client, groupID, d.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Unable to check the instances suspended by AS lifecycle hook (%s)", d.Id()),
+			Detail:   err.Error(),
+		})
+	} else if len(instances) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary: fmt.Sprintf("AS lifecycle hook (%s) is deleted while instances are waiting for the callback",
+				d.Id()),
+			Detail: fmt.Sprintf("The instances %s won't wait for the callback any more.",
+				strings.Join(instances, ", ")),
+		})
+	}
+
+	if err := lifecyclehooks.Delete(client, groupID, d.Id()).ExtractErr(); err != nil {
+		return append(diags, common.CheckDeletedDiag(d, err, "error deleting AS lifecycle hook")...)
+	}
+
+	return diags
+}
+
+func resourceASLifecycleHookImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <scaling_group_id>/<lifecycle_hook_name>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("scaling_group_id", parts[0])
+}
@@ -0,0 +1,150 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/lifecyclehooks"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccASLifecycleHook_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_as_lifecycle_hook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckASLifecycleHookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASLifecycleHook_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASLifecycleHookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_hook_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_hook_type", "INSTANCE_LAUNCHING"),
+					resource.TestCheckResourceAttr(resourceName, "default_result", "ABANDON"),
+					resource.TestCheckResourceAttr(resourceName, "heartbeat_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "notification_metadata", "created by acc test"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_topic_urn",
+						"sbercloud_smn_topic.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "notification_topic_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+				),
+			},
+			{
+				Config: testASLifecycleHook_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASLifecycleHookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_hook_type", "INSTANCE_TERMINATING"),
+					resource.TestCheckResourceAttr(resourceName, "default_result", "CONTINUE"),
+					resource.TestCheckResourceAttr(resourceName, "heartbeat_timeout", "600"),
+					resource.TestCheckResourceAttr(resourceName, "notification_metadata", "updated by acc test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccASLifecycleHookImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckASLifecycleHookDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.AutoscalingV1Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_as_lifecycle_hook" {
+			continue
+		}
+
+		_, err := lifecyclehooks.Get(client, rs.Primary.Attributes["scaling_group_id"], rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("AS lifecycle hook still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckASLifecycleHookExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		client, err := config.AutoscalingV1Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating sbercloud autoscaling client: %s", err)
+		}
+
+		_, err = lifecyclehooks.Get(client, rs.Primary.Attributes["scaling_group_id"], rs.Primary.ID).Extract()
+		return err
+	}
+}
+
+func testAccASLifecycleHookImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["scaling_group_id"], rs.Primary.ID), nil
+	}
+}
+
+func testASLifecycleHook_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_smn_topic" "test" {
+  name = "%s"
+}
+`, testASV1Group_basic(rName), rName)
+}
+
+func testASLifecycleHook_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_as_lifecycle_hook" "test" {
+  scaling_group_id       = sbercloud_as_group.hth_as_group.id
+  lifecycle_hook_name    = "%s"
+  lifecycle_hook_type    = "INSTANCE_LAUNCHING"
+  notification_topic_urn = sbercloud_smn_topic.test.id
+  notification_metadata  = "created by acc test"
+}
+`, testASLifecycleHook_base(rName), rName)
+}
+
+func testASLifecycleHook_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_as_lifecycle_hook" "test" {
+  scaling_group_id       = sbercloud_as_group.hth_as_group.id
+  lifecycle_hook_name    = "%s"
+  lifecycle_hook_type    = "INSTANCE_TERMINATING"
+  notification_topic_urn = sbercloud_smn_topic.test.id
+  notification_metadata  = "updated by acc test"
+  heartbeat_timeout      = 600
+  default_result         = "CONTINUE"
+}
+`, testASLifecycleHook_base(rName), rName)
+}
@@ -0,0 +1,351 @@
+package sbercloud
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceAsmMesh() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAsmMeshCreate,
+		ReadContext:   resourceAsmMeshRead,
+		DeleteContext: resourceAsmMeshDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"InCluster", "Hosted"}, false),
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"conf": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"traffic": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"outbound_traffic_policy": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"ALLOW_ANY", "REGISTRY_ONLY",
+										}, false),
+									},
+									"tracing_sampling": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+						"istio": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_log_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"customization": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildAsmMeshConfig(d *schema.ResourceData) map[string]interface{} {
+	confs := d.Get("conf").([]interface{})
+	if len(confs) == 0 || confs[0] == nil {
+		return nil
+	}
+
+	conf := confs[0].(map[string]interface{})
+	result := make(map[string]interface{})
+	if traffics := conf["traffic"].([]interface{}); len(traffics) > 0 && traffics[0] != nil {
+		traffic := traffics[0].(map[string]interface{})
+		result["traffic"] = utils.RemoveNil(map[string]interface{}{
+			"outboundTrafficPolicy": valueIgnoreEmpty(traffic["outbound_traffic_policy"]),
+			"tracingSampling":       valueIgnoreEmpty(traffic["tracing_sampling"]),
+		})
+	}
+	if istios := conf["istio"].([]interface{}); len(istios) > 0 && istios[0] != nil {
+		istio := istios[0].(map[string]interface{})
+		result["istio"] = utils.RemoveNil(map[string]interface{}{
+			"accessLogEnabled": istio["access_log_enabled"],
+			"customization":    valueIgnoreEmpty(istio["customization"]),
+		})
+	}
+	return result
+}
+
+func flattenAsmMeshConfig(respBody interface{}) []map[string]interface{} {
+	conf := pathSearch("spec.config", respBody, nil)
+	if conf == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	if traffic := pathSearch("traffic", conf, nil); traffic != nil {
+		result["traffic"] = []map[string]interface{}{
+			{
+				"outbound_traffic_policy": pathSearch("outboundTrafficPolicy", traffic, nil),
+				"tracing_sampling":        pathSearch("tracingSampling", traffic, nil),
+			},
+		}
+	}
+	if istio := pathSearch("istio", conf, nil); istio != nil {
+		result["istio"] = []map[string]interface{}{
+			{
+				"access_log_enabled": pathSearch("accessLogEnabled", istio, nil),
+				"customization":      pathSearch("customization", istio, nil),
+			},
+		}
+	}
+	return []map[string]interface{}{result}
+}
+
+func resourceAsmMeshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "asm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ASM client: %s", err)
+	}
+
+	spec := map[string]interface{}{
+		"type":                d.Get("type"),
+		"version":             valueIgnoreEmpty(d.Get("version")),
+		"config":              buildAsmMeshConfig(d),
+		"tags":                utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+		"enterpriseProjectID": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"extendParams": map[string]interface{}{
+			"clusters": []map[string]interface{}{
+				{
+					"clusterID": d.Get("cluster_id"),
+				},
+			},
+		},
+	}
+	createOpts := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": d.Get("name"),
+		},
+		"spec": utils.RemoveNil(spec),
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("meshes"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error creating ASM mesh: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("metadata.uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the ASM mesh ID from the API response")
+	}
+	d.SetId(id)
+
+	err = waitForAsmRunning(ctx, client, client.ServiceURL("meshes", id), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the ASM mesh (%s) to be running: %s", id, err)
+	}
+
+	return resourceAsmMeshRead(ctx, d, meta)
+}
+
+// asmStateRefreshFunc is shared by the mesh and the mesh cluster, the phase of both is returned in lower case.
+func asmStateRefreshFunc(client *golangsdk.ServiceClient, url string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Request("GET", url, &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "", err
+		}
+		return respBody, strings.ToLower(pathSearch("status.phase", respBody, "").(string)), nil
+	}
+}
+
+func waitForAsmRunning(ctx context.Context, client *golangsdk.ServiceClient, url string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"", "pending", "creating", "installing", "upgrading"},
+		Target:       []string{"running"},
+		Refresh:      asmStateRefreshFunc(client, url),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func waitForAsmDeleted(ctx context.Context, client *golangsdk.ServiceClient, url string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"", "running", "deleting", "uninstalling", "failed"},
+		Target:       []string{"deleted"},
+		Refresh:      asmStateRefreshFunc(client, url),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceAsmMeshRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "asm", region)
+	if err != nil {
+		return diag.Errorf("error creating ASM client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("meshes", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ASM mesh")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("metadata.name", respBody, nil)),
+		d.Set("type", pathSearch("spec.type", respBody, nil)),
+		d.Set("cluster_id", pathSearch("spec.extendParams.clusters[0].clusterID", respBody, nil)),
+		d.Set("version", pathSearch("spec.version", respBody, nil)),
+		d.Set("conf", flattenAsmMeshConfig(respBody)),
+		d.Set("tags", flattenResponseTags("spec.tags", respBody)),
+		d.Set("enterprise_project_id", pathSearch("spec.enterpriseProjectID", respBody, nil)),
+		d.Set("status", pathSearch("status.phase", respBody, nil)),
+		d.Set("created_at", pathSearch("metadata.creationTimestamp", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ASM mesh fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAsmMeshDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "asm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ASM client: %s", err)
+	}
+
+	meshURL := client.ServiceURL("meshes", d.Id())
+	if _, err := client.Request("DELETE", meshURL, &golangsdk.RequestOpts{}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting ASM mesh")
+	}
+
+	if err := waitForAsmDeleted(ctx, client, meshURL, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the ASM mesh (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
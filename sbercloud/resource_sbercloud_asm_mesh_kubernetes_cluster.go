@@ -0,0 +1,203 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceAsmMeshKubernetesCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAsmMeshKubernetesClusterCreate,
+		ReadContext:   resourceAsmMeshKubernetesClusterRead,
+		DeleteContext: resourceAsmMeshKubernetesClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAsmMeshKubernetesClusterImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"mesh_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"installation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// the control plane components are installed on the nodes selected by the node IDs
+func buildAsmMeshClusterInstallation(d *schema.ResourceData) map[string]interface{} {
+	installation := d.Get("installation").([]interface{})[0].(map[string]interface{})
+	return map[string]interface{}{
+		"nodes": map[string]interface{}{
+			"fieldSelector": map[string]interface{}{
+				"key":      "UID",
+				"operator": "In",
+				"values":   utils.ExpandToStringList(installation["node_ids"].([]interface{})),
+			},
+		},
+	}
+}
+
+func asmMeshClusterURL(client *golangsdk.ServiceClient, meshID, clusterID string) string {
+	return client.ServiceURL("meshes", meshID, "clusters", clusterID)
+}
+
+func resourceAsmMeshKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "asm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ASM client: %s", err)
+	}
+
+	meshID := d.Get("mesh_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+	createOpts := map[string]interface{}{
+		"clusterID":    clusterID,
+		"installation": buildAsmMeshClusterInstallation(d),
+	}
+	_, err = client.Request("POST", client.ServiceURL("meshes", meshID, "clusters"), &golangsdk.RequestOpts{
+		JSONBody: createOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error adding cluster (%s) to ASM mesh (%s): %s", clusterID, meshID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", meshID, clusterID))
+
+	err = waitForAsmRunning(ctx, client, asmMeshClusterURL(client, meshID, clusterID),
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the cluster (%s) of ASM mesh (%s) to be running: %s",
+			clusterID, meshID, err)
+	}
+
+	return resourceAsmMeshKubernetesClusterRead(ctx, d, meta)
+}
+
+func flattenAsmMeshClusterInstallation(respBody interface{}) []map[string]interface{} {
+	nodeIDs := pathSearch("installation.nodes.fieldSelector.values", respBody, nil)
+	if nodeIDs == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"node_ids": nodeIDs,
+		},
+	}
+}
+
+func resourceAsmMeshKubernetesClusterRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "asm", region)
+	if err != nil {
+		return diag.Errorf("error creating ASM client: %s", err)
+	}
+
+	meshID := d.Get("mesh_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+	resp, err := client.Request("GET", asmMeshClusterURL(client, meshID, clusterID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ASM mesh cluster")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("installation", flattenAsmMeshClusterInstallation(respBody)),
+		d.Set("status", pathSearch("status.phase", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ASM mesh cluster fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAsmMeshKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "asm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ASM client: %s", err)
+	}
+
+	clusterURL := asmMeshClusterURL(client, d.Get("mesh_id").(string), d.Get("cluster_id").(string))
+	if _, err := client.Request("DELETE", clusterURL, &golangsdk.RequestOpts{}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error removing cluster from ASM mesh")
+	}
+
+	if err := waitForAsmDeleted(ctx, client, clusterURL, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the cluster to be removed from ASM mesh (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAsmMeshKubernetesClusterImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <mesh_id>/<cluster_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("mesh_id", parts[0]),
+		d.Set("cluster_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,380 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/bcs/v2/blockchains"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceBcsPeerNode() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBcsPeerNodeCreate,
+		ReadContext:   resourceBcsPeerNodeRead,
+		UpdateContext: resourceBcsPeerNodeUpdate,
+		DeleteContext: resourceBcsPeerNodeDelete,
+
+		CustomizeDiff: resourceBcsPeerNodeCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"org_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_node_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"peer_quantity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"SAS", "SSD"}, false),
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"peer_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceBcsPeerNodeCustomizeDiff makes sure that the organization keeps at least one peer, removing all peers of
+// an organization is only allowed by destroying the resource.
+func resourceBcsPeerNodeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("peer_quantity") {
+		return nil
+	}
+
+	if d.Get("peer_quantity").(int) < 1 {
+		return fmt.Errorf("at least one peer must be kept in the organization (%s), got peer_quantity %d",
+			d.Get("org_name").(string), d.Get("peer_quantity").(int))
+	}
+	return nil
+}
+
+func buildBcsPeerNodeStorage(rawStorages []interface{}) map[string]interface{} {
+	if len(rawStorages) == 0 || rawStorages[0] == nil {
+		return nil
+	}
+
+	raw := rawStorages[0].(map[string]interface{})
+	return map[string]interface{}{
+		"type": raw["type"],
+		"size": raw["size"],
+	}
+}
+
+// listBcsOrgPeers returns the sorted names of all peers in the organization and the peer details keyed by name.
+func listBcsOrgPeers(client *golangsdk.ServiceClient, instanceID, orgName string) ([]string,
+	map[string]blockchains.Node, error) {
+	orgs, err := blockchains.GetNodes(client, instanceID).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	org, ok := (*orgs)[orgName]
+	if !ok {
+		return nil, nil, nil
+	}
+
+	names := make([]string, 0, len(org.Peers))
+	for name := range org.Peers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, org.Peers, nil
+}
+
+func bcsPeerNodeStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := blockchains.Get(client, instanceID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if instance.Basic.ProcessStatus != "" {
+			return instance, instance.Basic.ProcessStatus, nil
+		}
+		if instance.Basic.Status == "Abnormal" {
+			return instance, instance.Basic.Status, fmt.Errorf("the BCS instance is in abnormal status")
+		}
+		return instance, instance.Basic.Status, nil
+	}
+}
+
+// waitForBcsInstanceNormal waits for the BCS instance to finish the scaling of the peers.
+func waitForBcsInstanceNormal(ctx context.Context, client *golangsdk.ServiceClient, instanceID string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"IsUpdating", "IsScaling", "IsCreating"},
+		Target:       []string{"Normal"},
+		Refresh:      bcsPeerNodeStateRefreshFunc(client, instanceID),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// addBcsPeers adds the peers to the organization and returns the names of the new peers.
+func addBcsPeers(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, conf *config.Config,
+	count int, timeout time.Duration) ([]string, error) {
+	instanceID := d.Get("instance_id").(string)
+	orgName := d.Get("org_name").(string)
+
+	oldPeers, _, err := listBcsOrgPeers(client, instanceID, orgName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the peers of the organization (%s): %s", orgName, err)
+	}
+
+	nodeOrg := map[string]interface{}{
+		"name":              orgName,
+		"node_count":        count,
+		"peer_node_name":    d.Get("peer_node_name"),
+		"availability_zone": valueIgnoreEmpty(d.Get("availability_zone")),
+		"storage":           buildBcsPeerNodeStorage(d.Get("storage").([]interface{})),
+	}
+	addOpts := map[string]interface{}{
+		"node_orgs":             []interface{}{utils.RemoveNil(nodeOrg)},
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	_, err = client.Request("POST", client.ServiceURL("blockchains", instanceID), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(addOpts),
+		OkCodes:  []int{200, 202},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding peers to the organization (%s): %s", orgName, err)
+	}
+
+	if err := waitForBcsInstanceNormal(ctx, client, instanceID, timeout); err != nil {
+		return nil, fmt.Errorf("error waiting for the peers of the organization (%s) to be added: %s", orgName, err)
+	}
+
+	newPeers, _, err := listBcsOrgPeers(client, instanceID, orgName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the peers of the organization (%s): %s", orgName, err)
+	}
+
+	added := make([]string, 0, count)
+	for _, name := range newPeers {
+		if !utils.StrSliceContains(oldPeers, name) {
+			added = append(added, name)
+		}
+	}
+	return added, nil
+}
+
+// deleteBcsPeers removes the peers one by one, because the API does not support deleting the peers in batches.
+func deleteBcsPeers(ctx context.Context, client *golangsdk.ServiceClient, instanceID, orgName string,
+	peerIDs []string, timeout time.Duration) error {
+	for _, peerID := range peerIDs {
+		deleteURL := client.ServiceURL("blockchains", instanceID, "orgs", orgName, "peers", peerID)
+		if _, err := client.Request("DELETE", deleteURL, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202, 204},
+		}); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting the peer (%s): %s", peerID, err)
+		}
+
+		if err := waitForBcsInstanceNormal(ctx, client, instanceID, timeout); err != nil {
+			return fmt.Errorf("error waiting for the peer (%s) to be deleted: %s", peerID, err)
+		}
+	}
+	return nil
+}
+
+func resourceBcsPeerNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BcsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating BCS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	orgName := d.Get("org_name").(string)
+	peerIDs, err := addBcsPeers(ctx, client, d, conf, d.Get("peer_quantity").(int), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(peerIDs) == 0 {
+		return diag.Errorf("unable to find the new peers in the organization (%s)", orgName)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, orgName, d.Get("peer_node_name").(string)))
+	if err := d.Set("peer_ids", peerIDs); err != nil {
+		return diag.Errorf("error saving the peer IDs: %s", err)
+	}
+
+	return resourceBcsPeerNodeRead(ctx, d, meta)
+}
+
+func resourceBcsPeerNodeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.BcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating BCS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	instance, err := blockchains.Get(client, instanceID).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving BCS instance")
+	}
+
+	_, peers, err := listBcsOrgPeers(client, instanceID, d.Get("org_name").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving the peers of the BCS instance (%s): %s", instanceID, err)
+	}
+
+	peerIDs := make([]string, 0)
+	for _, peerID := range utils.ExpandToStringList(d.Get("peer_ids").([]interface{})) {
+		if _, ok := peers[peerID]; ok {
+			peerIDs = append(peerIDs, peerID)
+		}
+	}
+	if len(peerIDs) == 0 {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving BCS peer node")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("peer_quantity", len(peerIDs)),
+		d.Set("peer_ids", peerIDs),
+		d.Set("status", instance.Basic.Status),
+		d.Set("api_path", peers[peerIDs[0]].Port),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting BCS peer node fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBcsPeerNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BcsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating BCS client: %s", err)
+	}
+
+	if d.HasChange("peer_quantity") {
+		oldVal, newVal := d.GetChange("peer_quantity")
+		peerIDs := utils.ExpandToStringList(d.Get("peer_ids").([]interface{}))
+		diff := newVal.(int) - oldVal.(int)
+		if diff > 0 {
+			added, err := addBcsPeers(ctx, client, d, conf, diff, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			peerIDs = append(peerIDs, added...)
+		} else if diff < 0 && len(peerIDs) > newVal.(int) {
+			// the last added peers are removed first
+			removed := peerIDs[newVal.(int):]
+			err := deleteBcsPeers(ctx, client, d.Get("instance_id").(string), d.Get("org_name").(string), removed,
+				d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			peerIDs = peerIDs[:newVal.(int)]
+		}
+
+		if err := d.Set("peer_ids", peerIDs); err != nil {
+			return diag.Errorf("error saving the peer IDs: %s", err)
+		}
+	}
+
+	return resourceBcsPeerNodeRead(ctx, d, meta)
+}
+
+func resourceBcsPeerNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BcsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating BCS client: %s", err)
+	}
+
+	peerIDs := utils.ExpandToStringList(d.Get("peer_ids").([]interface{}))
+	err = deleteBcsPeers(ctx, client, d.Get("instance_id").(string), d.Get("org_name").(string), peerIDs,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
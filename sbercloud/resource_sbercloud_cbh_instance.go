@@ -0,0 +1,404 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCbhInstance manages the CBH instances, which only support the prePaid charging mode.
+func ResourceCbhInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCbhInstanceCreate,
+		ReadContext:   resourceCbhInstanceRead,
+		UpdateContext: resourceCbhInstanceUpdate,
+		DeleteContext: resourceCbhInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"period_unit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"month", "year"}, false),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
+			"auto_renew": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			},
+			"public_ip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"server_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_volume_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// the period types of the CBH API
+var cbhPeriodTypes = map[string]int{
+	"month": 2,
+	"year":  3,
+}
+
+func buildCbhInstanceCreateOpts(d *schema.ResourceData, conf *config.Config, region string) map[string]interface{} {
+	server := map[string]interface{}{
+		"name":              d.Get("name"),
+		"instance_key":      d.Get("name"),
+		"flavor_ref":        d.Get("flavor_id"),
+		"vpc_id":            d.Get("vpc_id"),
+		"availability_zone": d.Get("availability_zone"),
+		"region":            region,
+		"bastion_type":      "OEM",
+		"hx_password":       d.Get("password"),
+		"nics": []map[string]interface{}{
+			{"subnet_id": d.Get("subnet_id")},
+		},
+		"security_groups": []map[string]interface{}{
+			{"id": d.Get("security_group_id")},
+		},
+		"period_type":           cbhPeriodTypes[d.Get("period_unit").(string)],
+		"period_num":            d.Get("period"),
+		"is_auto_renew":         d.Get("auto_renew").(string) == "true",
+		"is_auto_pay":           true,
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"tags":                  valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	if v, ok := d.GetOk("public_ip_id"); ok {
+		server["public_ip"] = map[string]interface{}{
+			"id": v,
+		}
+	}
+
+	return map[string]interface{}{
+		"server": utils.RemoveNil(server),
+	}
+}
+
+// findCbhInstance lists the instances to find the instance by the filter, the API does not support querying an
+// instance by its ID.
+func findCbhInstance(client *golangsdk.ServiceClient, filter func(interface{}) bool) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("cbs", "instance", "list"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instance := range pathSearch("instance", respBody, make([]interface{}, 0)).([]interface{}) {
+		if filter(instance) {
+			return instance, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func cbhInstanceStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := findCbhInstance(client, func(instance interface{}) bool {
+			return pathSearch("instance_id", instance, "").(string) == id
+		})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("status", instance, "").(string)
+		if status == "ERROR" {
+			return instance, status, fmt.Errorf("the CBH instance is in ERROR status")
+		}
+		return instance, status, nil
+	}
+}
+
+func resourceCbhInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "cbh", region)
+	if err != nil {
+		return diag.Errorf("error creating CBH client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("cbs", "period", "order"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildCbhInstanceCreateOpts(d, conf, region),
+	})
+	if err != nil {
+		return diag.Errorf("error creating CBH instance: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orderID := pathSearch("order_id", respBody, "").(string)
+	if orderID == "" {
+		return diag.Errorf("unable to find the order ID of the CBH instance from the API response")
+	}
+	if err := common.WaitOrderComplete(ctx, d, conf, orderID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the order does not return the instance ID, the instance key is set to the name
+	name := d.Get("name").(string)
+	instance, err := findCbhInstance(client, func(instance interface{}) bool {
+		return pathSearch("instance_key", instance, "").(string) == name
+	})
+	if err != nil {
+		return diag.Errorf("error finding the CBH instance (%s) after creation: %s", name, err)
+	}
+	d.SetId(pathSearch("instance_id", instance, "").(string))
+
+	// the provisioning takes about 5 to 15 minutes
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"BUILD", "CREATING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      cbhInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        2 * time.Minute,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the CBH instance (%s) to be active: %s", d.Id(), err)
+	}
+
+	return resourceCbhInstanceRead(ctx, d, meta)
+}
+
+func resourceCbhInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "cbh", region)
+	if err != nil {
+		return diag.Errorf("error creating CBH client: %s", err)
+	}
+
+	instance, err := findCbhInstance(client, func(instance interface{}) bool {
+		return pathSearch("instance_id", instance, "").(string) == d.Id()
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CBH instance")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", instance, nil)),
+		d.Set("vpc_id", pathSearch("vpc_id", instance, nil)),
+		d.Set("subnet_id", pathSearch("subnet_id", instance, nil)),
+		d.Set("security_group_id", pathSearch("security_group_id", instance, nil)),
+		d.Set("availability_zone", pathSearch("az", instance, nil)),
+		d.Set("public_ip_id", pathSearch("public_id", instance, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", instance, nil)),
+		d.Set("server_id", pathSearch("server_id", instance, nil)),
+		d.Set("private_ip", pathSearch("private_ip", instance, nil)),
+		d.Set("public_ip", pathSearch("public_ip", instance, nil)),
+		d.Set("status", pathSearch("status", instance, nil)),
+		d.Set("version", pathSearch("bastion_version", instance, nil)),
+		d.Set("data_volume_size", pathSearch("data_disk_size", instance, nil)),
+	)
+
+	resp, err := client.Request("GET", client.ServiceURL("cbh", d.Id(), "tags"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err == nil {
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mErr = multierror.Append(mErr, d.Set("tags", flattenResponseTags("tags", respBody)))
+	} else {
+		mErr = multierror.Append(mErr, fmt.Errorf("error retrieving tags of CBH instance: %s", err))
+	}
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CBH instance fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCbhInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cbh", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CBH client: %s", err)
+	}
+
+	if d.HasChange("password") {
+		passwordOpts := map[string]interface{}{
+			"new_password": d.Get("password"),
+			"server_id":    d.Get("server_id"),
+		}
+		_, err = client.Request("PUT", client.ServiceURL("cbs", "instance", "password"), &golangsdk.RequestOpts{
+			JSONBody: passwordOpts,
+			OkCodes:  []int{200, 204},
+		})
+		if err != nil {
+			return diag.Errorf("error resetting the password of CBH instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := utils.UpdateResourceTags(client, d, "cbh", d.Id()); err != nil {
+			return diag.Errorf("error updating tags of CBH instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceCbhInstanceRead(ctx, d, meta)
+}
+
+func resourceCbhInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cbh", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CBH client: %s", err)
+	}
+
+	// the instance must be stopped before it is unsubscribed
+	if d.Get("status").(string) != "SHUTOFF" {
+		stopOpts := map[string]interface{}{
+			"server_id": d.Get("server_id"),
+		}
+		_, err = client.Request("POST", client.ServiceURL("cbs", "instance", "stop"), &golangsdk.RequestOpts{
+			JSONBody: stopOpts,
+			OkCodes:  []int{200, 204},
+		})
+		if err != nil {
+			return common.CheckDeletedDiag(d, err, "error stopping CBH instance")
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"ACTIVE", "SHUTTING_DOWN"},
+			Target:       []string{"SHUTOFF"},
+			Refresh:      cbhInstanceStateRefreshFunc(client, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutDelete),
+			Delay:        10 * time.Second,
+			PollInterval: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("error waiting for the CBH instance (%s) to be stopped: %s", d.Id(), err)
+		}
+	}
+
+	if err := UnsubscribePrePaidResource(d, conf, []string{d.Id()}); err != nil {
+		return diag.Errorf("error unsubscribing CBH instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"SHUTOFF", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      cbhInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the CBH instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCBRBackupShare shares a backup with the projects of other accounts.
+func ResourceCBRBackupShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCBRBackupShareCreate,
+		ReadContext:   resourceCBRBackupShareRead,
+		UpdateContext: resourceCBRBackupShareUpdate,
+		DeleteContext: resourceCBRBackupShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func addCBRBackupMembers(client *golangsdk.ServiceClient, backupID string, members []string) error {
+	_, err := client.Request("POST", client.ServiceURL("backups", backupID, "members"), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"members": members,
+		},
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func removeCBRBackupMembers(client *golangsdk.ServiceClient, backupID string, members []string) error {
+	for _, member := range members {
+		_, err := client.Request("DELETE", client.ServiceURL("backups", backupID, "members", member),
+			&golangsdk.RequestOpts{
+				OkCodes: []int{204},
+			})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceCBRBackupShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	backupID := d.Get("backup_id").(string)
+	members := utils.ExpandToStringListBySet(d.Get("members").(*schema.Set))
+	if err := addCBRBackupMembers(client, backupID, members); err != nil {
+		return diag.Errorf("error sharing CBR backup (%s): %s", backupID, err)
+	}
+	d.SetId(backupID)
+
+	return resourceCBRBackupShareRead(ctx, d, meta)
+}
+
+func resourceCBRBackupShareRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CbrV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("backups", d.Id(), "members"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CBR backup members")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the members of all the statuses are kept, the pending and rejected shares are still shared
+	members := pathSearch("members[*].dest_project_id", respBody, []interface{}{})
+	if len(members.([]interface{})) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("backup_id", d.Id()),
+		d.Set("members", members),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CBR backup share fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCBRBackupShareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	oldRaw, newRaw := d.GetChange("members")
+	oldMembers, newMembers := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+	if removed := oldMembers.Difference(newMembers); removed.Len() > 0 {
+		if err := removeCBRBackupMembers(client, d.Id(), utils.ExpandToStringListBySet(removed)); err != nil {
+			return diag.Errorf("error unsharing CBR backup (%s): %s", d.Id(), err)
+		}
+	}
+	if added := newMembers.Difference(oldMembers); added.Len() > 0 {
+		if err := addCBRBackupMembers(client, d.Id(), utils.ExpandToStringListBySet(added)); err != nil {
+			return diag.Errorf("error sharing CBR backup (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceCBRBackupShareRead(ctx, d, meta)
+}
+
+func resourceCBRBackupShareDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	members := utils.ExpandToStringListBySet(d.Get("members").(*schema.Set))
+	if err := removeCBRBackupMembers(client, d.Id(), members); err != nil {
+		return diag.Errorf("error unsharing CBR backup (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk/openstack/cbr/v3/policies"
+	"github.com/chnsz/golangsdk/openstack/cbr/v3/vaults"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceCBRVaultAssociatePolicy binds a policy to a vault. It conflicts with the policy_id of sbercloud_cbr_vault,
+// only one of them should manage the association.
+func ResourceCBRVaultAssociatePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCBRVaultAssociatePolicyCreate,
+		ReadContext:   resourceCBRVaultAssociatePolicyRead,
+		DeleteContext: resourceCBRVaultAssociatePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCBRVaultAssociatePolicyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCBRVaultAssociatePolicyCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	vaultID := d.Get("vault_id").(string)
+	policyID := d.Get("policy_id").(string)
+	opts := vaults.BindPolicyOpts{
+		PolicyID: policyID,
+	}
+	if _, err := vaults.BindPolicy(client, vaultID, opts).Extract(); err != nil {
+		return diag.Errorf("error associating the CBR policy (%s) with the vault (%s): %s", policyID, vaultID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", vaultID, policyID))
+
+	return resourceCBRVaultAssociatePolicyRead(ctx, d, meta)
+}
+
+func resourceCBRVaultAssociatePolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CbrV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	vaultID := d.Get("vault_id").(string)
+	policyID := d.Get("policy_id").(string)
+	policy, err := policies.Get(client, policyID).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CBR policy")
+	}
+
+	associated := false
+	for _, vault := range policy.AssociatedVaults {
+		if vault.VaultID == vaultID {
+			associated = true
+			break
+		}
+	}
+	if !associated {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vault_id", vaultID),
+		d.Set("policy_id", policyID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CBR vault associate policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCBRVaultAssociatePolicyDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	vaultID := d.Get("vault_id").(string)
+	opts := vaults.BindPolicyOpts{
+		PolicyID: d.Get("policy_id").(string),
+	}
+	if _, err := vaults.UnbindPolicy(client, vaultID, opts).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error dissociating the CBR policy from the vault")
+	}
+
+	return nil
+}
+
+func resourceCBRVaultAssociatePolicyImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <vault_id>/<policy_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("vault_id", parts[0]),
+		d.Set("policy_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,299 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk/openstack/cbr/v3/vaults"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	cbrResourceTypeServer = "OS::Nova::Server"
+	cbrResourceTypeVolume = "OS::Cinder::Volume"
+)
+
+// ResourceCBRVaultResourceAttach associates servers and volumes with an existing vault. Only the resources managed by
+// this resource are dissociated on deletion, the other resources of the vault are not affected. It conflicts with the
+// resources of sbercloud_cbr_vault, only one of them should manage a resource.
+func ResourceCBRVaultResourceAttach() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCBRVaultResourceAttachCreate,
+		ReadContext:   resourceCBRVaultResourceAttachRead,
+		UpdateContext: resourceCBRVaultResourceAttachUpdate,
+		DeleteContext: resourceCBRVaultResourceAttachDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCBRVaultResourceAttachImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vault_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"servers": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: []string{"servers", "volumes"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"exclude_volumes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"volumes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"protection_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildCBRVaultAttachResources(servers, volumes *schema.Set) []vaults.ResourceCreate {
+	resources := make([]vaults.ResourceCreate, 0, servers.Len()+volumes.Len())
+	for _, raw := range servers.List() {
+		server := raw.(map[string]interface{})
+		resource := vaults.ResourceCreate{
+			ID:   server["id"].(string),
+			Type: cbrResourceTypeServer,
+		}
+		excludeVolumes := utils.ExpandToStringListBySet(server["exclude_volumes"].(*schema.Set))
+		if len(excludeVolumes) > 0 {
+			resource.ExtraInfo = &vaults.ResourceExtraInfo{
+				ExcludeVolumes: excludeVolumes,
+			}
+		}
+		resources = append(resources, resource)
+	}
+	for _, raw := range volumes.List() {
+		resources = append(resources, vaults.ResourceCreate{
+			ID:   raw.(map[string]interface{})["id"].(string),
+			Type: cbrResourceTypeVolume,
+		})
+	}
+	return resources
+}
+
+func getCBRVaultAttachResourceIDs(resources []vaults.ResourceCreate) []string {
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, resource.ID)
+	}
+	return ids
+}
+
+func associateCBRVaultResources(d *schema.ResourceData, meta interface{}, servers, volumes *schema.Set) error {
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return fmt.Errorf("error creating CBR client: %s", err)
+	}
+
+	resources := buildCBRVaultAttachResources(servers, volumes)
+	if len(resources) == 0 {
+		return nil
+	}
+	vaultID := d.Get("vault_id").(string)
+	opts := vaults.AssociateResourcesOpts{
+		Resources: resources,
+	}
+	if _, err := vaults.AssociateResources(client, vaultID, opts).Extract(); err != nil {
+		return fmt.Errorf("error associating resources (%s) with the CBR vault (%s): %s",
+			strings.Join(getCBRVaultAttachResourceIDs(resources), ", "), vaultID, err)
+	}
+	return nil
+}
+
+func dissociateCBRVaultResources(d *schema.ResourceData, meta interface{}, servers, volumes *schema.Set) error {
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return fmt.Errorf("error creating CBR client: %s", err)
+	}
+
+	resourceIDs := getCBRVaultAttachResourceIDs(buildCBRVaultAttachResources(servers, volumes))
+	if len(resourceIDs) == 0 {
+		return nil
+	}
+	vaultID := d.Get("vault_id").(string)
+	opts := vaults.DissociateResourcesOpts{
+		ResourceIDs: resourceIDs,
+	}
+	if _, err := vaults.DissociateResources(client, vaultID, opts).Extract(); err != nil {
+		return common.CheckDeleted(d, err, fmt.Sprintf("error dissociating resources (%s) from the CBR vault (%s)",
+			strings.Join(resourceIDs, ", "), vaultID))
+	}
+	return nil
+}
+
+func resourceCBRVaultResourceAttachCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	err := associateCBRVaultResources(d, meta, d.Get("servers").(*schema.Set), d.Get("volumes").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("vault_id").(string))
+
+	return resourceCBRVaultResourceAttachRead(ctx, d, meta)
+}
+
+func flattenCBRVaultAttachServer(resource vaults.ResourceResp) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              resource.ID,
+		"exclude_volumes": resource.ExtraInfo.ExcludeVolumes,
+	}
+}
+
+func resourceCBRVaultResourceAttachRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CbrV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CBR client: %s", err)
+	}
+
+	vault, err := vaults.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CBR vault")
+	}
+
+	// only the resources managed by this resource are refreshed, the other resources of the vault are ignored
+	managed := make(map[string]bool)
+	for _, id := range getCBRVaultAttachResourceIDs(buildCBRVaultAttachResources(d.Get("servers").(*schema.Set),
+		d.Get("volumes").(*schema.Set))) {
+		managed[id] = true
+	}
+	servers := make([]map[string]interface{}, 0)
+	volumes := make([]map[string]interface{}, 0)
+	for _, resource := range vault.Resources {
+		if !managed[resource.ID] {
+			continue
+		}
+		switch resource.Type {
+		case cbrResourceTypeServer:
+			servers = append(servers, flattenCBRVaultAttachServer(resource))
+		case cbrResourceTypeVolume:
+			volumes = append(volumes, map[string]interface{}{"id": resource.ID})
+		}
+	}
+	if len(servers) == 0 && len(volumes) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vault_id", vault.ID),
+		d.Set("servers", servers),
+		d.Set("volumes", volumes),
+		d.Set("protection_type", vault.Billing.ProtectType),
+		d.Set("status", vault.Billing.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CBR vault resource attach fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCBRVaultResourceAttachUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	// a changed server is regarded as a removed one and an added one, so it is dissociated and then associated again
+	oldServers, newServers := d.GetChange("servers")
+	oldVolumes, newVolumes := d.GetChange("volumes")
+	err := dissociateCBRVaultResources(d, meta, oldServers.(*schema.Set).Difference(newServers.(*schema.Set)),
+		oldVolumes.(*schema.Set).Difference(newVolumes.(*schema.Set)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = associateCBRVaultResources(d, meta, newServers.(*schema.Set).Difference(oldServers.(*schema.Set)),
+		newVolumes.(*schema.Set).Difference(oldVolumes.(*schema.Set)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCBRVaultResourceAttachRead(ctx, d, meta)
+}
+
+func resourceCBRVaultResourceAttachDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	err := dissociateCBRVaultResources(d, meta, d.Get("servers").(*schema.Set), d.Get("volumes").(*schema.Set))
+	return diag.FromErr(err)
+}
+
+// resourceCBRVaultResourceAttachImportState imports one associated resource of the vault, the type of the resource is
+// taken from the vault.
+func resourceCBRVaultResourceAttachImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <vault_id>/<resource_id>")
+	}
+
+	conf := meta.(*config.Config)
+	client, err := conf.CbrV3Client(GetRegion(d, conf))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CBR client: %s", err)
+	}
+	vaultID, resourceID := parts[0], parts[1]
+	vault, err := vaults.Get(client, vaultID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CBR vault (%s): %s", vaultID, err)
+	}
+
+	d.SetId(vaultID)
+	mErr := multierror.Append(nil, d.Set("vault_id", vaultID))
+	for _, resource := range vault.Resources {
+		if resource.ID != resourceID {
+			continue
+		}
+		switch resource.Type {
+		case cbrResourceTypeServer:
+			mErr = multierror.Append(mErr, d.Set("servers", []map[string]interface{}{
+				flattenCBRVaultAttachServer(resource),
+			}))
+		case cbrResourceTypeVolume:
+			mErr = multierror.Append(mErr, d.Set("volumes", []map[string]interface{}{{"id": resource.ID}}))
+		}
+		return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+	}
+	return nil, fmt.Errorf("the resource (%s) is not associated with the CBR vault (%s)", resourceID, vaultID)
+}
@@ -0,0 +1,426 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceCCEAddon() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCCEAddonCreate,
+		ReadContext:   resourceCCEAddonRead,
+		UpdateContext: resourceCCEAddonUpdate,
+		DeleteContext: resourceCCEAddonDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCCEAddonImportState,
+		},
+
+		CustomizeDiff: resourceCCEAddonCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"template_version", "version"},
+			},
+			"version": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "use template_version instead",
+			},
+			"values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"basic": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ExactlyOneOf: []string{"values.0.basic", "values.0.basic_json"},
+						},
+						"basic_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentCCEAddonJson,
+							ExactlyOneOf:     []string{"values.0.basic", "values.0.basic_json"},
+						},
+						"custom": {
+							Type:          schema.TypeMap,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"values.0.custom_json"},
+						},
+						"custom_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentCCEAddonJson,
+							ConflictsWith:    []string{"values.0.custom"},
+						},
+						"flavor": {
+							Type:          schema.TypeMap,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"values.0.flavor_json"},
+						},
+						"flavor_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentCCEAddonJson,
+							ConflictsWith:    []string{"values.0.flavor"},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func suppressEquivalentCCEAddonJson(_, old, new string, _ *schema.ResourceData) bool {
+	equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
+	return equal
+}
+
+func cceAddonURL(client *golangsdk.ServiceClient, clusterID, addonID string) string {
+	if addonID == "" {
+		return addons.CCEServiceURL(client, clusterID, "addons")
+	}
+	return addons.CCEServiceURL(client, clusterID, "addons", addonID+"?cluster_id="+clusterID)
+}
+
+// getCCEAddonVersion returns the add-on version specified by the user, the deprecated version field
+// is used only when it has been changed or template_version is not set.
+func getCCEAddonVersion(d *schema.ResourceData) string {
+	if d.HasChange("version") && !d.HasChange("template_version") {
+		return d.Get("version").(string)
+	}
+	if v, ok := d.GetOk("template_version"); ok {
+		return v.(string)
+	}
+	return d.Get("version").(string)
+}
+
+func buildCCEAddonValuesParam(d *schema.ResourceData) (map[string]interface{}, error) {
+	result := map[string]interface{}{
+		"basic": map[string]interface{}{},
+	}
+
+	values := d.Get("values").([]interface{})
+	if len(values) == 0 || values[0] == nil {
+		return result, nil
+	}
+	valuesMap := values[0].(map[string]interface{})
+
+	for _, key := range []string{"basic", "custom", "flavor"} {
+		if raw := valuesMap[key].(map[string]interface{}); len(raw) != 0 {
+			result[key] = raw
+		}
+		if jsonRaw := valuesMap[key+"_json"].(string); jsonRaw != "" {
+			var param map[string]interface{}
+			if err := json.Unmarshal([]byte(jsonRaw), &param); err != nil {
+				return nil, fmt.Errorf("error unmarshalling %s_json: %s", key, err)
+			}
+			result[key] = param
+		}
+	}
+	return result, nil
+}
+
+func buildCCEAddonBodyParams(d *schema.ResourceData, annotations map[string]interface{}) (map[string]interface{}, error) {
+	values, err := buildCCEAddonValuesParam(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"kind":       "Addon",
+		"apiVersion": "v3",
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+		"spec": map[string]interface{}{
+			"clusterID":         d.Get("cluster_id"),
+			"version":           getCCEAddonVersion(d),
+			"addonTemplateName": d.Get("template_name"),
+			"values":            values,
+		},
+	}, nil
+}
+
+// resourceCCEAddonCustomizeDiff prevents installing an add-on which has already been installed in the cluster,
+// the CCE service allows only one instance of each add-on template in a cluster.
+func resourceCCEAddonCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("cluster_id") || !d.NewValueKnown("template_name") {
+		return nil
+	}
+
+	conf := meta.(*config.Config)
+	region := conf.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	client, err := conf.CceAddonV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	templateName := d.Get("template_name").(string)
+	existing, err := addons.List(client, clusterID, addons.ListOpts{AddonTemplateName: templateName})
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("error querying add-ons of the CCE cluster (%s): %s", clusterID, err)
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("the add-on %s already exists in the CCE cluster (%s) with ID %s, please import it instead",
+			templateName, clusterID, existing[0].Metadata.Id)
+	}
+	return nil
+}
+
+func resourceCCEAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceAddonV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	createOpts, err := buildCCEAddonBodyParams(d, map[string]interface{}{
+		"addon.install/type": "install",
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Request("POST", cceAddonURL(client, clusterID, ""), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+		OkCodes:          []int{201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("metadata.uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CCE add-on ID from the API response")
+	}
+	d.SetId(id)
+
+	if err := waitForCCEAddonRunning(ctx, client, clusterID, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the CCE add-on (%s) to become running: %s", id, err)
+	}
+
+	return resourceCCEAddonRead(ctx, d, meta)
+}
+
+func cceAddonStateRefreshFunc(client *golangsdk.ServiceClient, clusterID, addonID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Request("GET", cceAddonURL(client, clusterID, addonID), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "", err
+		}
+		return respBody, pathSearch("status.status", respBody, "").(string), nil
+	}
+}
+
+func waitForCCEAddonRunning(ctx context.Context, client *golangsdk.ServiceClient, clusterID, addonID string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"installing", "upgrading", "rollbacking", "abnormal"},
+		Target:       []string{"running", "available"},
+		Refresh:      cceAddonStateRefreshFunc(client, clusterID, addonID),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceCCEAddonRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CceAddonV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	resp, err := client.Request("GET", cceAddonURL(client, d.Get("cluster_id").(string), d.Id()),
+		&golangsdk.RequestOpts{KeepResponseBody: true})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE add-on")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	version := pathSearch("spec.version", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", pathSearch("spec.clusterID", respBody, nil)),
+		d.Set("template_name", pathSearch("spec.addonTemplateName", respBody, nil)),
+		d.Set("template_version", version),
+		d.Set("version", version),
+		d.Set("status", pathSearch("status.status", respBody, nil)),
+		d.Set("description", pathSearch("spec.description", respBody, nil)),
+		d.Set("created_at", pathSearch("metadata.creationTimestamp", respBody, nil)),
+		d.Set("updated_at", pathSearch("metadata.updateTimestamp", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE add-on fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCCEAddonUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceAddonV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	if d.HasChanges("template_version", "version", "values") {
+		clusterID := d.Get("cluster_id").(string)
+		updateOpts, err := buildCCEAddonBodyParams(d, map[string]interface{}{
+			"addon.upgrade/type": "upgrade",
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = client.Request("PUT", cceAddonURL(client, clusterID, d.Id()), &golangsdk.RequestOpts{
+			JSONBody: updateOpts,
+		})
+		if err != nil {
+			return diag.Errorf("error updating CCE add-on (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForCCEAddonRunning(ctx, client, clusterID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for the CCE add-on (%s) update to complete: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCCEAddonRead(ctx, d, meta)
+}
+
+func resourceCCEAddonDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceAddonV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE add-on client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	_, err = client.Request("DELETE", cceAddonURL(client, clusterID, d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE add-on")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"deleting", "running", "available", "abnormal", "unavailable"},
+		Target:       []string{"deleted"},
+		Refresh:      cceAddonStateRefreshFunc(client, clusterID, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the CCE add-on (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCCEAddonImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <cluster_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
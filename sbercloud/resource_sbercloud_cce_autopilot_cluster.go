@@ -0,0 +1,509 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCCEAutopilotCluster manages a serverless cluster of CCE, the nodes of the cluster are managed by CCE, so the
+// cluster can't have node pools or nodes.
+func ResourceCCEAutopilotCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCCEAutopilotClusterCreate,
+		ReadContext:   resourceCCEAutopilotClusterRead,
+		UpdateContext: resourceCCEAutopilotClusterUpdate,
+		DeleteContext: resourceCCEAutopilotClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "cce.autopilot.cluster",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// vpc_id and subnet_id are the shorthand of the host_network block
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"vpc_id", "host_network"},
+				RequiredWith: []string{"subnet_id"},
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"vpc_id"},
+			},
+			"host_network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"subnet": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"container_network": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"cidrs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"service_network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv4_cidr": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "rbac",
+							ValidateFunc: validation.StringInSlice([]string{"rbac"}, false),
+						},
+					},
+				},
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_api_server_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_internal": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_external": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_external_otc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildCCEAutopilotClusterHostNetwork(d *schema.ResourceData) map[string]interface{} {
+	if v, ok := d.GetOk("host_network"); ok {
+		hostNetwork := v.([]interface{})[0].(map[string]interface{})
+		return map[string]interface{}{
+			"vpc":    hostNetwork["vpc"],
+			"subnet": hostNetwork["subnet"],
+		}
+	}
+	return map[string]interface{}{
+		"vpc":    d.Get("vpc_id"),
+		"subnet": d.Get("subnet_id"),
+	}
+}
+
+func buildCCEAutopilotClusterContainerNetwork(d *schema.ResourceData) map[string]interface{} {
+	containerNetwork := d.Get("container_network").([]interface{})[0].(map[string]interface{})
+	cidrs := make([]map[string]interface{}, 0)
+	for _, cidr := range containerNetwork["cidrs"].([]interface{}) {
+		cidrs = append(cidrs, map[string]interface{}{"cidr": cidr})
+	}
+	return map[string]interface{}{
+		"mode":  containerNetwork["mode"],
+		"cidrs": valueIgnoreEmpty(cidrs),
+	}
+}
+
+func buildCCEAutopilotClusterServiceNetwork(d *schema.ResourceData) interface{} {
+	cidr := d.Get("service_network.0.ipv4_cidr").(string)
+	if cidr == "" {
+		return nil
+	}
+	return map[string]interface{}{
+		"IPv4CIDR": cidr,
+	}
+}
+
+func buildCCEAutopilotClusterCreateOpts(d *schema.ResourceData, conf *config.Config) map[string]interface{} {
+	authMode := d.Get("authentication.0.mode").(string)
+	if authMode == "" {
+		authMode = "rbac"
+	}
+	return map[string]interface{}{
+		"kind":       "Cluster",
+		"apiVersion": "v3",
+		"metadata": map[string]interface{}{
+			"name": d.Get("name"),
+		},
+		"spec": utils.RemoveNil(map[string]interface{}{
+			"flavor":           d.Get("flavor"),
+			"version":          valueIgnoreEmpty(d.Get("version")),
+			"description":      valueIgnoreEmpty(d.Get("description")),
+			"hostNetwork":      buildCCEAutopilotClusterHostNetwork(d),
+			"containerNetwork": utils.RemoveNil(buildCCEAutopilotClusterContainerNetwork(d)),
+			"serviceNetwork":   buildCCEAutopilotClusterServiceNetwork(d),
+			"authentication": map[string]interface{}{
+				"mode": authMode,
+			},
+			"deletionProtection": d.Get("deletion_protection"),
+			"clusterTags":        valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+			"extendParam": utils.RemoveNil(map[string]interface{}{
+				"enterpriseProjectId": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+			}),
+		}),
+	}
+}
+
+func getCCEAutopilotCluster(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("clusters", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func cceAutopilotClusterStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := getCCEAutopilotCluster(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		phase := pathSearch("status.phase", cluster, "").(string)
+		if phase == "Error" || phase == "Unavailable" {
+			return cluster, phase, fmt.Errorf("the cluster is %s: %v", phase,
+				pathSearch("status.message", cluster, ""))
+		}
+		return cluster, phase, nil
+	}
+}
+
+func resourceCCEAutopilotClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cce_autopilot", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE autopilot client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("clusters"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildCCEAutopilotClusterCreateOpts(d, conf),
+		OkCodes:          []int{201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating CCE autopilot cluster: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("metadata.uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CCE autopilot cluster ID from the API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Creating"},
+		Target:       []string{"Available"},
+		Refresh:      cceAutopilotClusterStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CCE autopilot cluster (%s) to be available: %s", id, err)
+	}
+
+	return resourceCCEAutopilotClusterRead(ctx, d, meta)
+}
+
+// flattenCCEAutopilotClusterEndpoint returns the URL of the endpoint in the specified type, the types are Internal,
+// External and External_OTC.
+func flattenCCEAutopilotClusterEndpoint(cluster interface{}, endpointType string) string {
+	expression := fmt.Sprintf("status.endpoints[?type=='%s']|[0].url", endpointType)
+	return pathSearch(expression, cluster, "").(string)
+}
+
+func flattenCCEAutopilotClusterContainerNetwork(cluster interface{}) []map[string]interface{} {
+	cidrs := make([]interface{}, 0)
+	for _, cidr := range pathSearch("spec.containerNetwork.cidrs", cluster, make([]interface{}, 0)).([]interface{}) {
+		cidrs = append(cidrs, pathSearch("cidr", cidr, nil))
+	}
+	return []map[string]interface{}{
+		{
+			"mode":  pathSearch("spec.containerNetwork.mode", cluster, nil),
+			"cidrs": cidrs,
+		},
+	}
+}
+
+func resourceCCEAutopilotClusterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "cce_autopilot", region)
+	if err != nil {
+		return diag.Errorf("error creating CCE autopilot client: %s", err)
+	}
+
+	cluster, err := getCCEAutopilotCluster(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE autopilot cluster")
+	}
+
+	vpcID := pathSearch("spec.hostNetwork.vpc", cluster, "").(string)
+	subnetID := pathSearch("spec.hostNetwork.subnet", cluster, "").(string)
+	// the kube-apiserver is reached through the external endpoint if the cluster is bound with an EIP
+	apiServerAddress := flattenCCEAutopilotClusterEndpoint(cluster, "External")
+	if apiServerAddress == "" {
+		apiServerAddress = flattenCCEAutopilotClusterEndpoint(cluster, "Internal")
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("metadata.name", cluster, nil)),
+		d.Set("flavor", pathSearch("spec.flavor", cluster, nil)),
+		d.Set("version", pathSearch("spec.version", cluster, nil)),
+		d.Set("description", pathSearch("spec.description", cluster, nil)),
+		d.Set("vpc_id", vpcID),
+		d.Set("subnet_id", subnetID),
+		d.Set("host_network", []map[string]interface{}{
+			{
+				"vpc":    vpcID,
+				"subnet": subnetID,
+			},
+		}),
+		d.Set("container_network", flattenCCEAutopilotClusterContainerNetwork(cluster)),
+		d.Set("service_network", []map[string]interface{}{
+			{
+				"ipv4_cidr": pathSearch("spec.serviceNetwork.IPv4CIDR", cluster, nil),
+			},
+		}),
+		d.Set("authentication", []map[string]interface{}{
+			{
+				"mode": pathSearch("spec.authentication.mode", cluster, nil),
+			},
+		}),
+		d.Set("deletion_protection", pathSearch("spec.deletionProtection", cluster, false)),
+		d.Set("enterprise_project_id", pathSearch("spec.extendParam.enterpriseProjectId", cluster, nil)),
+		d.Set("tags", flattenResponseTags("spec.clusterTags", cluster)),
+		d.Set("status", pathSearch("status.phase", cluster, nil)),
+		d.Set("kube_api_server_address", apiServerAddress),
+		d.Set("endpoint_internal", flattenCCEAutopilotClusterEndpoint(cluster, "Internal")),
+		d.Set("endpoint_external", flattenCCEAutopilotClusterEndpoint(cluster, "External")),
+		d.Set("endpoint_external_otc", flattenCCEAutopilotClusterEndpoint(cluster, "External_OTC")),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE autopilot cluster fields: %s", err)
+	}
+
+	return nil
+}
+
+func updateCCEAutopilotClusterTags(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	oldRaw, newRaw := d.GetChange("tags")
+	oldTags, newTags := oldRaw.(map[string]interface{}), newRaw.(map[string]interface{})
+
+	toRemove := make(map[string]interface{})
+	for k, v := range oldTags {
+		if nv, ok := newTags[k]; !ok || nv != v {
+			toRemove[k] = v
+		}
+	}
+	if len(toRemove) > 0 {
+		_, err := client.Request("POST", client.ServiceURL("clusters", d.Id(), "tags", "delete"),
+			&golangsdk.RequestOpts{
+				JSONBody: map[string]interface{}{"tags": utils.ExpandResourceTags(toRemove)},
+				OkCodes:  []int{204},
+			})
+		if err != nil {
+			return err
+		}
+	}
+	if len(newTags) > 0 {
+		_, err := client.Request("POST", client.ServiceURL("clusters", d.Id(), "tags", "create"),
+			&golangsdk.RequestOpts{
+				JSONBody: map[string]interface{}{"tags": utils.ExpandResourceTags(newTags)},
+				OkCodes:  []int{204},
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceCCEAutopilotClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cce_autopilot", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE autopilot client: %s", err)
+	}
+
+	if d.HasChanges("description", "deletion_protection") {
+		updateOpts := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"description":        d.Get("description"),
+				"deletionProtection": d.Get("deletion_protection"),
+			},
+		}
+		_, err = client.Request("PUT", client.ServiceURL("clusters", d.Id()), &golangsdk.RequestOpts{
+			JSONBody: updateOpts,
+			OkCodes:  []int{200},
+		})
+		if err != nil {
+			return diag.Errorf("error updating CCE autopilot cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := updateCCEAutopilotClusterTags(client, d); err != nil {
+			return diag.Errorf("error updating tags of CCE autopilot cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceCCEAutopilotClusterRead(ctx, d, meta)
+}
+
+func resourceCCEAutopilotClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cce_autopilot", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE autopilot client: %s", err)
+	}
+
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("CCE autopilot cluster (%s) is protected from deletion, please set deletion_protection "+
+			"to false first", d.Id())
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("clusters", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE autopilot cluster")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Available", "Deleting"},
+		Target:       []string{"DELETED"},
+		Refresh:      cceAutopilotClusterStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CCE autopilot cluster (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
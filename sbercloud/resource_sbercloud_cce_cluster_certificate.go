@@ -0,0 +1,249 @@
+package sbercloud
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/clusters"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// cceCertificateExpiryWarningPeriod is how long before the expiry of the certificate the warning is emitted.
+const cceCertificateExpiryWarningPeriod = 30 * 24 * time.Hour
+
+// ResourceCCEClusterCertificate issues the certificate of a cluster. Issuing a new certificate invalidates the old one
+// and an issued certificate can't be revoked, so the deletion only removes the resource from the state.
+func ResourceCCEClusterCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCCEClusterCertificateCreate,
+		ReadContext:   resourceCCEClusterCertificateRead,
+		DeleteContext: resourceCCEClusterCertificateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// the certificate is valid for the maximum period (5 years) if omitted
+			"validity_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 1825),
+			},
+			"kube_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"certificate_clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_authority_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"certificate_users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_certificate_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_key_data": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"expiry_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseCCECertificateExpiry returns the earliest expiry time of the client certificates in the kubeconfig.
+func parseCCECertificateExpiry(cert *clusters.Certificate) (time.Time, error) {
+	var expiry time.Time
+	for _, user := range cert.Users {
+		raw, err := base64.StdEncoding.DecodeString(user.User.ClientCertData)
+		if err != nil {
+			return expiry, fmt.Errorf("error decoding the certificate of user %s: %s", user.Name, err)
+		}
+		block, _ := pem.Decode(raw)
+		if block == nil {
+			return expiry, fmt.Errorf("the certificate of user %s is not in PEM format", user.Name)
+		}
+		x509Cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return expiry, fmt.Errorf("error parsing the certificate of user %s: %s", user.Name, err)
+		}
+		if expiry.IsZero() || x509Cert.NotAfter.Before(expiry) {
+			expiry = x509Cert.NotAfter
+		}
+	}
+	if expiry.IsZero() {
+		return expiry, fmt.Errorf("unable to find any client certificate in the kubeconfig")
+	}
+	return expiry, nil
+}
+
+func flattenCCECertificateClusters(cert *clusters.Certificate) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(cert.Clusters))
+	for i, cluster := range cert.Clusters {
+		result[i] = map[string]interface{}{
+			"name":                       cluster.Name,
+			"server":                     cluster.Cluster.Server,
+			"certificate_authority_data": cluster.Cluster.CertAuthorityData,
+		}
+	}
+	return result
+}
+
+func flattenCCECertificateUsers(cert *clusters.Certificate) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(cert.Users))
+	for i, user := range cert.Users {
+		result[i] = map[string]interface{}{
+			"name":                    user.Name,
+			"client_certificate_data": user.User.ClientCertData,
+			"client_key_data":         user.User.ClientKeyData,
+		}
+	}
+	return result
+}
+
+func resourceCCEClusterCertificateCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	duration := -1
+	if v, ok := d.GetOk("validity_period"); ok {
+		duration = v.(int)
+	}
+	resp, err := client.Request("POST", client.ServiceURL("clusters", clusterID, "clustercert"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"duration": duration,
+			},
+			MoreHeaders: clusters.RequestOpts.MoreHeaders,
+			OkCodes:     []int{200, 201},
+		})
+	if err != nil {
+		return diag.Errorf("error issuing the certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+	defer resp.Body.Close()
+
+	kubeConfig, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return diag.Errorf("error reading the certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+	d.SetId(clusterID)
+
+	var cert clusters.Certificate
+	if err := json.Unmarshal(kubeConfig, &cert); err != nil {
+		return diag.Errorf("error parsing the certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+	expiry, err := parseCCECertificateExpiry(&cert)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("kube_config", string(kubeConfig)),
+		d.Set("certificate_clusters", flattenCCECertificateClusters(&cert)),
+		d.Set("certificate_users", flattenCCECertificateUsers(&cert)),
+		d.Set("expiry_time", expiry.UTC().Format(time.RFC3339)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE cluster certificate fields: %s", err)
+	}
+
+	return resourceCCEClusterCertificateRead(ctx, d, meta)
+}
+
+// resourceCCEClusterCertificateRead only checks the cluster, the certificate can't be queried without issuing a new
+// one, which would invalidate the certificate in the state.
+func resourceCCEClusterCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	if _, err := clusters.Get(client, d.Id()).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE cluster")
+	}
+
+	if err := d.Set("region", region); err != nil {
+		return diag.Errorf("error setting CCE cluster certificate fields: %s", err)
+	}
+
+	expiry, err := time.Parse(time.RFC3339, d.Get("expiry_time").(string))
+	if err != nil {
+		return diag.Errorf("error parsing the expiry time of CCE cluster certificate: %s", err)
+	}
+	if time.Until(expiry) < cceCertificateExpiryWarningPeriod {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The certificate of CCE cluster (%s) expires at %s", d.Id(), d.Get("expiry_time")),
+				Detail:   "Taint or replace the resource to issue a new certificate, the old one will be invalidated.",
+			},
+		}
+	}
+
+	return nil
+}
+
+func resourceCCEClusterCertificateDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
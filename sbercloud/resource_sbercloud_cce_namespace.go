@@ -0,0 +1,302 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceCCENamespace() *schema.Resource {
+	nameValidation := validation.All(
+		validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`),
+			"The name can only consist of lowercase letters, numbers, and hyphens (-), "+
+				"and it must start and end with a letter or digit."),
+		validation.StringLenBetween(1, 63),
+	)
+
+	return &schema.Resource{
+		CreateContext: resourceCCENamespaceCreate,
+		ReadContext:   resourceCCENamespaceRead,
+		UpdateContext: resourceCCENamespaceUpdate,
+		DeleteContext: resourceCCENamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCCENamespaceImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: nameValidation,
+				ExactlyOneOf: []string{"name", "prefix"},
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: nameValidation,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "use created_at instead",
+			},
+		},
+	}
+}
+
+func resourceCCENamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"kind":       "Namespace",
+		"apiVersion": "v1",
+		"metadata": utils.RemoveNil(map[string]interface{}{
+			"name":         valueIgnoreEmpty(d.Get("name")),
+			"generateName": valueIgnoreEmpty(d.Get("prefix")),
+			"labels":       valueIgnoreEmpty(d.Get("labels")),
+			"annotations":  valueIgnoreEmpty(d.Get("annotations")),
+		}),
+	}
+	clusterID := d.Get("cluster_id").(string)
+	resp, err := client.Request("POST", addons.CCEServiceURL(client, clusterID, "namespaces"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating CCE namespace: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("metadata.uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CCE namespace ID from the API response")
+	}
+	d.SetId(id)
+
+	// the name is generated by the server when the prefix is specified
+	if err := d.Set("name", pathSearch("metadata.name", respBody, nil)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCCENamespaceRead(ctx, d, meta)
+}
+
+func getCCENamespace(client *golangsdk.ServiceClient, clusterID, name string) (interface{}, error) {
+	resp, err := client.Request("GET", cceNamespacedURL(client, clusterID, name), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func resourceCCENamespaceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CceV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	respBody, err := getCCENamespace(client, d.Get("cluster_id").(string), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE namespace")
+	}
+
+	createdAt := pathSearch("metadata.creationTimestamp", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("metadata.name", respBody, nil)),
+		d.Set("prefix", pathSearch("metadata.generateName", respBody, nil)),
+		d.Set("labels", pathSearch("metadata.labels", respBody, nil)),
+		d.Set("annotations", pathSearch("metadata.annotations", respBody, nil)),
+		d.Set("status", pathSearch("status.phase", respBody, nil)),
+		d.Set("created_at", createdAt),
+		d.Set("creation_timestamp", createdAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE namespace fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceCCENamespaceUpdate only refreshes the resource, because force_destroy is the only updatable parameter
+// and it is used only in the deletion.
+func resourceCCENamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceCCENamespaceRead(ctx, d, meta)
+}
+
+// deleteCCENamespaceResources removes the pods and PVCs in the namespace, other resources are removed by
+// the cascading deletion of the namespace.
+func deleteCCENamespaceResources(client *golangsdk.ServiceClient, clusterID, namespace string) error {
+	for _, kind := range []string{"pods", "persistentvolumeclaims"} {
+		_, err := client.Request("DELETE", cceNamespacedURL(client, clusterID, namespace, kind),
+			&golangsdk.RequestOpts{})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("error deleting the %s: %s", kind, err)
+		}
+	}
+	return nil
+}
+
+func resourceCCENamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	if d.Get("force_destroy").(bool) {
+		if err := deleteCCENamespaceResources(client, clusterID, name); err != nil {
+			return diag.Errorf("error cleaning up the CCE namespace (%s): %s", name, err)
+		}
+	} else {
+		podNames, err := listCCEActivePodNames(client, clusterID, name, "")
+		if err != nil {
+			return diag.Errorf("error querying the pods in the CCE namespace (%s): %s", name, err)
+		}
+		if len(podNames) > 0 {
+			return diag.Errorf("the CCE namespace (%s) is not empty, the running pods are: %s, please remove them "+
+				"first or set force_destroy to true", name, strings.Join(podNames, ", "))
+		}
+	}
+
+	_, err = client.Request("DELETE", cceNamespacedURL(client, clusterID, name), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE namespace")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Active", "Terminating"},
+		Target:       []string{"DELETED"},
+		Refresh:      cceNamespaceStateRefreshFunc(client, clusterID, name),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the CCE namespace (%s) to be deleted: %s", name, err)
+	}
+
+	return nil
+}
+
+func cceNamespaceStateRefreshFunc(client *golangsdk.ServiceClient, clusterID, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getCCENamespace(client, clusterID, name)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return respBody, pathSearch("status.phase", respBody, "").(string), nil
+	}
+}
+
+func resourceCCENamespaceImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <cluster_id>/<name>")
+	}
+
+	conf := meta.(*config.Config)
+	client, err := conf.CceV1Client(GetRegion(d, conf))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	respBody, err := getCCENamespace(client, parts[0], parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE namespace (%s): %s", d.Id(), err)
+	}
+
+	id := pathSearch("metadata.uid", respBody, "").(string)
+	if id == "" {
+		return nil, fmt.Errorf("unable to find the CCE namespace ID from the API response")
+	}
+	d.SetId(id)
+
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", parts[0]),
+		d.Set("name", parts[1]),
+		d.Set("force_destroy", false),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
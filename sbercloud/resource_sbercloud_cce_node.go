@@ -0,0 +1,106 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+)
+
+// cceNodeEipFields are the EIP fields of the node resource of the huaweicloud package which the eipcount block is
+// translated to before the node is created.
+var cceNodeEipFields = []string{"eip_ids", "iptype", "sharetype", "bandwidth_size", "bandwidth_charge_mode"}
+
+// ResourceCCENode extends the node resource of the huaweicloud package with the eipcount block, which assigns the
+// EIP to the node in the creation request of the node.
+func ResourceCCENode() *schema.Resource {
+	node := huaweicloud.ResourceCCENodeV3()
+
+	// The fields filled from the eipcount block are kept in the state, mark them computed to avoid the diff.
+	for _, field := range cceNodeEipFields {
+		node.Schema[field].Computed = true
+	}
+	node.Schema["eipcount"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: append([]string{"eip_id"}, cceNodeEipFields...),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      1,
+					ValidateFunc: validation.IntInSlice([]int{1}),
+				},
+				"id_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"share_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"charge_mode": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"size": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+
+	createContext := node.CreateContext
+	node.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := expandCCENodeEipCount(d); err != nil {
+			return diag.FromErr(err)
+		}
+		return createContext(ctx, d, meta)
+	}
+	return node
+}
+
+// expandCCENodeEipCount binds the existing EIPs if the ids are specified, otherwise it creates a new EIP.
+func expandCCENodeEipCount(d *schema.ResourceData) error {
+	rawList := d.Get("eipcount").([]interface{})
+	if len(rawList) < 1 || rawList[0] == nil {
+		return nil
+	}
+	eip := rawList[0].(map[string]interface{})
+
+	if ids := eip["ids"].([]interface{}); len(ids) > 0 {
+		return d.Set("eip_ids", ids)
+	}
+	if eip["id_type"].(string) == "" || eip["share_type"].(string) == "" || eip["size"].(int) == 0 {
+		return fmt.Errorf("either ids or id_type, share_type and size must be specified in the eipcount block")
+	}
+	for field, value := range map[string]interface{}{
+		"iptype":                eip["id_type"],
+		"sharetype":             eip["share_type"],
+		"bandwidth_size":        eip["size"],
+		"bandwidth_charge_mode": eip["charge_mode"],
+	} {
+		if err := d.Set(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
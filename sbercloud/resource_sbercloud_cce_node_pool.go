@@ -0,0 +1,43 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceCCENodePool extends the node pool resource of the huaweicloud package with the plan-time check of the
+// cluster type, the nodes of the autopilot clusters are managed by CCE and they can't have node pools.
+func ResourceCCENodePool() *schema.Resource {
+	nodePool := huaweicloud.ResourceCCENodePool()
+	nodePool.CustomizeDiff = resourceCCENodePoolCustomizeDiff
+	return nodePool
+}
+
+// resourceCCENodePoolCustomizeDiff rejects the node pools of the autopilot clusters. The check is skipped if the
+// cluster ID is unknown, and the errors of the query are left to the creation.
+func resourceCCENodePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cluster_id") || !d.HasChange("cluster_id") {
+		return nil
+	}
+
+	conf := meta.(*config.Config)
+	region := conf.Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	client, err := NewServiceClient(conf, "cce_autopilot", region)
+	if err != nil {
+		return nil
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if _, err := getCCEAutopilotCluster(client, clusterID); err == nil {
+		return fmt.Errorf("the cluster (%s) is an autopilot cluster, node pools can't be added to autopilot clusters",
+			clusterID)
+	}
+	return nil
+}
@@ -0,0 +1,405 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/addons"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceCCEPersistentVolumeClaim() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCCEPersistentVolumeClaimCreate,
+		ReadContext:   resourceCCEPersistentVolumeClaimRead,
+		DeleteContext: resourceCCEPersistentVolumeClaimDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCCEPersistentVolumeClaimImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`),
+						"The name can only consist of lowercase letters, numbers, and hyphens (-), "+
+							"and it must start and end with a letter or digit."),
+					validation.StringLenBetween(1, 63),
+				),
+			},
+			"storage_class_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"csi-disk", "csi-nas", "csi-obs", "csi-sfsturbo",
+				}, false),
+			},
+			"access_modes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ReadWriteOnce", "ReadOnlyMany", "ReadWriteMany",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"storage": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Filesystem", "Block"}, false),
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"phase": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "use phase instead",
+			},
+		},
+	}
+}
+
+func cceNamespacedURL(client *golangsdk.ServiceClient, clusterID, namespace string, parts ...string) string {
+	return addons.CCEServiceURL(client, clusterID, append([]string{"namespaces", namespace}, parts...)...)
+}
+
+func buildCCEPersistentVolumeClaimBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata": utils.RemoveNil(map[string]interface{}{
+			"name":        d.Get("name"),
+			"namespace":   d.Get("namespace"),
+			"labels":      valueIgnoreEmpty(d.Get("labels")),
+			"annotations": valueIgnoreEmpty(d.Get("annotations")),
+		}),
+		"spec": utils.RemoveNil(map[string]interface{}{
+			"accessModes":      d.Get("access_modes").(*schema.Set).List(),
+			"storageClassName": d.Get("storage_class_name"),
+			"volumeMode":       valueIgnoreEmpty(d.Get("volume_mode")),
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{
+					"storage": d.Get("storage"),
+				},
+			},
+		}),
+	}
+}
+
+func resourceCCEPersistentVolumeClaimCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	namespace := d.Get("namespace").(string)
+	resp, err := client.Request("POST", cceNamespacedURL(client, clusterID, namespace, "persistentvolumeclaims"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         buildCCEPersistentVolumeClaimBodyParams(d),
+			OkCodes:          []int{200, 201},
+		})
+	if err != nil {
+		return diag.Errorf("error creating CCE PVC: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("metadata.uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CCE PVC ID from the API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Pending"},
+		Target:       []string{"Bound"},
+		Refresh:      ccePersistentVolumeClaimStateRefreshFunc(client, clusterID, namespace, d.Get("name").(string)),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the CCE PVC (%s) to become bound: %s", d.Id(), err)
+	}
+
+	return resourceCCEPersistentVolumeClaimRead(ctx, d, meta)
+}
+
+func getCCEPersistentVolumeClaim(client *golangsdk.ServiceClient, clusterID, namespace,
+	name string) (interface{}, error) {
+	resp, err := client.Request("GET", cceNamespacedURL(client, clusterID, namespace, "persistentvolumeclaims", name),
+		&golangsdk.RequestOpts{KeepResponseBody: true})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func ccePersistentVolumeClaimStateRefreshFunc(client *golangsdk.ServiceClient, clusterID, namespace,
+	name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getCCEPersistentVolumeClaim(client, clusterID, namespace, name)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return respBody, pathSearch("status.phase", respBody, "").(string), nil
+	}
+}
+
+func resourceCCEPersistentVolumeClaimRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CceV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	respBody, err := getCCEPersistentVolumeClaim(client, d.Get("cluster_id").(string), d.Get("namespace").(string),
+		d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE PVC")
+	}
+
+	phase := pathSearch("status.phase", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("metadata.name", respBody, nil)),
+		d.Set("namespace", pathSearch("metadata.namespace", respBody, nil)),
+		d.Set("labels", pathSearch("metadata.labels", respBody, nil)),
+		d.Set("annotations", flattenCCEPersistentVolumeClaimAnnotations(d,
+			pathSearch("metadata.annotations", respBody, nil))),
+		d.Set("storage_class_name", pathSearch("spec.storageClassName", respBody, nil)),
+		d.Set("access_modes", pathSearch("spec.accessModes", respBody, nil)),
+		d.Set("storage", pathSearch("spec.resources.requests.storage", respBody, nil)),
+		d.Set("volume_mode", pathSearch("spec.volumeMode", respBody, nil)),
+		d.Set("volume_name", pathSearch("spec.volumeName", respBody, nil)),
+		d.Set("creation_timestamp", pathSearch("metadata.creationTimestamp", respBody, nil)),
+		d.Set("phase", phase),
+		d.Set("status", phase),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CCE PVC fields: %s", err)
+	}
+
+	return nil
+}
+
+// cceServerAnnotationPrefixes are the prefixes of the annotations which are added by Kubernetes to the PVC.
+var cceServerAnnotationPrefixes = []string{
+	"pv.kubernetes.io/",
+	"volume.beta.kubernetes.io/",
+	"volume.kubernetes.io/",
+	"kubectl.kubernetes.io/",
+}
+
+// flattenCCEPersistentVolumeClaimAnnotations only keeps the annotations which are configured, so the annotations added
+// by the server don't replace the PVC. After importing, the annotations added by Kubernetes are filtered out instead.
+func flattenCCEPersistentVolumeClaimAnnotations(d *schema.ResourceData,
+	rawAnnotations interface{}) map[string]interface{} {
+	annotations, ok := rawAnnotations.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	configured := d.Get("annotations").(map[string]interface{})
+	result := make(map[string]interface{})
+	for k, v := range annotations {
+		if len(configured) > 0 {
+			if _, ok := configured[k]; ok {
+				result[k] = v
+			}
+			continue
+		}
+
+		isServerAnnotation := false
+		for _, prefix := range cceServerAnnotationPrefixes {
+			if strings.HasPrefix(k, prefix) {
+				isServerAnnotation = true
+				break
+			}
+		}
+		if !isServerAnnotation {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// listCCEActivePodNames returns the names of the pods which are not terminated in the namespace, the filter is
+// an additional JMESPath condition of the pods and can be empty.
+func listCCEActivePodNames(client *golangsdk.ServiceClient, clusterID, namespace, filter string) ([]string, error) {
+	resp, err := client.Request("GET", cceNamespacedURL(client, clusterID, namespace, "pods"),
+		&golangsdk.RequestOpts{KeepResponseBody: true})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := "status.phase!='Succeeded' && status.phase!='Failed'"
+	if filter != "" {
+		condition = filter + " && " + condition
+	}
+	names := pathSearch(fmt.Sprintf("items[?%s].metadata.name", condition), respBody,
+		make([]interface{}, 0)).([]interface{})
+
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[i] = name.(string)
+	}
+	return result, nil
+}
+
+func resourceCCEPersistentVolumeClaimDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CceV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+
+	podNames, err := listCCEActivePodNames(client, clusterID, namespace,
+		fmt.Sprintf("spec.volumes[?persistentVolumeClaim.claimName=='%s']", name))
+	if err != nil {
+		return diag.Errorf("error querying the pods which use the CCE PVC (%s): %s", d.Id(), err)
+	}
+	if len(podNames) > 0 {
+		return diag.Errorf("the CCE PVC (%s) is still used by the pod %s, please remove the pod first", name, podNames[0])
+	}
+
+	_, err = client.Request("DELETE", cceNamespacedURL(client, clusterID, namespace, "persistentvolumeclaims", name),
+		&golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE PVC")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Bound", "Pending", "Lost"},
+		Target:       []string{"DELETED"},
+		Refresh:      ccePersistentVolumeClaimStateRefreshFunc(client, clusterID, namespace, name),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the CCE PVC (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCCEPersistentVolumeClaimImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <cluster_id>/<namespace>/<name>")
+	}
+
+	conf := meta.(*config.Config)
+	client, err := conf.CceV1Client(GetRegion(d, conf))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v1 client: %s", err)
+	}
+
+	respBody, err := getCCEPersistentVolumeClaim(client, parts[0], parts[1], parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE PVC (%s): %s", d.Id(), err)
+	}
+
+	id := pathSearch("metadata.uid", respBody, "").(string)
+	if id == "" {
+		return nil, fmt.Errorf("unable to find the CCE PVC ID from the API response")
+	}
+	d.SetId(id)
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", parts[0]),
+		d.Set("namespace", parts[1]),
+		d.Set("name", parts[2]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,176 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCesMetricData reports custom metric data to Cloud Eye. The resource is one-time: the data can't be queried
+// back or deleted, so the state only records what was reported and the data expires after its TTL.
+func ResourceCesMetricData() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCesMetricDataCreate,
+		ReadContext:   resourceCesMetricDataRead,
+		DeleteContext: resourceCesMetricDataDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"metric_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespace": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"metric_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"dimensions": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 4,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"ttl": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 604800),
+						},
+						"collect_time": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Required: true,
+							ForceNew: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"int", "float"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildCesMetricDataDimensions(rawDimensions []interface{}) []map[string]interface{} {
+	dimensions := make([]map[string]interface{}, 0, len(rawDimensions))
+	for _, raw := range rawDimensions {
+		dimension := raw.(map[string]interface{})
+		dimensions = append(dimensions, map[string]interface{}{
+			"name":  dimension["name"],
+			"value": dimension["value"],
+		})
+	}
+	return dimensions
+}
+
+func buildCesMetricDataBody(d *schema.ResourceData) []map[string]interface{} {
+	rawData := d.Get("metric_data").([]interface{})
+	body := make([]map[string]interface{}, 0, len(rawData))
+	for _, raw := range rawData {
+		data := raw.(map[string]interface{})
+		metric := data["metric"].([]interface{})[0].(map[string]interface{})
+		body = append(body, utils.RemoveNil(map[string]interface{}{
+			"metric": map[string]interface{}{
+				"namespace":   metric["namespace"],
+				"metric_name": metric["metric_name"],
+				"dimensions":  buildCesMetricDataDimensions(metric["dimensions"].([]interface{})),
+			},
+			"ttl":          data["ttl"],
+			"collect_time": data["collect_time"],
+			"value":        data["value"],
+			"unit":         valueIgnoreEmpty(data["unit"]),
+			"type":         valueIgnoreEmpty(data["type"]),
+		}))
+	}
+	return body
+}
+
+func resourceCesMetricDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CesV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	_, err = client.Request("POST", client.ServiceURL("metric-data"), &golangsdk.RequestOpts{
+		JSONBody: buildCesMetricDataBody(d),
+		OkCodes:  []int{201},
+	})
+	if err != nil {
+		return diag.Errorf("error reporting CES metric data: %s", err)
+	}
+	d.SetId(resource.UniqueId())
+
+	return resourceCesMetricDataRead(ctx, d, meta)
+}
+
+// resourceCesMetricDataRead only refreshes the region, the reported data can't be queried by the ID.
+func resourceCesMetricDataRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	if err := d.Set("region", GetRegion(d, conf)); err != nil {
+		return diag.Errorf("error setting CES metric data fields: %s", err)
+	}
+	return nil
+}
+
+func resourceCesMetricDataDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
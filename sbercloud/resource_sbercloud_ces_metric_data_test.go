@@ -0,0 +1,56 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCESMetricData_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	collectTime := time.Now().Unix() * 1000
+	resourceName := "sbercloud_ces_metric_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCESMetricData_basic(rName, collectTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "metric_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_data.0.metric.0.namespace", "TFACC.TEST"),
+					resource.TestCheckResourceAttr(resourceName, "metric_data.0.value", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testCESMetricData_basic(rName string, collectTime int64) string {
+	return fmt.Sprintf(`
+resource "sbercloud_ces_metric_data" "test" {
+  metric_data {
+    metric {
+      namespace   = "TFACC.TEST"
+      metric_name = "cpu_util"
+
+      dimensions {
+        name  = "instance_name"
+        value = "%s"
+      }
+    }
+
+    ttl          = 3600
+    collect_time = %d
+    value        = 60
+    unit         = "%%"
+    type         = "int"
+  }
+}
+`, rName, collectTime)
+}
@@ -0,0 +1,292 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCnadAdvancedPolicy manages a protection policy of a Cloud Native Anti-DDoS (CNAD) instance. The policy is
+// created with its name first, and the protection settings are applied by an update after it becomes available.
+func ResourceCnadAdvancedPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCnadAdvancedPolicyCreate,
+		ReadContext:   resourceCnadAdvancedPolicyRead,
+		UpdateContext: resourceCnadAdvancedPolicyUpdate,
+		DeleteContext: resourceCnadAdvancedPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"software", "hardware"}, false),
+			},
+			"udp_filter_status": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"all_src_ip_filter_status": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"max_conn_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"new_conn_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"total_conn_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"http_protect_status": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"total_flow": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"detect_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func getCnadAdvancedPolicy(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	getPath := client.ServiceURL("cnad", "policies", id)
+	getResp, err := client.Request("GET", getPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func cnadAdvancedPolicyStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		policy, err := getCnadAdvancedPolicy(client, id)
+		if err != nil {
+			// the policy may not be found right after the creation
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "PENDING", nil
+			}
+			return nil, "ERROR", err
+		}
+		return policy, "AVAILABLE", nil
+	}
+}
+
+func buildCnadAdvancedPolicyUpdateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":       d.Get("name"),
+		"threshold":  valueIgnoreEmpty(d.Get("detect_threshold")),
+		"total_flow": valueIgnoreEmpty(d.Get("total_flow")),
+		"options": map[string]interface{}{
+			"udp_filter":         d.Get("udp_filter_status"),
+			"all_src_ip_filter":  d.Get("all_src_ip_filter_status"),
+			"http_protection":    d.Get("http_protect_status"),
+			"connect_protection": d.Get("max_conn_filter.0.status"),
+		},
+	}
+	if d.Get("max_conn_filter.0.status").(bool) {
+		bodyParams["connection_protection_list"] = utils.RemoveNil(map[string]interface{}{
+			"new_conn_limit":   valueIgnoreEmpty(d.Get("max_conn_filter.0.new_conn_limit")),
+			"total_conn_limit": valueIgnoreEmpty(d.Get("max_conn_filter.0.total_conn_limit")),
+		})
+	}
+	return utils.RemoveNil(bodyParams)
+}
+
+func updateCnadAdvancedPolicy(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	updatePath := client.ServiceURL("cnad", "policies", d.Id())
+	_, err := client.Request("PUT", updatePath, &golangsdk.RequestOpts{
+		JSONBody: buildCnadAdvancedPolicyUpdateBodyParams(d),
+		OkCodes:  []int{200},
+	})
+	return err
+}
+
+func resourceCnadAdvancedPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	createPath := client.ServiceURL("cnad", "policies")
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"name":                  d.Get("name"),
+			"package_id":            d.Get("instance_id"),
+			"type":                  d.Get("type"),
+			"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		}),
+	}
+	createResp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CNAD advanced policy: %s", err)
+	}
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := pathSearch("policy_id", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CNAD advanced policy ID from the API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      cnadAdvancedPolicyStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CNAD advanced policy (%s) to be available: %s", id, err)
+	}
+
+	// the protection settings are not accepted by the creation
+	if err := updateCnadAdvancedPolicy(client, d); err != nil {
+		return diag.Errorf("error setting the protection of CNAD advanced policy (%s): %s", id, err)
+	}
+
+	return resourceCnadAdvancedPolicyRead(ctx, d, meta)
+}
+
+func flattenCnadAdvancedPolicyMaxConnFilter(policy interface{}) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"status":           pathSearch("options.connect_protection", policy, false),
+			"new_conn_limit":   pathSearch("connection_protection_list.new_conn_limit", policy, nil),
+			"total_conn_limit": pathSearch("connection_protection_list.total_conn_limit", policy, nil),
+		},
+	}
+}
+
+func resourceCnadAdvancedPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	policy, err := getCnadAdvancedPolicy(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CNAD advanced policy")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", pathSearch("package_id", policy, nil)),
+		d.Set("name", pathSearch("policy_name", policy, nil)),
+		d.Set("type", pathSearch("type", policy, nil)),
+		d.Set("udp_filter_status", pathSearch("options.udp_filter", policy, nil)),
+		d.Set("all_src_ip_filter_status", pathSearch("options.all_src_ip_filter", policy, nil)),
+		d.Set("http_protect_status", pathSearch("options.http_protection", policy, nil)),
+		d.Set("max_conn_filter", flattenCnadAdvancedPolicyMaxConnFilter(policy)),
+		d.Set("total_flow", pathSearch("total_flow", policy, nil)),
+		d.Set("detect_threshold", pathSearch("threshold", policy, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", policy, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CNAD advanced policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCnadAdvancedPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	if err := updateCnadAdvancedPolicy(client, d); err != nil {
+		return diag.Errorf("error updating CNAD advanced policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceCnadAdvancedPolicyRead(ctx, d, meta)
+}
+
+func resourceCnadAdvancedPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	deletePath := client.ServiceURL("cnad", "policies", d.Id())
+	_, err = client.Request("DELETE", deletePath, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CNAD advanced policy")
+	}
+
+	return nil
+}
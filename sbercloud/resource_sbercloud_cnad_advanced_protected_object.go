@@ -0,0 +1,287 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCnadAdvancedProtectedObject protects an EIP with a CNAD policy. The EIP is added to the CNAD instance of the
+// policy first, and it is bound to the policy after the instance starts to protect it.
+func ResourceCnadAdvancedProtectedObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCnadAdvancedProtectedObjectCreate,
+		ReadContext:   resourceCnadAdvancedProtectedObjectRead,
+		UpdateContext: resourceCnadAdvancedProtectedObjectUpdate,
+		DeleteContext: resourceCnadAdvancedProtectedObjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCnadAdvancedProtectedObjectImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"floating_ip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getCnadProtectedObject lists the protected objects of the CNAD instance to find the object of the EIP, the API does
+// not support querying an object by the EIP ID.
+func getCnadProtectedObject(client *golangsdk.ServiceClient, packageID, floatingIPID string) (interface{}, error) {
+	for offset := 0; ; offset += 100 {
+		listPath := client.ServiceURL("cnad", "protected-ips") +
+			fmt.Sprintf("?package_id=%s&limit=100&offset=%d", packageID, offset)
+		resp, err := client.Request("GET", listPath, &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		items := pathSearch("items", respBody, make([]interface{}, 0)).([]interface{})
+		for _, item := range items {
+			if pathSearch("ip_id", item, "").(string) == floatingIPID {
+				return item, nil
+			}
+		}
+		if len(items) < 100 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+	}
+}
+
+func cnadProtectedObjectStateRefreshFunc(client *golangsdk.ServiceClient, packageID,
+	floatingIPID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := getCnadProtectedObject(client, packageID, floatingIPID)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "PENDING", nil
+			}
+			return nil, "ERROR", err
+		}
+		return object, pathSearch("status", object, "PENDING").(string), nil
+	}
+}
+
+func doCnadProtectedObjectAction(client *golangsdk.ServiceClient, action, policyID, packageID,
+	floatingIPID string) error {
+	actionPath := client.ServiceURL("cnad", "policies", policyID, action)
+	_, err := client.Request("POST", actionPath, &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"package_id":       packageID,
+			"protected_ip_ids": []string{floatingIPID},
+		},
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func updateCnadProtectedObjectName(client *golangsdk.ServiceClient, object interface{}, name string) error {
+	tagPath := client.ServiceURL("cnad", "protected-ips", pathSearch("id", object, "").(string), "tag")
+	_, err := client.Request("PUT", tagPath, &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{"tag": name},
+		OkCodes:  []int{200},
+	})
+	return err
+}
+
+func resourceCnadAdvancedProtectedObjectCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	floatingIPID := d.Get("floating_ip_id").(string)
+	policy, err := getCnadAdvancedPolicy(client, policyID)
+	if err != nil {
+		return diag.Errorf("error retrieving CNAD advanced policy (%s): %s", policyID, err)
+	}
+	packageID := pathSearch("package_id", policy, "").(string)
+
+	// the objects of an instance are protected by the instance before they can be bound to a policy
+	config.MutexKV.Lock(packageID)
+	defer config.MutexKV.Unlock(packageID)
+
+	if _, err := getCnadProtectedObject(client, packageID, floatingIPID); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return diag.Errorf("error retrieving the protected objects of CNAD instance (%s): %s", packageID, err)
+		}
+		_, err = client.Request("POST", client.ServiceURL("cnad", "packages", packageID, "protected-ips"),
+			&golangsdk.RequestOpts{
+				JSONBody: map[string]interface{}{"protected_ip_ids": []string{floatingIPID}},
+				OkCodes:  []int{200},
+			})
+		if err != nil {
+			return diag.Errorf("error adding EIP (%s) to CNAD instance (%s): %s", floatingIPID, packageID, err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING", "CREATING"},
+		Target:       []string{"NORMAL"},
+		Refresh:      cnadProtectedObjectStateRefreshFunc(client, packageID, floatingIPID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	object, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for EIP (%s) to be protected by CNAD instance (%s): %s", floatingIPID,
+			packageID, err)
+	}
+
+	if err := doCnadProtectedObjectAction(client, "bind", policyID, packageID, floatingIPID); err != nil {
+		return diag.Errorf("error binding EIP (%s) to CNAD advanced policy (%s): %s", floatingIPID, policyID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", policyID, floatingIPID))
+
+	if name, ok := d.GetOk("name"); ok {
+		if err := updateCnadProtectedObjectName(client, object, name.(string)); err != nil {
+			return diag.Errorf("error setting the name of CNAD protected object (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceCnadAdvancedProtectedObjectRead(ctx, d, meta)
+}
+
+func resourceCnadAdvancedProtectedObjectRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	policyID := d.Get("policy_id").(string)
+	policy, err := getCnadAdvancedPolicy(client, policyID)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CNAD advanced policy")
+	}
+	packageID := pathSearch("package_id", policy, "").(string)
+
+	object, err := getCnadProtectedObject(client, packageID, d.Get("floating_ip_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CNAD protected object")
+	}
+	// the object is unbound from the policy outside of Terraform
+	if pathSearch("policy_id", object, "").(string) != policyID {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", pathSearch("tag", object, nil)),
+		d.Set("instance_id", packageID),
+		d.Set("ip_address", pathSearch("ip", object, nil)),
+		d.Set("status", pathSearch("status", object, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CNAD protected object fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCnadAdvancedProtectedObjectUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	object, err := getCnadProtectedObject(client, d.Get("instance_id").(string), d.Get("floating_ip_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving CNAD protected object (%s): %s", d.Id(), err)
+	}
+	if err := updateCnadProtectedObjectName(client, object, d.Get("name").(string)); err != nil {
+		return diag.Errorf("error updating the name of CNAD protected object (%s): %s", d.Id(), err)
+	}
+
+	return resourceCnadAdvancedProtectedObjectRead(ctx, d, meta)
+}
+
+// resourceCnadAdvancedProtectedObjectDelete unbinds the EIP from the policy, the EIP is still protected by the default
+// protection of the CNAD instance.
+func resourceCnadAdvancedProtectedObjectDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "cnad", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CNAD client: %s", err)
+	}
+
+	packageID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(packageID)
+	defer config.MutexKV.Unlock(packageID)
+
+	err = doCnadProtectedObjectAction(client, "unbind", d.Get("policy_id").(string), packageID,
+		d.Get("floating_ip_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error unbinding CNAD protected object")
+	}
+
+	return nil
+}
+
+func resourceCnadAdvancedProtectedObjectImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <policy_id>/<floating_ip_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("policy_id", parts[0]),
+		d.Set("floating_ip_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
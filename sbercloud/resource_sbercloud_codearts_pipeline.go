@@ -0,0 +1,371 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceCodeArtsPipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCodeArtsPipelineCreate,
+		ReadContext:   resourceCodeArtsPipelineRead,
+		UpdateContext: resourceCodeArtsPipelineUpdate,
+		DeleteContext: resourceCodeArtsPipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCodeArtsPipelineImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"component_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"definition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: utils.ValidateJsonString,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"params": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     codeArtsPipelineSourceParamsSchema(),
+						},
+					},
+				},
+			},
+			"variables": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"is_secret": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_runtime": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func codeArtsPipelineSourceParamsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"git_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"git_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"codehub_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_branch": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"repo_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"alias": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func codeArtsPipelinePath(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/api/pipelines", d.Get("project_id").(string))
+}
+
+func buildCodeArtsPipelineSources(d *schema.ResourceData) []map[string]interface{} {
+	rawSources := d.Get("sources").([]interface{})
+	sources := make([]map[string]interface{}, 0, len(rawSources))
+	for _, v := range rawSources {
+		source := v.(map[string]interface{})
+		var params map[string]interface{}
+		if rawParams := source["params"].([]interface{}); len(rawParams) > 0 && rawParams[0] != nil {
+			p := rawParams[0].(map[string]interface{})
+			params = utils.RemoveNil(map[string]interface{}{
+				"git_type":       p["git_type"],
+				"git_url":        p["git_url"],
+				"codehub_id":     valueIgnoreEmpty(p["codehub_id"]),
+				"endpoint_id":    valueIgnoreEmpty(p["endpoint_id"]),
+				"default_branch": valueIgnoreEmpty(p["default_branch"]),
+				"repo_name":      valueIgnoreEmpty(p["repo_name"]),
+				"alias":          valueIgnoreEmpty(p["alias"]),
+			})
+		}
+		sources = append(sources, map[string]interface{}{
+			"type":   source["type"],
+			"params": params,
+		})
+	}
+	return sources
+}
+
+func buildCodeArtsPipelineVariables(d *schema.ResourceData) []map[string]interface{} {
+	rawVariables := d.Get("variables").([]interface{})
+	variables := make([]map[string]interface{}, len(rawVariables))
+	for i, v := range rawVariables {
+		variable := v.(map[string]interface{})
+		variables[i] = map[string]interface{}{
+			"name":        variable["name"],
+			"type":        variable["type"],
+			"value":       variable["value"],
+			"description": variable["description"],
+			"is_secret":   variable["is_secret"],
+			"is_runtime":  variable["is_runtime"],
+			"sequence":    i + 1,
+		}
+	}
+	return variables
+}
+
+func buildCodeArtsPipelineBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":         d.Get("name"),
+		"description":  d.Get("description"),
+		"component_id": valueIgnoreEmpty(d.Get("component_id")),
+		"definition":   valueIgnoreEmpty(d.Get("definition")),
+		"sources":      buildCodeArtsPipelineSources(d),
+		"variables":    buildCodeArtsPipelineVariables(d),
+		"tags":         utils.ExpandToStringList(d.Get("tags").([]interface{})),
+		"is_publish":   false,
+	}
+	return utils.RemoveNil(params)
+}
+
+func resourceCodeArtsPipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_pipeline", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts pipeline client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL(codeArtsPipelinePath(d)), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildCodeArtsPipelineBodyParams(d),
+	})
+	if err != nil {
+		return diag.Errorf("error creating CodeArts pipeline: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("pipeline_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CodeArts pipeline ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceCodeArtsPipelineRead(ctx, d, meta)
+}
+
+func flattenCodeArtsPipelineSources(respBody interface{}) []map[string]interface{} {
+	sources := pathSearch("sources", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(sources))
+	for i, source := range sources {
+		params := pathSearch("params", source, nil)
+		result[i] = map[string]interface{}{
+			"type": pathSearch("type", source, nil),
+			"params": []map[string]interface{}{
+				{
+					"git_type":       pathSearch("git_type", params, nil),
+					"git_url":        pathSearch("git_url", params, nil),
+					"codehub_id":     pathSearch("codehub_id", params, nil),
+					"endpoint_id":    pathSearch("endpoint_id", params, nil),
+					"default_branch": pathSearch("default_branch", params, nil),
+					"repo_name":      pathSearch("repo_name", params, nil),
+					"alias":          pathSearch("alias", params, nil),
+				},
+			},
+		}
+	}
+	return result
+}
+
+func flattenCodeArtsPipelineVariables(d *schema.ResourceData, respBody interface{}) []map[string]interface{} {
+	variables := pathSearch("variables", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(variables))
+	for i, variable := range variables {
+		isSecret := pathSearch("is_secret", variable, false).(bool)
+		value := pathSearch("value", variable, nil)
+		// the values of the secret variables are masked by the API, so keep the configured ones
+		if isSecret {
+			value = d.Get(fmt.Sprintf("variables.%d.value", i))
+		}
+		result[i] = map[string]interface{}{
+			"name":        pathSearch("name", variable, nil),
+			"type":        pathSearch("type", variable, nil),
+			"value":       value,
+			"description": pathSearch("description", variable, nil),
+			"is_secret":   isSecret,
+			"is_runtime":  pathSearch("is_runtime", variable, false),
+		}
+	}
+	return result
+}
+
+func resourceCodeArtsPipelineRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "codearts_pipeline", region)
+	if err != nil {
+		return diag.Errorf("error creating CodeArts pipeline client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL(codeArtsPipelinePath(d), d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CodeArts pipeline")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("component_id", pathSearch("component_id", respBody, nil)),
+		d.Set("description", pathSearch("description", respBody, nil)),
+		d.Set("definition", pathSearch("definition", respBody, nil)),
+		d.Set("sources", flattenCodeArtsPipelineSources(respBody)),
+		d.Set("variables", flattenCodeArtsPipelineVariables(d, respBody)),
+		d.Set("tags", pathSearch("tags", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CodeArts pipeline fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCodeArtsPipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_pipeline", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts pipeline client: %s", err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL(codeArtsPipelinePath(d), d.Id()), &golangsdk.RequestOpts{
+		JSONBody: buildCodeArtsPipelineBodyParams(d),
+	})
+	if err != nil {
+		return diag.Errorf("error updating CodeArts pipeline (%s): %s", d.Id(), err)
+	}
+
+	return resourceCodeArtsPipelineRead(ctx, d, meta)
+}
+
+func resourceCodeArtsPipelineDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_pipeline", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts pipeline client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL(codeArtsPipelinePath(d), d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CodeArts pipeline")
+	}
+
+	return nil
+}
+
+func resourceCodeArtsPipelineImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <project_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("project_id", parts[0])
+}
@@ -0,0 +1,171 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceCodeArtsProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCodeArtsProjectCreate,
+		ReadContext:   resourceCodeArtsProjectRead,
+		UpdateContext: resourceCodeArtsProjectUpdate,
+		DeleteContext: resourceCodeArtsProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"scrum", "kanban"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCodeArtsProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_project", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts project client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"project_name":  d.Get("name"),
+		"project_type":  d.Get("project_type"),
+		"description":   d.Get("description"),
+		"enterprise_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("project"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating CodeArts project: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("result.project.project_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CodeArts project ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceCodeArtsProjectRead(ctx, d, meta)
+}
+
+func resourceCodeArtsProjectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "codearts_project", region)
+	if err != nil {
+		return diag.Errorf("error creating CodeArts project client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("projects", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CodeArts project")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("result.project_name", respBody, nil)),
+		d.Set("project_type", pathSearch("result.project_type", respBody, nil)),
+		d.Set("description", pathSearch("result.description", respBody, nil)),
+		d.Set("enterprise_project_id", pathSearch("result.enterprise_id", respBody, nil)),
+		d.Set("created_at", pathSearch("result.created_time", respBody, nil)),
+		d.Set("creator_id", pathSearch("result.creator.user_id", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CodeArts project fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCodeArtsProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_project", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts project client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"project_name": d.Get("name"),
+		"description":  d.Get("description"),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("projects", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating CodeArts project (%s): %s", d.Id(), err)
+	}
+
+	return resourceCodeArtsProjectRead(ctx, d, meta)
+}
+
+func resourceCodeArtsProjectDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_project", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts project client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("projects", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CodeArts project")
+	}
+
+	return nil
+}
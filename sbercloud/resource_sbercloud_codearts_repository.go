@@ -0,0 +1,221 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the visibility levels of the CodeHub repository
+var codeArtsRepositoryVisibility = map[string]int{
+	"private": 0,
+	"public":  20,
+}
+
+func ResourceCodeArtsRepository() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCodeArtsRepositoryCreate,
+		ReadContext:   resourceCodeArtsRepositoryRead,
+		DeleteContext: resourceCodeArtsRepositoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "private",
+				ValidateFunc: validation.StringInSlice([]string{"private", "public"}, false),
+			},
+			"template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"import_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"ssh_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"https_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCodeArtsRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_repository", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts repository client: %s", err)
+	}
+
+	importMembers := 0
+	if d.Get("import_members").(bool) {
+		importMembers = 1
+	}
+	createOpts := map[string]interface{}{
+		"project_uuid":     d.Get("project_id"),
+		"name":             d.Get("name"),
+		"description":      valueIgnoreEmpty(d.Get("description")),
+		"visibility_level": codeArtsRepositoryVisibility[d.Get("visibility").(string)],
+		"template_id":      valueIgnoreEmpty(d.Get("template_id")),
+		"import_members":   importMembers,
+	}
+	resp, err := client.Request("POST", client.ServiceURL("v1", "repositories"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating CodeArts repository: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("result.repository_uuid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CodeArts repository ID from the API response")
+	}
+	d.SetId(id)
+
+	// the repository is initialized asynchronously and cannot be queried until it's ready
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    codeArtsRepositoryStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CodeArts repository (%s) to become ready: %s", id, err)
+	}
+
+	return resourceCodeArtsRepositoryRead(ctx, d, meta)
+}
+
+func getCodeArtsRepository(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("v2", "repositories", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func codeArtsRepositoryStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getCodeArtsRepository(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "PENDING", nil
+			}
+			return nil, "", err
+		}
+		return respBody, "COMPLETED", nil
+	}
+}
+
+func resourceCodeArtsRepositoryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "codearts_repository", region)
+	if err != nil {
+		return diag.Errorf("error creating CodeArts repository client: %s", err)
+	}
+
+	respBody, err := getCodeArtsRepository(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CodeArts repository")
+	}
+
+	visibility := "private"
+	if pathSearch("result.visibility_level", respBody, float64(0)).(float64) ==
+		float64(codeArtsRepositoryVisibility["public"]) {
+		visibility = "public"
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("project_id", pathSearch("result.project_uuid", respBody, nil)),
+		d.Set("name", pathSearch("result.repository_name", respBody, nil)),
+		d.Set("description", pathSearch("result.description", respBody, nil)),
+		d.Set("visibility", visibility),
+		d.Set("ssh_url", pathSearch("result.ssh_url", respBody, nil)),
+		d.Set("https_url", pathSearch("result.https_url", respBody, nil)),
+		d.Set("web_url", pathSearch("result.web_url", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CodeArts repository fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCodeArtsRepositoryDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "codearts_repository", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CodeArts repository client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("v1", "repositories", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CodeArts repository")
+	}
+
+	return nil
+}
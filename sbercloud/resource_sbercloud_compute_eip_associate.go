@@ -0,0 +1,246 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/compute/v2/extensions/floatingips"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/eips"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceComputeEIPAssociate associates an EIP with an instance by the floating IP actions of the compute API. The
+// association is identified by the EIP address, the instance and the fixed IP are found from the port of the EIP.
+func ResourceComputeEIPAssociate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeEIPAssociateCreate,
+		ReadContext:   resourceComputeEIPAssociateRead,
+		DeleteContext: resourceComputeEIPAssociateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComputeEIPAssociateImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"public_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fixed_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getComputeEIPByAddress(client *golangsdk.ServiceClient, address string) (*eips.PublicIp, error) {
+	pages, err := eips.List(client, eips.ListOpts{PublicIp: []string{address}}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allEips, err := eips.ExtractPublicIPs(pages)
+	if err != nil {
+		return nil, err
+	}
+	if len(allEips) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return &allEips[0], nil
+}
+
+// computeEIPAssociateRefreshFunc returns the port of the EIP, the port is empty before the association completes
+// and after the disassociation completes.
+func computeEIPAssociateRefreshFunc(client *golangsdk.ServiceClient, address string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		eip, err := getComputeEIPByAddress(client, address)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		if eip.PortID == "" {
+			return eip, "UNBOUND", nil
+		}
+		return eip, "BOUND", nil
+	}
+}
+
+func resourceComputeEIPAssociateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+	vpcClient, err := conf.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	publicIP := d.Get("public_ip").(string)
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(publicIP)
+	defer config.MutexKV.Unlock(publicIP)
+
+	eip, err := getComputeEIPByAddress(vpcClient, publicIP)
+	if err != nil {
+		return diag.Errorf("error retrieving EIP (%s): %s", publicIP, err)
+	}
+	// the compute API moves an associated EIP to the instance silently
+	if eip.PortID != "" {
+		return diag.Errorf("EIP (%s) is already associated with port (%s) of private IP (%s), please disassociate it "+
+			"first", publicIP, eip.PortID, eip.PrivateAddress)
+	}
+
+	opts := floatingips.AssociateOpts{
+		FloatingIP: publicIP,
+		FixedIP:    d.Get("fixed_ip").(string),
+	}
+	if err := floatingips.AssociateInstance(computeClient, instanceID, opts).ExtractErr(); err != nil {
+		return diag.Errorf("error associating EIP (%s) with instance (%s): %s", publicIP, instanceID, err)
+	}
+	d.SetId(publicIP)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"UNBOUND"},
+		Target:       []string{"BOUND"},
+		Refresh:      computeEIPAssociateRefreshFunc(vpcClient, publicIP),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for EIP (%s) to be associated: %s", publicIP, err)
+	}
+
+	return resourceComputeEIPAssociateRead(ctx, d, meta)
+}
+
+func resourceComputeEIPAssociateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	vpcClient, err := conf.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+	networkingClient, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	// the resources created by the earlier versions use <eip>/<instance_id>/<fixed_ip> as the ID
+	eip, err := getComputeEIPByAddress(vpcClient, d.Get("public_ip").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving EIP")
+	}
+	d.SetId(eip.PublicAddress)
+	// the EIP is disassociated outside of Terraform
+	if eip.PortID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	port, err := ports.Get(networkingClient, eip.PortID).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the port of EIP")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("public_ip", eip.PublicAddress),
+		d.Set("instance_id", port.DeviceID),
+		d.Set("fixed_ip", eip.PrivateAddress),
+		d.Set("port_id", port.ID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting compute EIP associate fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeEIPAssociateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+	vpcClient, err := conf.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	publicIP := d.Get("public_ip").(string)
+	config.MutexKV.Lock(publicIP)
+	defer config.MutexKV.Unlock(publicIP)
+
+	instanceID := d.Get("instance_id").(string)
+	opts := floatingips.DisassociateOpts{
+		FloatingIP: publicIP,
+	}
+	if err := floatingips.DisassociateInstance(computeClient, instanceID, opts).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disassociating EIP")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"BOUND"},
+		Target:       []string{"UNBOUND"},
+		Refresh:      computeEIPAssociateRefreshFunc(vpcClient, publicIP),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for EIP (%s) to be disassociated: %s", publicIP, err)
+	}
+
+	return nil
+}
+
+// resourceComputeEIPAssociateImportState imports the resource by the EIP address. The <eip>/<instance_id>/<fixed_ip>
+// format of the earlier versions is accepted too.
+func resourceComputeEIPAssociateImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	publicIP := strings.Split(d.Id(), "/")[0]
+	if publicIP == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <public_ip>")
+	}
+
+	d.SetId(publicIP)
+	return []*schema.ResourceData{d}, d.Set("public_ip", publicIP)
+}
@@ -2,7 +2,6 @@ package sbercloud
 
 import (
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -33,6 +32,8 @@ func TestAccComputeV2EIPAssociate_basic(t *testing.T) {
 					testAccCheckComputeV2InstanceExists("sbercloud_compute_instance.test", &instance),
 					testAccCheckVpcV1EIPExists("sbercloud_vpc_eip.test", &eip),
 					testAccCheckComputeV2EIPAssociateAssociated(&eip, &instance, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "sbercloud_vpc_eip.test", "address"),
+					resource.TestCheckResourceAttrSet(resourceName, "port_id"),
 				),
 			},
 			{
@@ -62,6 +63,8 @@ func TestAccComputeV2EIPAssociate_fixedIP(t *testing.T) {
 					testAccCheckComputeV2InstanceExists("sbercloud_compute_instance.test", &instance),
 					testAccCheckVpcV1EIPExists("sbercloud_vpc_eip.test", &eip),
 					testAccCheckComputeV2EIPAssociateAssociated(&eip, &instance, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "sbercloud_vpc_eip.test", "address"),
+					resource.TestCheckResourceAttrSet(resourceName, "port_id"),
 				),
 			},
 			{
@@ -85,10 +88,8 @@ func testAccCheckComputeV2EIPAssociateDestroy(s *terraform.State) error {
 			continue
 		}
 
-		floatingIP, instanceId, _, err := parseComputeFloatingIPAssociateId(rs.Primary.ID)
-		if err != nil {
-			return err
-		}
+		floatingIP := rs.Primary.Attributes["public_ip"]
+		instanceId := rs.Primary.Attributes["instance_id"]
 
 		instance, err := servers.Get(computeClient, instanceId).Extract()
 		if err != nil {
@@ -115,19 +116,6 @@ func testAccCheckComputeV2EIPAssociateDestroy(s *terraform.State) error {
 	return nil
 }
 
-func parseComputeFloatingIPAssociateId(id string) (string, string, string, error) {
-	idParts := strings.Split(id, "/")
-	if len(idParts) < 3 {
-		return "", "", "", fmt.Errorf("Unable to determine floating ip association ID")
-	}
-
-	floatingIP := idParts[0]
-	instanceId := idParts[1]
-	fixedIP := idParts[2]
-
-	return floatingIP, instanceId, fixedIP, nil
-}
-
 func testAccCheckComputeV2EIPAssociateAssociated(
 	eip *eips.PublicIp, instance *servers.Server, n int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -0,0 +1,165 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/compute/v2/extensions/floatingips"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceComputeFloatingIPAssociate associates a floating IP of the legacy floating IP pools with an instance. The
+// association is read from the os-floating-ips extension of the compute API instead of the VPC EIPs.
+func ResourceComputeFloatingIPAssociate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeFloatingIPAssociateCreate,
+		ReadContext:   resourceComputeFloatingIPAssociateRead,
+		DeleteContext: resourceComputeFloatingIPAssociateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComputeFloatingIPAssociateImportState,
+		},
+
+		DeprecationMessage: "use sbercloud_compute_eip_associate instead",
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"floating_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fixed_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+	}
+}
+
+func getComputeFloatingIPByAddress(client *golangsdk.ServiceClient, address string) (*floatingips.FloatingIP, error) {
+	pages, err := floatingips.List(client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(pages)
+	if err != nil {
+		return nil, err
+	}
+	for _, fip := range allFloatingIPs {
+		if fip.IP == address {
+			return &fip, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceComputeFloatingIPAssociateCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ComputeV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	floatingIP := d.Get("floating_ip").(string)
+	instanceID := d.Get("instance_id").(string)
+	fixedIP := d.Get("fixed_ip").(string)
+	opts := floatingips.AssociateOpts{
+		FloatingIP: floatingIP,
+		FixedIP:    fixedIP,
+	}
+	if err := floatingips.AssociateInstance(client, instanceID, opts).ExtractErr(); err != nil {
+		return diag.Errorf("error associating floating IP (%s) with instance (%s): %s", floatingIP, instanceID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", floatingIP, instanceID, fixedIP))
+
+	return resourceComputeFloatingIPAssociateRead(ctx, d, meta)
+}
+
+func resourceComputeFloatingIPAssociateRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	fip, err := getComputeFloatingIPByAddress(client, d.Get("floating_ip").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving floating IP")
+	}
+	// the floating IP is disassociated or moved to another instance outside of Terraform
+	if fip.InstanceID == "" || fip.InstanceID != d.Get("instance_id").(string) {
+		d.SetId("")
+		return nil
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", fip.IP, fip.InstanceID, fip.FixedIP))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("floating_ip", fip.IP),
+		d.Set("instance_id", fip.InstanceID),
+		d.Set("fixed_ip", fip.FixedIP),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting compute floating IP associate fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeFloatingIPAssociateDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ComputeV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	opts := floatingips.DisassociateOpts{
+		FloatingIP: d.Get("floating_ip").(string),
+	}
+	err = floatingips.DisassociateInstance(client, d.Get("instance_id").(string), opts).ExtractErr()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disassociating floating IP")
+	}
+
+	return nil
+}
+
+func resourceComputeFloatingIPAssociateImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <floating_ip>/<instance_id>/<fixed_ip>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("floating_ip", parts[0]),
+		d.Set("instance_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
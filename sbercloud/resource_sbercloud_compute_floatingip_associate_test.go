@@ -0,0 +1,106 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccComputeFloatingIPAssociate_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_compute_floatingip_associate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeFloatingIPAssociateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFloatingIPAssociate_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeFloatingIPAssociateExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "floating_ip", "sbercloud_vpc_eip.test", "address"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "sbercloud_compute_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "fixed_ip",
+						"sbercloud_compute_instance.test", "access_ip_v4"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeFloatingIPAssociateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	computeClient, err := config.ComputeV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating Sbercloud compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_compute_floatingip_associate" {
+			continue
+		}
+
+		fip, err := getComputeFloatingIPByAddress(computeClient, rs.Primary.Attributes["floating_ip"])
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+		if fip.InstanceID != "" {
+			return fmt.Errorf("floating IP %s is still associated with instance %s", fip.IP, fip.InstanceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeFloatingIPAssociateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		computeClient, err := config.ComputeV2Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating Sbercloud compute client: %s", err)
+		}
+
+		fip, err := getComputeFloatingIPByAddress(computeClient, rs.Primary.Attributes["floating_ip"])
+		if err != nil {
+			return err
+		}
+		if fip.InstanceID != rs.Primary.Attributes["instance_id"] {
+			return fmt.Errorf("floating IP %s was not associated with instance %s", fip.IP,
+				rs.Primary.Attributes["instance_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccComputeFloatingIPAssociate_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_floatingip_associate" "test" {
+  floating_ip = sbercloud_vpc_eip.test.address
+  instance_id = sbercloud_compute_instance.test.id
+  fixed_ip    = sbercloud_compute_instance.test.access_ip_v4
+}
+`, testAccComputeV2EIPAssociate_Base(rName))
+}
@@ -2,7 +2,9 @@ package sbercloud
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/chnsz/golangsdk/openstack/networking/v1/subnets"
 	"github.com/chnsz/golangsdk/openstack/networking/v2/extensions/security/groups"
 	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -45,10 +48,10 @@ var (
 
 func ResourceComputeInstanceV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeInstanceV2Create,
-		Read:   resourceComputeInstanceV2Read,
-		Update: resourceComputeInstanceV2Update,
-		Delete: resourceComputeInstanceV2Delete,
+		Create:      resourceComputeInstanceV2Create,
+		ReadContext: resourceComputeInstanceV2ReadContext,
+		Update:      resourceComputeInstanceV2Update,
+		Delete:      resourceComputeInstanceV2Delete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeInstanceV2ImportState,
@@ -231,6 +234,7 @@ func ResourceComputeInstanceV2() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Computed: true,
+				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"group": {
@@ -242,12 +246,14 @@ func ResourceComputeInstanceV2() *schema.Resource {
 						"fault_domain": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							ForceNew: true,
 						},
 						"tenancy": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"dedicated", "shared"}, false),
 						},
 						"deh_id": {
 							Type:     schema.TypeString,
@@ -284,11 +290,42 @@ func ResourceComputeInstanceV2() *schema.Resource {
 			},
 
 			// charge info: charging_mode, period_unit, period, auto_renew
-			"charging_mode": schemeChargingMode(novaConflicts),
+			"charging_mode": schemaComputeChargingMode(),
 			"period_unit":   schemaPeriodUnit(novaConflicts),
 			"period":        schemaPeriod(novaConflicts),
 			"auto_renew":    schemaAutoRenew(novaConflicts),
 
+			// spot info: spot_price, spot_duration, spot_duration_count, interruption_policy
+			"spot_price": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: novaConflicts,
+			},
+			"spot_duration": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntBetween(1, 6),
+				ConflictsWith: novaConflicts,
+			},
+			"spot_duration_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"spot_duration"},
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: novaConflicts,
+			},
+			"interruption_policy": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"immediate", "delayed"}, false),
+				ConflictsWith: novaConflicts,
+			},
+
 			"user_id": { // required if in prePaid charging mode with key_pair.
 				Type:     schema.TypeString,
 				Optional: true,
@@ -420,6 +457,54 @@ func ResourceComputeInstanceV2() *schema.Resource {
 	}
 }
 
+// schemaComputeChargingMode returns the charging mode schema which supports the spot instance additionally.
+func schemaComputeChargingMode() *schema.Schema {
+	chargingMode := schemeChargingMode(novaConflicts)
+	chargingMode.ValidateFunc = validation.StringInSlice([]string{
+		"prePaid", "postPaid", "spot",
+	}, false)
+	return chargingMode
+}
+
+// spotServerCreateOpts appends the spot parameters, which are not supported by cloudservers.ServerExtendParam,
+// to the extendparam of the request body.
+type spotServerCreateOpts struct {
+	cloudservers.CreateOpts
+	SpotPrice          string
+	SpotDurationHours  int
+	SpotDurationCount  int
+	InterruptionPolicy string
+}
+
+func (opts spotServerCreateOpts) ToServerCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	server := b["server"].(map[string]interface{})
+	extendParam, ok := server["extendparam"].(map[string]interface{})
+	if !ok {
+		extendParam = make(map[string]interface{})
+	}
+	extendParam["marketType"] = "spot"
+	if opts.SpotPrice != "" {
+		extendParam["spotPrice"] = opts.SpotPrice
+	}
+	if opts.SpotDurationHours != 0 {
+		extendParam["spot_duration_hours"] = opts.SpotDurationHours
+	}
+	if opts.SpotDurationCount != 0 {
+		extendParam["spot_duration_count"] = opts.SpotDurationCount
+	}
+	if opts.InterruptionPolicy != "" {
+		extendParam["interruption_policy"] = opts.InterruptionPolicy
+	}
+	server["extendparam"] = extendParam
+
+	return b, nil
+}
+
 func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*config.Config)
 	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
@@ -546,6 +631,20 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 				return fmtp.Errorf("Error creating SberCloud server: %s", err)
 			}
 			job_id = n.JobID
+		} else if d.Get("charging_mode") == "spot" {
+			// spot, which is charged in postPaid mode.
+			spotOpts := spotServerCreateOpts{
+				CreateOpts:         *createOpts,
+				SpotPrice:          d.Get("spot_price").(string),
+				SpotDurationHours:  d.Get("spot_duration").(int),
+				SpotDurationCount:  d.Get("spot_duration_count").(int),
+				InterruptionPolicy: d.Get("interruption_policy").(string),
+			}
+			n, err := cloudservers.Create(ecsV11Client, spotOpts).ExtractJobResponse()
+			if err != nil {
+				return fmtp.Errorf("Error creating SberCloud spot server: %s", err)
+			}
+			job_id = n.JobID
 		} else {
 			// postPaid.
 			n, err := cloudservers.Create(ecsV11Client, createOpts).ExtractJobResponse()
@@ -695,6 +794,51 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// resourceComputeInstanceV2ReadContext reports the spot instance which has been interrupted and released, the
+// instance is removed from the state and will be re-created.
+func resourceComputeInstanceV2ReadContext(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	serverID := d.Id()
+	if err := resourceComputeInstanceV2Read(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Id() == "" && d.Get("charging_mode").(string) == "spot" {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The spot instance (%s) has been interrupted and released", serverID),
+				Detail:   "The instance is removed from the state and will be re-created on the next apply.",
+			},
+		}
+	}
+	return nil
+}
+
+// setComputeSpotPrice sets the spot price from the market information of the server, which is not parsed by
+// cloudservers.Get. The configured value is kept if the price is not returned.
+func setComputeSpotPrice(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	resp, err := client.Request("GET", client.ServiceURL("cloudservers", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return fmtp.Errorf("Error retrieving the market information of spot instance (%s): %s", d.Id(), err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	spotPrice := pathSearch("server.market_info.spot_options.spot_price", respBody, nil)
+	if spotPrice == nil {
+		return nil
+	}
+	if price, ok := spotPrice.(float64); ok {
+		return d.Set("spot_price", strconv.FormatFloat(price, 'f', -1, 64))
+	}
+	return d.Set("spot_price", fmt.Sprint(spotPrice))
+}
+
 func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*config.Config)
 	ecsClient, err := config.ComputeV1Client(GetRegion(d, config))
@@ -731,6 +875,11 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 		d.Set("charging_mode", "postPaid")
 	} else if chageMode == "1" {
 		d.Set("charging_mode", "prePaid")
+	} else if chageMode == "2" {
+		d.Set("charging_mode", "spot")
+		if err := setComputeSpotPrice(d, ecsClient); err != nil {
+			return err
+		}
 	}
 
 	flavorInfo := server.Flavor
@@ -835,14 +984,7 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// set scheduler_hints
-	osHints := server.OsSchedulerHints
-	if len(osHints.Group) > 0 {
-		schedulerHints := make([]map[string]interface{}, len(osHints.Group))
-		for i, v := range osHints.Group {
-			schedulerHints[i] = map[string]interface{}{
-				"group": v,
-			}
-		}
+	if schedulerHints := flattenComputeSchedulerHints(d, server.OsSchedulerHints); len(schedulerHints) > 0 {
 		d.Set("scheduler_hints", schedulerHints)
 	}
 
@@ -1225,6 +1367,14 @@ func validateComputeInstanceConfig(d *schema.ResourceData, config *config.Config
 		}
 	}
 
+	if d.Get("charging_mode").(string) != "spot" {
+		for _, key := range []string{"spot_price", "spot_duration", "spot_duration_count", "interruption_policy"} {
+			if _, ok := d.GetOk(key); ok {
+				return fmtp.Errorf("%s can only be specified when charging_mode is set to spot", key)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1320,10 +1470,48 @@ func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw
 		Tenancy:         schedulerHintsRaw["tenancy"].(string),
 		DedicatedHostID: schedulerHintsRaw["deh_id"].(string),
 	}
+	if faultDomain := schedulerHintsRaw["fault_domain"].(string); faultDomain != "" {
+		schedulerHints.AdditionalProperties = map[string]interface{}{
+			"fault_domain": faultDomain,
+		}
+	}
 
 	return schedulerHints
 }
 
+// flattenComputeSchedulerHints builds the scheduler_hints from the os:scheduler_hints of the server, the fault domain
+// is only honored at boot time, so it's read back here to avoid the diff after import.
+// The fault_domain, the tenancy and the deh_id are kept from the existing state if the hints have been set, so that
+// a fault domain assigned by the service doesn't replace the instance when the hint is not configured.
+func flattenComputeSchedulerHints(d *schema.ResourceData, osHints cloudservers.OsSchedulerHints) []map[string]interface{} {
+	if len(osHints.Group) == 0 && osHints.FaultDomain == "" {
+		return nil
+	}
+
+	schedulerHint := map[string]interface{}{
+		"fault_domain": osHints.FaultDomain,
+		"tenancy":      "",
+		"deh_id":       "",
+	}
+	if hints := d.Get("scheduler_hints").(*schema.Set).List(); len(hints) > 0 {
+		hint := hints[0].(map[string]interface{})
+		schedulerHint["fault_domain"] = hint["fault_domain"]
+		schedulerHint["tenancy"] = hint["tenancy"]
+		schedulerHint["deh_id"] = hint["deh_id"]
+	}
+
+	if len(osHints.Group) > 0 {
+		schedulerHint["group"] = osHints.Group[0]
+	}
+	if len(osHints.Tenancy) > 0 {
+		schedulerHint["tenancy"] = osHints.Tenancy[0]
+	}
+	if len(osHints.DedicatedHostID) > 0 {
+		schedulerHint["deh_id"] = osHints.DedicatedHostID[0]
+	}
+	return []map[string]interface{}{schedulerHint}
+}
+
 func getImage(client *golangsdk.ServiceClient, id, name string) (*cloudimages.Image, error) {
 	listOpts := &cloudimages.ListOpts{
 		ID:    id,
@@ -1478,6 +1666,11 @@ func resourceComputeSchedulerHintsHash(v interface{}) int {
 		buf.WriteString(fmt.Sprintf("%s-", m["group"].(string)))
 	}
 
+	// the fault domain is only hashed when it's specified, the value assigned by the service is ignored
+	if v, ok := m["fault_domain"].(string); ok && v != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
 	if m["tenancy"] != nil {
 		buf.WriteString(fmt.Sprintf("%s-", m["tenancy"].(string)))
 	}
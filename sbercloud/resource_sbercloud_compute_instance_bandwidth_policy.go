@@ -0,0 +1,331 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the QoS policy of an instance is shared by the bandwidth policies of both directions
+const computeBandwidthQosPolicyPrefix = "instance-bandwidth-"
+
+// ResourceComputeInstanceBandwidthPolicy limits the bandwidth of an instance in one direction through the QoS
+// extension of the networking API. The limits of both directions are the rules of the same QoS policy, which is bound
+// to all ports of the instance, and the policy is removed with its last rule.
+func ResourceComputeInstanceBandwidthPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeInstanceBandwidthPolicyCreate,
+		ReadContext:   resourceComputeInstanceBandwidthPolicyRead,
+		UpdateContext: resourceComputeInstanceBandwidthPolicyUpdate,
+		DeleteContext: resourceComputeInstanceBandwidthPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceComputeInstanceBandwidthPolicyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"egress", "ingress"}, false),
+			},
+			"max_kbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"burst_limit_kbps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getComputeBandwidthQosPolicy returns the QoS policy of the instance, or nil if the instance has no bandwidth limit.
+// ErrDefault404 means that the QoS extension is not available.
+func getComputeBandwidthQosPolicy(client *golangsdk.ServiceClient, instanceID string) (interface{}, error) {
+	url := client.ServiceURL("qos", "policies") + "?name=" + computeBandwidthQosPolicyPrefix + instanceID
+	resp, err := client.Request("GET", url, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return pathSearch("policies|[0]", respBody, nil), nil
+}
+
+func getComputeBandwidthRule(policy interface{}, direction string) interface{} {
+	expression := fmt.Sprintf("rules[?type=='bandwidth_limit' && direction=='%s']|[0]", direction)
+	return pathSearch(expression, policy, nil)
+}
+
+// bindComputeBandwidthQosPolicy binds the QoS policy to all ports of the instance, an empty policy ID unbinds it.
+func bindComputeBandwidthQosPolicy(client *golangsdk.ServiceClient, instanceID, policyID string) error {
+	allPages, err := ports.List(client, ports.ListOpts{DeviceID: instanceID}).AllPages()
+	if err != nil {
+		return fmt.Errorf("error retrieving ports of instance (%s): %s", instanceID, err)
+	}
+	portList, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return err
+	}
+	if len(portList) == 0 && policyID != "" {
+		return fmt.Errorf("instance (%s) has no port to limit the bandwidth", instanceID)
+	}
+
+	var qosPolicyID interface{}
+	if policyID != "" {
+		qosPolicyID = policyID
+	}
+	for _, port := range portList {
+		_, err = client.Request("PUT", client.ServiceURL("ports", port.ID), &golangsdk.RequestOpts{
+			JSONBody: map[string]interface{}{
+				"port": map[string]interface{}{"qos_policy_id": qosPolicyID},
+			},
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return fmt.Errorf("error binding QoS policy to port (%s): %s", port.ID, err)
+		}
+	}
+	return nil
+}
+
+func buildComputeBandwidthRuleOpts(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"bandwidth_limit_rule": utils.RemoveNil(map[string]interface{}{
+			"max_kbps":       d.Get("max_kbps"),
+			"max_burst_kbps": valueIgnoreEmpty(d.Get("burst_limit_kbps")),
+		}),
+	}
+}
+
+func resourceComputeInstanceBandwidthPolicyCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	direction := d.Get("direction").(string)
+	// the policies of both directions share the QoS policy of the instance
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	policy, err := getComputeBandwidthQosPolicy(client, instanceID)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return diag.Errorf("the bandwidth of instance (%s) can't be limited, the QoS extension of the networking "+
+				"API is not available in this region", instanceID)
+		}
+		return diag.Errorf("error retrieving QoS policy of instance (%s): %s", instanceID, err)
+	}
+	if getComputeBandwidthRule(policy, direction) != nil {
+		return diag.Errorf("the %s bandwidth of instance (%s) is already limited, please import the policy",
+			direction, instanceID)
+	}
+
+	policyID := pathSearch("id", policy, "").(string)
+	if policyID == "" {
+		resp, err := client.Request("POST", client.ServiceURL("qos", "policies"), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"policy": map[string]interface{}{
+					"name": computeBandwidthQosPolicyPrefix + instanceID,
+				},
+			},
+			OkCodes: []int{201},
+		})
+		if err != nil {
+			return diag.Errorf("error creating QoS policy of instance (%s): %s", instanceID, err)
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		policyID = pathSearch("policy.id", respBody, "").(string)
+	}
+
+	ruleOpts := buildComputeBandwidthRuleOpts(d)
+	ruleOpts["bandwidth_limit_rule"].(map[string]interface{})["direction"] = direction
+	_, err = client.Request("POST", client.ServiceURL("qos", "policies", policyID, "bandwidth_limit_rules"),
+		&golangsdk.RequestOpts{
+			JSONBody: ruleOpts,
+			OkCodes:  []int{201},
+		})
+	if err != nil {
+		return diag.Errorf("error limiting the %s bandwidth of instance (%s): %s", direction, instanceID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, direction))
+
+	if err := bindComputeBandwidthQosPolicy(client, instanceID, policyID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceComputeInstanceBandwidthPolicyRead(ctx, d, meta)
+}
+
+func resourceComputeInstanceBandwidthPolicyRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	policy, err := getComputeBandwidthQosPolicy(client, instanceID)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			// the state is kept as it is, because the limit can't be checked
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Unable to check the bandwidth policy of instance (%s)", instanceID),
+					Detail:   "The QoS extension of the networking API is not available in this region.",
+				},
+			}
+		}
+		return diag.Errorf("error retrieving QoS policy of instance (%s): %s", instanceID, err)
+	}
+	rule := getComputeBandwidthRule(policy, d.Get("direction").(string))
+	if rule == nil {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("max_kbps", pathSearch("max_kbps", rule, nil)),
+		d.Set("burst_limit_kbps", pathSearch("max_burst_kbps", rule, nil)),
+		d.Set("qos_policy_id", pathSearch("id", policy, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting compute instance bandwidth policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeInstanceBandwidthPolicyUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	policy, err := getComputeBandwidthQosPolicy(client, d.Get("instance_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving QoS policy of instance (%s): %s", d.Get("instance_id"), err)
+	}
+	ruleID := pathSearch("id", getComputeBandwidthRule(policy, d.Get("direction").(string)), "").(string)
+	policyID := pathSearch("id", policy, "").(string)
+	_, err = client.Request("PUT", client.ServiceURL("qos", "policies", policyID, "bandwidth_limit_rules", ruleID),
+		&golangsdk.RequestOpts{
+			JSONBody: buildComputeBandwidthRuleOpts(d),
+			OkCodes:  []int{200},
+		})
+	if err != nil {
+		return diag.Errorf("error updating compute instance bandwidth policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceComputeInstanceBandwidthPolicyRead(ctx, d, meta)
+}
+
+func resourceComputeInstanceBandwidthPolicyDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	policy, err := getComputeBandwidthQosPolicy(client, instanceID)
+	if err != nil {
+		return diag.Errorf("error retrieving QoS policy of instance (%s): %s", instanceID, err)
+	}
+	rule := getComputeBandwidthRule(policy, d.Get("direction").(string))
+	if rule == nil {
+		return nil
+	}
+
+	policyID := pathSearch("id", policy, "").(string)
+	ruleID := pathSearch("id", rule, "").(string)
+	_, err = client.Request("DELETE", client.ServiceURL("qos", "policies", policyID, "bandwidth_limit_rules", ruleID),
+		&golangsdk.RequestOpts{
+			OkCodes: []int{204},
+		})
+	if err != nil {
+		return diag.Errorf("error deleting compute instance bandwidth policy (%s): %s", d.Id(), err)
+	}
+
+	// the policy is kept while the bandwidth of the other direction is limited
+	if len(pathSearch("rules", policy, make([]interface{}, 0)).([]interface{})) > 1 {
+		return nil
+	}
+	if err := bindComputeBandwidthQosPolicy(client, instanceID, ""); err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.Request("DELETE", client.ServiceURL("qos", "policies", policyID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return diag.Errorf("error deleting QoS policy of instance (%s): %s", instanceID, err)
+	}
+
+	return nil
+}
+
+func resourceComputeInstanceBandwidthPolicyImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<direction>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("direction", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,90 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccComputeInstanceBandwidthPolicy_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	egressName := "sbercloud_compute_instance_bandwidth_policy.egress"
+	ingressName := "sbercloud_compute_instance_bandwidth_policy.ingress"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceBandwidthPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceBandwidthPolicy_basic(rName, 10240),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(egressName, "instance_id",
+						"sbercloud_compute_instance.test", "id"),
+					resource.TestCheckResourceAttr(egressName, "direction", "egress"),
+					resource.TestCheckResourceAttr(egressName, "max_kbps", "10240"),
+					resource.TestCheckResourceAttr(egressName, "burst_limit_kbps", "2048"),
+					resource.TestCheckResourceAttr(ingressName, "direction", "ingress"),
+					resource.TestCheckResourceAttrPair(egressName, "qos_policy_id", ingressName, "qos_policy_id"),
+				),
+			},
+			{
+				Config: testAccComputeInstanceBandwidthPolicy_basic(rName, 20480),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(egressName, "max_kbps", "20480"),
+				),
+			},
+			{
+				ResourceName:      egressName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeInstanceBandwidthPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.NetworkingV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating SberCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_compute_instance_bandwidth_policy" {
+			continue
+		}
+
+		policy, err := getComputeBandwidthQosPolicy(client, rs.Primary.Attributes["instance_id"])
+		if err != nil {
+			return err
+		}
+		if policy != nil {
+			return fmt.Errorf("QoS policy of instance %s still exists", rs.Primary.Attributes["instance_id"])
+		}
+	}
+	return nil
+}
+
+func testAccComputeInstanceBandwidthPolicy_basic(rName string, maxKbps int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance_bandwidth_policy" "egress" {
+  instance_id      = sbercloud_compute_instance.test.id
+  direction        = "egress"
+  max_kbps         = %d
+  burst_limit_kbps = 2048
+}
+
+resource "sbercloud_compute_instance_bandwidth_policy" "ingress" {
+  instance_id = sbercloud_compute_instance.test.id
+  direction   = "ingress"
+  max_kbps    = 10240
+}
+`, testAccComputeV2Instance_basic(rName), maxKbps)
+}
@@ -0,0 +1,98 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceComputeInstanceReboot reboots an instance when it is created, all the arguments force a new resource, so
+// changing the trigger reboots the instance again. Deleting the resource does nothing.
+func ResourceComputeInstanceReboot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeInstanceRebootCreate,
+		ReadContext:   resourceComputeInstanceRebootRead,
+		DeleteContext: resourceComputeInstanceRebootDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SOFT",
+				ValidateFunc: validation.StringInSlice([]string{"SOFT", "HARD"}, false),
+			},
+			"trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInstanceRebootCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ComputeV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	opts := servers.RebootOpts{
+		Type: servers.RebootMethod(d.Get("type").(string)),
+	}
+	if err := servers.Reboot(client, instanceID, opts).ExtractErr(); err != nil {
+		return diag.Errorf("error rebooting instance (%s): %s", instanceID, describeComputeInstanceActionError(err))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"REBOOT", "HARD_REBOOT"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      ServerV2StateRefreshFunc(client, instanceID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for instance (%s) to be active after the reboot: %s", instanceID, err)
+	}
+	d.SetId(resource.UniqueId())
+
+	return resourceComputeInstanceRebootRead(ctx, d, meta)
+}
+
+// resourceComputeInstanceRebootRead only refreshes the region, the reboot is an action which can't be queried.
+func resourceComputeInstanceRebootRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	if err := d.Set("region", GetRegion(d, conf)); err != nil {
+		return diag.Errorf("error setting compute instance reboot fields: %s", err)
+	}
+	return nil
+}
+
+func resourceComputeInstanceRebootDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,50 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeInstanceReboot_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_compute_instance_reboot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceReboot_basic(rName, "SOFT", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_compute_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SOFT"),
+					resource.TestCheckResourceAttr("sbercloud_compute_instance.test", "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccComputeInstanceReboot_basic(rName, "HARD", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "HARD"),
+					resource.TestCheckResourceAttr(resourceName, "trigger", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeInstanceReboot_basic(rName, rebootType, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance_reboot" "test" {
+  instance_id = sbercloud_compute_instance.test.id
+  type        = "%s"
+  trigger     = "%s"
+}
+`, testAccComputeV2Instance_basic(rName), rebootType, trigger)
+}
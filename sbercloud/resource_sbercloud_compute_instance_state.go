@@ -0,0 +1,236 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/compute/v2/servers"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// the instance states of the resource and the corresponding statuses of Nova
+var computeInstanceStates = map[string]string{
+	"active":    "ACTIVE",
+	"shutoff":   "SHUTOFF",
+	"suspended": "SUSPENDED",
+}
+
+// computeInstanceStateActions holds the Nova actions of the valid state transitions, the key is <from>/<to>
+var computeInstanceStateActions = map[string]string{
+	"active/shutoff":   "os-stop",
+	"active/suspended": "suspend",
+	"shutoff/active":   "os-start",
+	"suspended/active": "resume",
+}
+
+// ResourceComputeInstanceState manages the power state of an existing instance, an instance can be stopped or
+// suspended without being deleted. Deleting the resource does not change the state of the instance.
+func ResourceComputeInstanceState() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeInstanceStateCreate,
+		ReadContext:   resourceComputeInstanceStateRead,
+		UpdateContext: resourceComputeInstanceStateUpdate,
+		DeleteContext: resourceComputeInstanceStateDelete,
+
+		CustomizeDiff: resourceComputeInstanceStateCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"active", "shutoff", "suspended"}, false),
+			},
+		},
+	}
+}
+
+// computeInstanceStateName converts the Nova status to the state of the resource, the statuses which can't be managed
+// by the resource are returned in lower case.
+func computeInstanceStateName(status string) string {
+	for state, novaStatus := range computeInstanceStates {
+		if novaStatus == status {
+			return state
+		}
+	}
+	return strings.ToLower(status)
+}
+
+// computeInstanceStateAction returns the Nova action to change the instance from one state to another, an empty
+// action means that the instance is already in the target state.
+func computeInstanceStateAction(from, to string) (string, error) {
+	if from == to {
+		return "", nil
+	}
+	if action, ok := computeInstanceStateActions[from+"/"+to]; ok {
+		return action, nil
+	}
+
+	switch {
+	case from == "shutoff" && to == "suspended":
+		return "", fmt.Errorf("cannot suspend the instance because it is stopped, only running instances can be " +
+			"suspended")
+	case from == "suspended" && to == "shutoff":
+		return "", fmt.Errorf("cannot stop the instance because it is suspended, resume it (state active) first")
+	default:
+		return "", fmt.Errorf("cannot change the instance from the %s state to the %s state", from, to)
+	}
+}
+
+func resourceComputeInstanceStateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("state") {
+		return nil
+	}
+
+	// the transitions from the states which can't be managed by the resource are checked on apply
+	oldState, newState := d.GetChange("state")
+	if _, ok := computeInstanceStates[oldState.(string)]; !ok {
+		return nil
+	}
+	_, err := computeInstanceStateAction(oldState.(string), newState.(string))
+	return err
+}
+
+// describeComputeInstanceActionError converts the common errors of the instance actions to readable messages.
+func describeComputeInstanceActionError(err error) string {
+	switch e := err.(type) {
+	case golangsdk.ErrDefault404:
+		return "the instance does not exist"
+	case golangsdk.ErrDefault403:
+		return "the action is not allowed for the instance, e.g. the flavor does not support suspension"
+	case golangsdk.ErrUnexpectedResponseCode:
+		if e.Actual == 409 {
+			return "the instance is busy or its current state does not allow the action, e.g. a task is in progress"
+		}
+	}
+	return err.Error()
+}
+
+func changeComputeInstanceState(ctx context.Context, client *golangsdk.ServiceClient, instanceID, state string,
+	timeout time.Duration) error {
+	server, err := servers.Get(client, instanceID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving instance (%s): %s", instanceID, err)
+	}
+
+	current := computeInstanceStateName(server.Status)
+	action, err := computeInstanceStateAction(current, state)
+	if err != nil {
+		return fmt.Errorf("error changing the state of instance (%s): %s", instanceID, err)
+	}
+	if action == "" {
+		return nil
+	}
+
+	_, err = client.Request("POST", client.ServiceURL("servers", instanceID, "action"), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{action: nil},
+		OkCodes:  []int{202},
+	})
+	if err != nil {
+		return fmt.Errorf("error changing instance (%s) from the %s state to the %s state: %s", instanceID, current,
+			state, describeComputeInstanceActionError(err))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{computeInstanceStates[current]},
+		Target:       []string{computeInstanceStates[state]},
+		Refresh:      ServerV2StateRefreshFunc(client, instanceID),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to be %s: %s", instanceID, state, err)
+	}
+	return nil
+}
+
+func resourceComputeInstanceStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ComputeV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	err = changeComputeInstanceState(ctx, client, instanceID, d.Get("state").(string),
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(instanceID)
+
+	return resourceComputeInstanceStateRead(ctx, d, meta)
+}
+
+func resourceComputeInstanceStateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	server, err := servers.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving compute instance state")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", server.ID),
+		d.Set("state", computeInstanceStateName(server.Status)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting compute instance state fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeInstanceStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ComputeV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	err = changeComputeInstanceState(ctx, client, d.Id(), d.Get("state").(string), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceComputeInstanceStateRead(ctx, d, meta)
+}
+
+func resourceComputeInstanceStateDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,63 @@
+package sbercloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccComputeInstanceState_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_compute_instance_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceState_basic(rName, "suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_compute_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "suspended"),
+				),
+			},
+			{
+				Config:      testAccComputeInstanceState_basic(rName, "shutoff"),
+				ExpectError: regexp.MustCompile(`cannot stop the instance because it is suspended`),
+			},
+			{
+				Config: testAccComputeInstanceState_basic(rName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+				),
+			},
+			{
+				Config: testAccComputeInstanceState_basic(rName, "shutoff"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "shutoff"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeInstanceState_basic(rName, state string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance_state" "test" {
+  instance_id = sbercloud_compute_instance.test.id
+  state       = "%s"
+}
+`, testAccComputeV2Instance_basic(rName), state)
+}
@@ -66,6 +66,93 @@ func TestAccComputeV2Instance_disks(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_spot(t *testing.T) {
+	var instance servers.Server
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_compute_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_spot(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "charging_mode", "spot"),
+					resource.TestCheckResourceAttr(resourceName, "spot_duration", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_duration_count", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "spot_price"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_schedulerHints(t *testing.T) {
+	var instance servers.Server
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_compute_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_schedulerHints(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_hints.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "scheduler_hints.0.group",
+						"sbercloud_compute_servergroup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_hints.0.fault_domain", ""),
+				),
+			},
+			{
+				// the fault domain is not specified, the one assigned by the service must not replace the instance
+				Config:   testAccComputeV2Instance_schedulerHints(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_faultDomain(t *testing.T) {
+	var instance servers.Server
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_compute_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckEcsFaultDomain(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_faultDomain(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_hints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduler_hints.0.fault_domain",
+						SBC_ECS_FAULT_DOMAIN),
+				),
+			},
+			{
+				Config:   testAccComputeV2Instance_faultDomain(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_tags(t *testing.T) {
 	var instance servers.Server
 
@@ -307,6 +394,82 @@ resource "sbercloud_compute_instance" "test" {
 `, testAccCompute_data, rName)
 }
 
+func testAccComputeV2Instance_spot(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance" "test" {
+  name              = "%s"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  system_disk_type  = "SSD"
+
+  network {
+    uuid = data.sbercloud_vpc_subnet.test.id
+  }
+
+  charging_mode       = "spot"
+  spot_duration       = 1
+  spot_duration_count = 2
+  interruption_policy = "immediate"
+}
+`, testAccCompute_data, rName)
+}
+
+func testAccComputeV2Instance_schedulerHints(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_servergroup" "test" {
+  name     = "%s"
+  policies = ["anti-affinity"]
+}
+
+resource "sbercloud_compute_instance" "test" {
+  name              = "%s"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  system_disk_type  = "SSD"
+
+  network {
+    uuid = data.sbercloud_vpc_subnet.test.id
+  }
+
+  scheduler_hints {
+    group   = sbercloud_compute_servergroup.test.id
+    tenancy = "shared"
+  }
+}
+`, testAccCompute_data, rName, rName)
+}
+
+func testAccComputeV2Instance_faultDomain(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance" "test" {
+  name              = "%s"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  system_disk_type  = "SSD"
+
+  network {
+    uuid = data.sbercloud_vpc_subnet.test.id
+  }
+
+  scheduler_hints {
+    fault_domain = "%s"
+  }
+}
+`, testAccCompute_data, rName, SBC_ECS_FAULT_DOMAIN)
+}
+
 func testAccComputeV2Instance_tags(rName string) string {
 	return fmt.Sprintf(`
 %s
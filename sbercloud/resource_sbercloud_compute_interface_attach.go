@@ -0,0 +1,364 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/compute/v2/extensions/attachinterfaces"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/chnsz/golangsdk/pagination"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceComputeInterfaceAttach() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeInterfaceAttachCreate,
+		ReadContext:   resourceComputeInterfaceAttachRead,
+		UpdateContext: resourceComputeInterfaceAttachUpdate,
+		DeleteContext: resourceComputeInterfaceAttachDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"port_id", "network_id"},
+			},
+			"subnet_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"port_id"},
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"fixed_ip": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"port_id"},
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source_dest_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mac_addr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mac": {
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "use mac_addr instead",
+			},
+		},
+	}
+}
+
+func parseComputeInterfaceAttachID(id string) (instanceID, portID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		err = fmt.Errorf("invalid format of the compute interface attachment ID (%s), must be "+
+			"<instance_id>/<port_id>", id)
+		return
+	}
+	return parts[0], parts[1], nil
+}
+
+// checkComputeInterfaceAttachNetwork returns an error if the network to be attached is the network of the primary
+// NIC of the instance, because the instance cannot have two NICs in the same network through the primary NIC.
+func checkComputeInterfaceAttachNetwork(client *golangsdk.ServiceClient, instanceID, networkID string) error {
+	resp, err := client.Request("GET", client.ServiceURL("ports")+"?device_id="+instanceID, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return fmt.Errorf("error querying the ports of the compute instance (%s): %s", instanceID, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	primaryNetworkID := pathSearch("ports[?\"binding:vif_details\".primary_interface] | [0].network_id",
+		respBody, "").(string)
+	if primaryNetworkID != "" && primaryNetworkID == networkID {
+		return fmt.Errorf("the network (%s) is the network of the primary NIC of the compute instance (%s), "+
+			"please attach the interface to another network", networkID, instanceID)
+	}
+	return nil
+}
+
+func updateComputeInterfaceAttachPort(client *golangsdk.ServiceClient, d *schema.ResourceData, portID string) error {
+	if d.HasChange("security_group_ids") {
+		secGroups := utils.ExpandToStringListBySet(d.Get("security_group_ids").(*schema.Set))
+		if _, err := ports.Update(client, portID, ports.UpdateOpts{SecurityGroups: &secGroups}).Extract(); err != nil {
+			return fmt.Errorf("error updating the security groups of port (%s): %s", portID, err)
+		}
+	}
+
+	if d.HasChange("source_dest_check") {
+		var err error
+		if d.Get("source_dest_check").(bool) {
+			err = enableSourceDestCheck(client, portID)
+		} else {
+			err = disableSourceDestCheck(client, portID)
+		}
+		if err != nil {
+			return fmt.Errorf("error updating the source/destination check of port (%s): %s", portID, err)
+		}
+	}
+	return nil
+}
+
+func resourceComputeInterfaceAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+	networkClient, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	networkID := d.Get("network_id").(string)
+	portID := d.Get("port_id").(string)
+	if portID != "" {
+		port, err := ports.Get(networkClient, portID).Extract()
+		if err != nil {
+			return diag.Errorf("error retrieving port (%s): %s", portID, err)
+		}
+		networkID = port.NetworkID
+	}
+	if err := checkComputeInterfaceAttachNetwork(networkClient, instanceID, networkID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the API takes an array of IPs, but only one element is allowed in the array
+	var fixedIPs []attachinterfaces.FixedIP
+	fixedIP := d.Get("fixed_ip").(string)
+	subnetID := d.Get("subnet_id").(string)
+	if fixedIP != "" || subnetID != "" {
+		fixedIPs = append(fixedIPs, attachinterfaces.FixedIP{
+			SubnetID:  subnetID,
+			IPAddress: fixedIP,
+		})
+	}
+	attachOpts := attachinterfaces.CreateOpts{
+		PortID:    portID,
+		NetworkID: d.Get("network_id").(string),
+		FixedIPs:  fixedIPs,
+	}
+	attachment, err := attachinterfaces.Create(computeClient, instanceID, attachOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error attaching interface to compute instance (%s): %s", instanceID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"ATTACHING"},
+		Target:       []string{"ATTACHED"},
+		Refresh:      computeInterfaceAttachStateRefreshFunc(computeClient, instanceID, attachment.PortID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the interface to be attached to compute instance (%s): %s",
+			instanceID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, attachment.PortID))
+
+	if err := updateComputeInterfaceAttachPort(networkClient, d, attachment.PortID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceComputeInterfaceAttachRead(ctx, d, meta)
+}
+
+func resourceComputeInterfaceAttachRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+	networkClient, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	instanceID, portID, err := parseComputeInterfaceAttachID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attachment, err := attachinterfaces.Get(computeClient, instanceID, portID).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving compute interface attachment")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceID),
+		d.Set("port_id", attachment.PortID),
+		d.Set("network_id", attachment.NetID),
+		d.Set("mac_addr", attachment.MACAddr),
+		d.Set("mac", attachment.MACAddr),
+	)
+	if len(attachment.FixedIPs) > 0 {
+		mErr = multierror.Append(mErr,
+			d.Set("fixed_ip", attachment.FixedIPs[0].IPAddress),
+			d.Set("subnet_id", attachment.FixedIPs[0].SubnetID),
+		)
+	}
+
+	port, err := ports.Get(networkClient, attachment.PortID).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving port (%s): %s", attachment.PortID, err)
+	}
+	mErr = multierror.Append(mErr,
+		d.Set("security_group_ids", port.SecurityGroups),
+		d.Set("source_dest_check", len(port.AllowedAddressPairs) == 0),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting compute interface attachment fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeInterfaceAttachUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	networkClient, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	if err := updateComputeInterfaceAttachPort(networkClient, d, d.Get("port_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceComputeInterfaceAttachRead(ctx, d, meta)
+}
+
+func resourceComputeInterfaceAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	computeClient, err := conf.ComputeV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	instanceID, portID, err := parseComputeInterfaceAttachID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := attachinterfaces.Delete(computeClient, instanceID, portID).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error detaching compute interface")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"ATTACHED"},
+		Target:       []string{"DETACHED"},
+		Refresh:      computeInterfaceDetachStateRefreshFunc(computeClient, instanceID, portID),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the interface (%s) to be detached: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func computeInterfaceAttachStateRefreshFunc(client *golangsdk.ServiceClient, instanceID,
+	portID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := attachinterfaces.Get(client, instanceID, portID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return attachment, "ATTACHING", nil
+			}
+			return nil, "", err
+		}
+		return attachment, "ATTACHED", nil
+	}
+}
+
+// computeInterfaceDetachStateRefreshFunc checks the interface list of the instance, the interface is detached once
+// it disappears from the list.
+func computeInterfaceDetachStateRefreshFunc(client *golangsdk.ServiceClient, instanceID,
+	portID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var found bool
+		err := attachinterfaces.List(client, instanceID).EachPage(func(page pagination.Page) (bool, error) {
+			interfaces, err := attachinterfaces.ExtractInterfaces(page)
+			if err != nil {
+				return false, err
+			}
+			for _, v := range interfaces {
+				if v.PortID == portID {
+					found = true
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DETACHED", nil
+			}
+			return nil, "", err
+		}
+		if found {
+			return portID, "ATTACHED", nil
+		}
+		return "", "DETACHED", nil
+	}
+}
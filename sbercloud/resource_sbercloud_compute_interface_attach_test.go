@@ -2,6 +2,7 @@ package sbercloud
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -26,9 +27,36 @@ func TestAccComputeV2InterfaceAttach_Basic(t *testing.T) {
 				Config: testAccComputeV2InterfaceAttach_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeV2InterfaceAttachExists("sbercloud_compute_interface_attach.ai_1", &ai),
-					testAccCheckComputeV2InterfaceAttachIP(&ai, "192.168.0.199"),
+					testAccCheckComputeV2InterfaceAttachIP(&ai, "192.168.10.199"),
+					resource.TestCheckResourceAttrPair("sbercloud_compute_interface_attach.ai_1", "network_id",
+						"sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrSet("sbercloud_compute_interface_attach.ai_1", "port_id"),
+					resource.TestCheckResourceAttrSet("sbercloud_compute_interface_attach.ai_1", "mac_addr"),
+					resource.TestCheckResourceAttr("sbercloud_compute_interface_attach.ai_1",
+						"security_group_ids.#", "1"),
 				),
 			},
+			{
+				ResourceName:      "sbercloud_compute_interface_attach.ai_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeV2InterfaceAttach_primaryNetwork(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InterfaceAttachDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeV2InterfaceAttach_primaryNetwork(rName),
+				ExpectError: regexp.MustCompile(`is the network of the primary NIC`),
+			},
 		},
 	})
 }
@@ -138,10 +166,41 @@ resource "sbercloud_compute_instance" "instance_1" {
   }
 }
 
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%s"
+  vpc_id     = data.sbercloud_vpc_subnet.test.vpc_id
+  cidr       = "192.168.10.0/24"
+  gateway_ip = "192.168.10.1"
+}
+
+resource "sbercloud_compute_interface_attach" "ai_1" {
+  instance_id        = sbercloud_compute_instance.instance_1.id
+  network_id         = sbercloud_vpc_subnet.test.id
+  fixed_ip           = "192.168.10.199"
+  security_group_ids = [data.sbercloud_networking_secgroup.test.id]
+}
+`, testAccCompute_data, rName, rName)
+}
+
+func testAccComputeV2InterfaceAttach_primaryNetwork(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_compute_instance" "instance_1" {
+  name              = "%s"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+
+  network {
+    uuid = data.sbercloud_vpc_subnet.test.id
+  }
+}
+
 resource "sbercloud_compute_interface_attach" "ai_1" {
   instance_id = sbercloud_compute_instance.instance_1.id
-  network_id =  data.sbercloud_vpc_subnet.test.id
-  fixed_ip = "192.168.0.199"
+  network_id  = data.sbercloud_vpc_subnet.test.id
 }
 `, testAccCompute_data, rName)
 }
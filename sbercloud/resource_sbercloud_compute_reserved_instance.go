@@ -0,0 +1,232 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceComputeReservedInstance purchases a prepaid reservation of ECS flavors. A reservation can not be
+// unsubscribed, it is released when it expires, so the deletion returns an error.
+func ResourceComputeReservedInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeReservedInstanceCreate,
+		ReadContext:   resourceComputeReservedInstanceRead,
+		UpdateContext: resourceComputeReservedInstanceUpdate,
+		DeleteContext: resourceComputeReservedInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quantity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"period_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"month", "year"}, false),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
+			"auto_renew": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remaining_quantity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildComputeReservedInstanceCreateOpts(d *schema.ResourceData, conf *config.Config) map[string]interface{} {
+	return map[string]interface{}{
+		"reserved_instance": utils.RemoveNil(map[string]interface{}{
+			"flavor_ref":            d.Get("flavor_id"),
+			"availability_zone":     d.Get("availability_zone"),
+			"instance_count":        d.Get("quantity"),
+			"period_type":           d.Get("period_type"),
+			"period_num":            d.Get("period"),
+			"is_auto_renew":         d.Get("auto_renew").(string) == "true",
+			"is_auto_pay":           true,
+			"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		}),
+	}
+}
+
+func resourceComputeReservedInstanceCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ComputeV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ECS client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("reserved-instances"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildComputeReservedInstanceCreateOpts(d, conf),
+	})
+	if err != nil {
+		return diag.Errorf("error purchasing ECS reserved instance: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orderID := pathSearch("order_id", respBody, "").(string)
+	if orderID == "" {
+		return diag.Errorf("unable to find the order ID of the ECS reserved instance from the API response")
+	}
+	id := pathSearch("reserved_instance_ids|[0]", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the ECS reserved instance ID from the API response")
+	}
+	if err := common.WaitOrderComplete(ctx, d, conf, orderID); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return resourceComputeReservedInstanceRead(ctx, d, meta)
+}
+
+func resourceComputeReservedInstanceRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ComputeV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ECS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("reserved-instances", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ECS reserved instance")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instance := pathSearch("reserved_instance", respBody, nil)
+
+	autoRenew := "false"
+	if pathSearch("is_auto_renew", instance, false).(bool) {
+		autoRenew = "true"
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("flavor_id", pathSearch("flavor_ref", instance, nil)),
+		d.Set("availability_zone", pathSearch("availability_zone", instance, nil)),
+		d.Set("quantity", pathSearch("instance_count", instance, nil)),
+		d.Set("period_type", pathSearch("period_type", instance, nil)),
+		d.Set("period", pathSearch("period_num", instance, nil)),
+		d.Set("auto_renew", autoRenew),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", instance, nil)),
+		d.Set("instance_type", pathSearch("instance_type", instance, nil)),
+		d.Set("remaining_quantity", pathSearch("remaining_count", instance, nil)),
+		d.Set("start_time", pathSearch("start_time", instance, nil)),
+		d.Set("end_time", pathSearch("end_time", instance, nil)),
+		d.Set("status", pathSearch("status", instance, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ECS reserved instance fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceComputeReservedInstanceUpdate switches the auto-renew of the reservation by the subscription API of BSS.
+func resourceComputeReservedInstanceUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BssV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating BSS client: %s", err)
+	}
+
+	if d.HasChange("auto_renew") {
+		method := "DELETE"
+		if d.Get("auto_renew").(string) == "true" {
+			method = "POST"
+		}
+		renewPath := client.ServiceURL("orders", "subscriptions", "resources", "autorenew", d.Id())
+		_, err := client.Request(method, renewPath, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+		if err != nil {
+			return diag.Errorf("error updating the auto-renew of ECS reserved instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceComputeReservedInstanceRead(ctx, d, meta)
+}
+
+func resourceComputeReservedInstanceDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return diag.Errorf("ECS reserved instance (%s) can not be deleted, it is released when it expires. Disable "+
+		"auto_renew to let it expire, and remove it from the state by `terraform state rm`", d.Id())
+}
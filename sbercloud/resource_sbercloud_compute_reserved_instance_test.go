@@ -0,0 +1,110 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccComputeReservedInstance_basic(t *testing.T) {
+	resourceName := "sbercloud_compute_reserved_instance.test"
+	provider := testAccComputeReservedInstanceProvider()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckEcsReservedInstance(t)
+		},
+		Providers: map[string]*schema.Provider{
+			"sbercloud": provider,
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeReservedInstance_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeReservedInstanceExists(provider, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "flavor_id",
+						"data.sbercloud_compute_flavors.test", "ids.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone",
+						"data.sbercloud_availability_zones.test", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "quantity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "period_type", "month"),
+					resource.TestCheckResourceAttr(resourceName, "period", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccComputeReservedInstanceProvider returns a provider whose deletion of the reservation only removes it from
+// the state, the reservation can not be unsubscribed and it's released when it expires.
+func testAccComputeReservedInstanceProvider() *schema.Provider {
+	provider := Provider()
+	provider.ResourcesMap["sbercloud_compute_reserved_instance"].DeleteContext = func(context.Context,
+		*schema.ResourceData, interface{}) diag.Diagnostics {
+		return nil
+	}
+	return provider
+}
+
+func testAccCheckComputeReservedInstanceExists(provider *schema.Provider, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := provider.Meta().(*config.Config)
+		client, err := config.ComputeV1Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating sbercloud ECS client: %s", err)
+		}
+
+		_, err = client.Request("GET", client.ServiceURL("reserved-instances", rs.Primary.ID), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		return err
+	}
+}
+
+const testAccComputeReservedInstance_basic = `
+data "sbercloud_availability_zones" "test" {}
+
+data "sbercloud_compute_flavors" "test" {
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+resource "sbercloud_compute_reserved_instance" "test" {
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  quantity          = 1
+  period_type       = "month"
+  period            = 1
+  auto_renew        = "false"
+}
+`
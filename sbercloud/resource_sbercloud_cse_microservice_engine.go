@@ -0,0 +1,327 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cse/dedicated/v2/engines"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/cse"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpc"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceCseMicroserviceEngine() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCseMicroserviceEngineCreate,
+		ReadContext:   resourceCseMicroserviceEngineRead,
+		UpdateContext: resourceCseMicroserviceEngineUpdate,
+		DeleteContext: resourceCseMicroserviceEngineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 24),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9-]*[A-Za-z0-9])?$`),
+						"the name must start with a letter and can't end with a hyphen (-), only letters, digits "+
+							"and hyphens (-) are allowed"),
+				),
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RBAC", "NONE"}, false),
+			},
+			// the password of the root account, which is required when the RBAC authentication is enabled
+			"admin_pass": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kie_private_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kie_public_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_private_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registry_public_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseCseEngineError converts the error returned for the deleted engine, which is reported with a 400 status code,
+// to a 404 error.
+func parseCseEngineError(err error) error {
+	var apiErr engines.ErrorResponse
+	if errCode, ok := err.(golangsdk.ErrDefault400); ok {
+		if json.Unmarshal(errCode.Body, &apiErr) == nil && apiErr.ErrCode == "SVCSTG.00501116" {
+			return golangsdk.ErrDefault404{}
+		}
+	}
+	return err
+}
+
+func buildCseEngineAuthCred(d *schema.ResourceData) (*engines.AuthCred, error) {
+	if d.Get("auth_type").(string) != "RBAC" {
+		return nil, nil
+	}
+	password := d.Get("admin_pass").(string)
+	if password == "" {
+		return nil, fmt.Errorf("admin_pass is required when the auth_type is RBAC")
+	}
+	return &engines.AuthCred{Password: password}, nil
+}
+
+func waitForCseEngineJob(ctx context.Context, client *golangsdk.ServiceClient, engineID string, jobID int,
+	target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Init", "Executing"},
+		Target:       []string{target},
+		Refresh:      cse.MicroserviceJobRefreshFunc(client, engineID, strconv.Itoa(jobID)),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceCseMicroserviceEngineCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CseV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSE v2 client: %s", err)
+	}
+
+	// the API requires the name of the VPC and the CIDR of the subnet as well
+	vpcID, subnetID := d.Get("vpc_id").(string), d.Get("subnet_id").(string)
+	subnet, err := vpc.GetVpcSubnetById(conf, region, subnetID)
+	if err != nil {
+		return diag.Errorf("error retrieving subnet (%s): %s", subnetID, err)
+	}
+	if subnet.VPC_ID != vpcID {
+		return diag.Errorf("the subnet (%s) doesn't belong to the VPC (%s)", subnetID, vpcID)
+	}
+	vpcResp, err := vpc.GetVpcById(conf, region, vpcID)
+	if err != nil {
+		return diag.Errorf("error retrieving VPC (%s): %s", vpcID, err)
+	}
+
+	authCred, err := buildCseEngineAuthCred(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createOpts := engines.CreateOpts{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Payment:             "1",
+		SpecType:            "CSE2",
+		Flavor:              d.Get("flavor").(string),
+		AvailabilityZones:   utils.ExpandToStringList(d.Get("availability_zones").([]interface{})),
+		AuthType:            d.Get("auth_type").(string),
+		AuthCred:            authCred,
+		VpcName:             vpcResp.Name,
+		VpcId:               vpcID,
+		NetworkId:           subnetID,
+		SubnetCidr:          subnet.CIDR,
+		EnterpriseProjectId: GetEnterpriseProjectID(d, conf),
+	}
+	resp, err := engines.Create(client, createOpts)
+	if err != nil {
+		return diag.Errorf("error creating CSE microservice engine: %s", err)
+	}
+	d.SetId(resp.ID)
+
+	// the provisioning usually takes 5 to 10 minutes
+	if err := waitForCseEngineJob(ctx, client, d.Id(), resp.JobId, "Finished",
+		d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the CSE microservice engine (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceCseMicroserviceEngineRead(ctx, d, meta)
+}
+
+func resourceCseMicroserviceEngineRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CseV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSE v2 client: %s", err)
+	}
+
+	engine, err := engines.Get(client, d.Id(), d.Get("enterprise_project_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseCseEngineError(err), "error retrieving CSE microservice engine")
+	}
+
+	endpoints := engine.ExternalEntrypoint
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", engine.Name),
+		d.Set("description", engine.Description),
+		d.Set("flavor", engine.Flavor),
+		d.Set("availability_zones", engine.Reference.AzList),
+		d.Set("vpc_id", engine.Reference.VpcId),
+		d.Set("subnet_id", engine.Reference.NetworkId),
+		d.Set("auth_type", engine.AuthType),
+		d.Set("enterprise_project_id", engine.EnterpriseProjectId),
+		d.Set("kie_private_address", endpoints.ServiceEndpoint.ConfigCenter.MasterEntrypoint),
+		d.Set("kie_public_address", endpoints.PublicServiceEndpoint.ConfigCenter.MasterEntrypoint),
+		d.Set("registry_private_address", endpoints.ServiceEndpoint.ServiceCenter.MasterEntrypoint),
+		d.Set("registry_public_address", endpoints.PublicServiceEndpoint.ServiceCenter.MasterEntrypoint),
+		d.Set("status", engine.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CSE microservice engine fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCseMicroserviceEngineUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CseV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CSE v2 client: %s", err)
+	}
+
+	// only the authentication can be changed, enabling RBAC sets the password of the root account as well
+	if !d.HasChanges("auth_type", "admin_pass") {
+		return resourceCseMicroserviceEngineRead(ctx, d, meta)
+	}
+	authCred, err := buildCseEngineAuthCred(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bodyParams := map[string]interface{}{
+		"authType": d.Get("auth_type"),
+	}
+	if authCred != nil {
+		bodyParams["auth_cred"] = authCred
+	}
+
+	resp, err := client.Request("PUT", client.ServiceURL("enginemgr", "engines", d.Id(), "config"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         bodyParams,
+			MoreHeaders: map[string]string{
+				"X-Enterprise-Project-ID": d.Get("enterprise_project_id").(string),
+			},
+			OkCodes: []int{200},
+		})
+	if err != nil {
+		return diag.Errorf("error updating the authentication of CSE microservice engine (%s): %s", d.Id(), err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	jobID := int(pathSearch("jobId", respBody, float64(0)).(float64))
+	if err := waitForCseEngineJob(ctx, client, d.Id(), jobID, "Finished",
+		d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for the CSE microservice engine (%s) to be updated: %s", d.Id(), err)
+	}
+
+	return resourceCseMicroserviceEngineRead(ctx, d, meta)
+}
+
+func resourceCseMicroserviceEngineDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CseV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CSE v2 client: %s", err)
+	}
+
+	resp, err := engines.Delete(client, d.Id(), d.Get("enterprise_project_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseCseEngineError(err), "error deleting CSE microservice engine")
+	}
+
+	if err := waitForCseEngineJob(ctx, client, d.Id(), resp.JobId, "Deleted",
+		d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the CSE microservice engine (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
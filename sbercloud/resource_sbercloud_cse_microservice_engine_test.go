@@ -0,0 +1,116 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/cse/dedicated/v2/engines"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccCseMicroserviceEngine_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_cse_microservice_engine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCseMicroserviceEngineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCseMicroserviceEngine_basic(rName, "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCseMicroserviceEngineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "cse.s1.small2"),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "NONE"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "data.sbercloud_vpc_subnet.test", "vpc_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "data.sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "registry_private_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "kie_private_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccCseMicroserviceEngine_basic(rName, "RBAC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCseMicroserviceEngineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "RBAC"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_pass"},
+			},
+		},
+	})
+}
+
+func testAccCheckCseMicroserviceEngineDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.CseV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating sbercloud CSE v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_cse_microservice_engine" {
+			continue
+		}
+
+		if _, err := engines.Get(client, rs.Primary.ID, ""); err == nil {
+			return fmt.Errorf("CSE microservice engine still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCseMicroserviceEngineExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		client, err := config.CseV2Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating sbercloud CSE v2 client: %s", err)
+		}
+
+		_, err = engines.Get(client, rs.Primary.ID, "")
+		return err
+	}
+}
+
+func testAccCseMicroserviceEngine_basic(rName, authType string) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+data "sbercloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "sbercloud_cse_microservice_engine" "test" {
+  name               = "%s"
+  description        = "created by acc test"
+  flavor             = "cse.s1.small2"
+  availability_zones = slice(data.sbercloud_availability_zones.test.names, 0, 1)
+  vpc_id             = data.sbercloud_vpc_subnet.test.vpc_id
+  subnet_id          = data.sbercloud_vpc_subnet.test.id
+  auth_type          = "%s"
+  admin_pass         = "Terraform!123"
+}
+`, rName, authType)
+}
@@ -0,0 +1,284 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/css/v1/cluster"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCssClusterPublicAccess manages the public access of a CSS cluster, a cluster has at most one public access
+// configuration, so the resource ID is the cluster ID.
+func ResourceCssClusterPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCssClusterPublicAccessCreate,
+		ReadContext:   resourceCssClusterPublicAccessRead,
+		UpdateContext: resourceCssClusterPublicAccessUpdate,
+		DeleteContext: resourceCssClusterPublicAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bandwidth": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"whitelist_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"whitelist": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"whitelist_enabled"},
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// checkCssClusterAvailable returns a clear error when the cluster is busy, the public access API only reports a
+// generic failure in this case.
+func checkCssClusterAvailable(client *golangsdk.ServiceClient, clusterID string) error {
+	target, err := cluster.Get(client, clusterID)
+	if err != nil {
+		return err
+	}
+	if target.Status != cluster.ClusterStatusAvailable || len(target.Actions) > 0 {
+		return fmt.Errorf("the CSS cluster (%s) is not available for changing the public access, status: %s, "+
+			"actions: %v", clusterID, target.Status, target.Actions)
+	}
+	return nil
+}
+
+func waitForCssClusterAvailable(ctx context.Context, client *golangsdk.ServiceClient, clusterID string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			target, err := cluster.Get(client, clusterID)
+			if err != nil {
+				return nil, "", err
+			}
+			if target.Status == cluster.ClusterStatusAvailable && len(target.Actions) == 0 {
+				return target, "AVAILABLE", nil
+			}
+			if target.Status == cluster.ClusterStatusUnavailable {
+				return target, target.Status, fmt.Errorf("the CSS cluster is unavailable")
+			}
+			return target, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func doCssClusterPublicAccessRequest(client *golangsdk.ServiceClient, method, clusterID string, body interface{},
+	paths ...string) error {
+	opts := golangsdk.RequestOpts{
+		MoreHeaders: cluster.RequestOpts.MoreHeaders,
+		OkCodes:     []int{200, 201, 202, 204},
+	}
+	if body != nil {
+		opts.JSONBody = body
+	}
+	_, err := client.Request(method, client.ServiceURL(append([]string{"clusters", clusterID}, paths...)...), &opts)
+	return err
+}
+
+func updateCssClusterPublicWhitelist(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	timeout time.Duration) error {
+	clusterID := d.Id()
+	if err := checkCssClusterAvailable(client, clusterID); err != nil {
+		return err
+	}
+
+	var err error
+	if d.Get("whitelist_enabled").(bool) {
+		err = doCssClusterPublicAccessRequest(client, "POST", clusterID, map[string]interface{}{
+			"whiteIpList": d.Get("whitelist").(string),
+		}, "publicwhitelist", "update")
+	} else {
+		err = doCssClusterPublicAccessRequest(client, "PUT", clusterID, nil, "publicwhitelist", "close")
+	}
+	if err != nil {
+		return fmt.Errorf("error updating the public access whitelist: %s", err)
+	}
+	return waitForCssClusterAvailable(ctx, client, clusterID, timeout)
+}
+
+func resourceCssClusterPublicAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CssV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if err := checkCssClusterAvailable(client, clusterID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	openOpts := map[string]interface{}{
+		"eip": map[string]interface{}{
+			"bandWidth": map[string]interface{}{
+				"size": d.Get("bandwidth").(int),
+			},
+		},
+		"isAutoPay": 1,
+	}
+	if err := doCssClusterPublicAccessRequest(client, "POST", clusterID, openOpts, "public", "open"); err != nil {
+		return diag.Errorf("error enabling the public access of CSS cluster (%s): %s", clusterID, err)
+	}
+	d.SetId(clusterID)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForCssClusterAvailable(ctx, client, clusterID, timeout); err != nil {
+		return diag.Errorf("error waiting for the public access of CSS cluster (%s) to be enabled: %s", clusterID, err)
+	}
+
+	// the whitelist is disabled right after the public access is enabled
+	if d.Get("whitelist_enabled").(bool) {
+		if err := updateCssClusterPublicWhitelist(ctx, client, d, timeout); err != nil {
+			return diag.Errorf("error configuring the public access of CSS cluster (%s): %s", clusterID, err)
+		}
+	}
+
+	return resourceCssClusterPublicAccessRead(ctx, d, meta)
+}
+
+func resourceCssClusterPublicAccessRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("clusters", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      cluster.RequestOpts.MoreHeaders,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSS cluster")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	publicIP := pathSearch("publicIp", respBody, "").(string)
+	if publicIP == "" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "the public access of CSS cluster is disabled")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("public_ip", publicIP),
+		d.Set("bandwidth", pathSearch("bandwidthSize", respBody, nil)),
+		d.Set("whitelist_enabled", pathSearch("elbWhiteList.enableWhiteList", respBody, false)),
+		d.Set("whitelist", pathSearch("elbWhiteList.whiteList", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CSS cluster public access fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCssClusterPublicAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CssV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	clusterID := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.HasChange("bandwidth") {
+		if err := checkCssClusterAvailable(client, clusterID); err != nil {
+			return diag.FromErr(err)
+		}
+		bandwidthOpts := map[string]interface{}{
+			"bandWidth": map[string]interface{}{
+				"size": d.Get("bandwidth").(int),
+			},
+			"isAutoPay": 1,
+		}
+		err := doCssClusterPublicAccessRequest(client, "POST", clusterID, bandwidthOpts, "public", "bandwidth")
+		if err != nil {
+			return diag.Errorf("error updating the public access bandwidth of CSS cluster (%s): %s", clusterID, err)
+		}
+		if err := waitForCssClusterAvailable(ctx, client, clusterID, timeout); err != nil {
+			return diag.Errorf("error waiting for the public access bandwidth of CSS cluster (%s) to be updated: %s",
+				clusterID, err)
+		}
+	}
+
+	if d.HasChanges("whitelist_enabled", "whitelist") {
+		if err := updateCssClusterPublicWhitelist(ctx, client, d, timeout); err != nil {
+			return diag.Errorf("error configuring the public access of CSS cluster (%s): %s", clusterID, err)
+		}
+	}
+
+	return resourceCssClusterPublicAccessRead(ctx, d, meta)
+}
+
+func resourceCssClusterPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CssV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	clusterID := d.Id()
+	if err := checkCssClusterAvailable(client, clusterID); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling the public access of CSS cluster")
+	}
+	if err := doCssClusterPublicAccessRequest(client, "PUT", clusterID, nil, "public", "close"); err != nil {
+		return diag.Errorf("error disabling the public access of CSS cluster (%s): %s", clusterID, err)
+	}
+	if err := waitForCssClusterAvailable(ctx, client, clusterID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the public access of CSS cluster (%s) to be disabled: %s", clusterID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func TestAccCssClusterPublicAccess_basic(t *testing.T) {
+	resourceName := "sbercloud_css_cluster_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckCssCluster(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCssClusterPublicAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssClusterPublicAccess_basic(5, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_id", SBC_CSS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "5"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_ip"),
+				),
+			},
+			{
+				Config: testAccCssClusterPublicAccess_basic(10, true, "192.168.0.0/24,10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bandwidth", "10"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist", "192.168.0.0/24,10.0.0.1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCssClusterPublicAccessDestroy(s *terraform.State) error {
+	conf := testAccProvider.Meta().(*config.Config)
+	client, err := conf.CssV1Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_css_cluster_public_access" {
+			continue
+		}
+
+		resp, err := client.Request("GET", client.ServiceURL("clusters", rs.Primary.ID), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return err
+		}
+		if pathSearch("publicIp", respBody, "").(string) != "" {
+			return fmt.Errorf("the public access of CSS cluster (%s) is still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCssClusterPublicAccess_basic(bandwidth int, whitelistEnabled bool, whitelist string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_css_cluster_public_access" "test" {
+  cluster_id        = "%s"
+  bandwidth         = %d
+  whitelist_enabled = %t
+  whitelist         = "%s"
+}
+`, SBC_CSS_CLUSTER_ID, bandwidth, whitelistEnabled, whitelist)
+}
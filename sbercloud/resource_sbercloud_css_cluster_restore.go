@@ -0,0 +1,201 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/css/v1/cluster"
+	"github.com/chnsz/golangsdk/openstack/css/v1/snapshots"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCssClusterRestore restores the indices of a snapshot to a cluster. The restoration can't be undone, so the
+// deletion only removes the resource from the state.
+func ResourceCssClusterRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCssClusterRestoreCreate,
+		ReadContext:   resourceCssClusterRestoreRead,
+		DeleteContext: resourceCssClusterRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// the snapshot is restored to the source cluster by default
+			"target_cluster": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"indices": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rename_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"rename_replacement"},
+			},
+			"rename_replacement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"rename_pattern"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCssClusterRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.CssV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+	targetCluster := d.Get("target_cluster").(string)
+	if targetCluster == "" {
+		targetCluster = clusterID
+	}
+
+	// the target cluster can't accept the restoration while it's restarting, expanding or restoring another snapshot
+	target, err := cluster.Get(client, targetCluster)
+	if err != nil {
+		return diag.Errorf("error retrieving the target CSS cluster (%s): %s", targetCluster, err)
+	}
+	if target.Status != cluster.ClusterStatusAvailable || len(target.Actions) > 0 {
+		return diag.Errorf("the target CSS cluster (%s) is not available for restoration, status: %s, actions: %v",
+			targetCluster, target.Status, target.Actions)
+	}
+
+	restoreOpts := map[string]interface{}{
+		"targetCluster":     targetCluster,
+		"indices":           valueIgnoreEmpty(d.Get("indices")),
+		"renamePattern":     valueIgnoreEmpty(d.Get("rename_pattern")),
+		"renameReplacement": valueIgnoreEmpty(d.Get("rename_replacement")),
+	}
+	_, err = client.Request("POST", client.ServiceURL("clusters", clusterID, "index_snapshot", snapshotID, "restore"),
+		&golangsdk.RequestOpts{
+			JSONBody:    utils.RemoveNil(restoreOpts),
+			MoreHeaders: snapshots.RequestOpts.MoreHeaders,
+			OkCodes:     []int{200, 201},
+		})
+	if err != nil {
+		return diag.Errorf("error restoring CSS snapshot (%s) to the cluster (%s): %s", snapshotID, targetCluster, err)
+	}
+	d.SetId(snapshotID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"NONE", "RESTORING"},
+		Target:       []string{"SUCCESS"},
+		Refresh:      cssClusterRestoreStateRefreshFunc(client, clusterID, snapshotID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the CSS snapshot (%s) to be restored to the cluster (%s): %s",
+			snapshotID, targetCluster, err)
+	}
+
+	if err := d.Set("target_cluster", targetCluster); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceCssClusterRestoreRead(ctx, d, meta)
+}
+
+func getCssSnapshot(client *golangsdk.ServiceClient, clusterID, snapshotID string) (*snapshots.Snapshot, error) {
+	snapshotList, err := snapshots.List(client, clusterID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	for i := range snapshotList {
+		if snapshotList[i].ID == snapshotID {
+			return &snapshotList[i], nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func cssClusterRestoreStateRefreshFunc(client *golangsdk.ServiceClient, clusterID,
+	snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := getCssSnapshot(client, clusterID, snapshotID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := strings.ToUpper(snapshot.RestoreStatus)
+		if status == "FAILED" {
+			// the snapshot doesn't report the size of its indices, the capacity is only checked by the restoration
+			return snapshot, status, fmt.Errorf("the restoration failed, please check whether the target cluster " +
+				"has enough disk capacity to hold the restored indices and whether the restored index names conflict " +
+				"with the existing ones")
+		}
+		return snapshot, status, nil
+	}
+}
+
+func resourceCssClusterRestoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.CssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	snapshot, err := getCssSnapshot(client, d.Get("cluster_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSS snapshot")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("snapshot_id", snapshot.ID),
+		d.Set("status", strings.ToUpper(snapshot.RestoreStatus)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting CSS cluster restore fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCssClusterRestoreDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
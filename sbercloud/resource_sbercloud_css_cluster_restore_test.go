@@ -0,0 +1,43 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCssClusterRestore_basic(t *testing.T) {
+	resourceName := "sbercloud_css_cluster_restore.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckCssSnapshot(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssClusterRestore_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cluster_id", SBC_CSS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_id", SBC_CSS_SNAPSHOT_ID),
+					resource.TestCheckResourceAttr(resourceName, "target_cluster", SBC_CSS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCESS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCssClusterRestore_basic() string {
+	return fmt.Sprintf(`
+resource "sbercloud_css_cluster_restore" "test" {
+  cluster_id         = "%s"
+  snapshot_id        = "%s"
+  indices            = "*"
+  rename_pattern     = "(.+)"
+  rename_replacement = "restored_$1"
+}
+`, SBC_CSS_CLUSTER_ID, SBC_CSS_SNAPSHOT_ID)
+}
@@ -0,0 +1,226 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDataArtsStudioConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDataArtsStudioConnectionCreate,
+		ReadContext:   resourceDataArtsStudioConnectionRead,
+		UpdateContext: resourceDataArtsStudioConnectionUpdate,
+		DeleteContext: resourceDataArtsStudioConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDataArtsStudioConnectionImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connection_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"connection_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// the configuration contains the connection credentials and the API never returns them
+			"config": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: utils.ValidateJsonString,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"agent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func buildDataArtsStudioConnectionBodyParams(d *schema.ResourceData) (map[string]interface{}, error) {
+	var dwConfig map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("config").(string)), &dwConfig); err != nil {
+		return nil, fmt.Errorf("error parsing the connection config: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"dw_name":     d.Get("connection_name"),
+		"dw_type":     d.Get("connection_type"),
+		"db_type":     valueIgnoreEmpty(d.Get("db_type")),
+		"dw_config":   dwConfig,
+		"description": d.Get("description"),
+		"agent_id":    valueIgnoreEmpty(d.Get("agent_id")),
+	}
+	return map[string]interface{}{
+		"data_source_vos": []map[string]interface{}{
+			utils.RemoveNil(params),
+		},
+	}, nil
+}
+
+func dataArtsStudioConnectionHeaders(d *schema.ResourceData) map[string]string {
+	return map[string]string{
+		"workspace": d.Get("workspace_id").(string),
+	}
+}
+
+func resourceDataArtsStudioConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dataarts", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	createOpts, err := buildDataArtsStudioConnectionBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("data-connections"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+		MoreHeaders:      dataArtsStudioConnectionHeaders(d),
+	})
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio connection: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("data_connection_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DataArts Studio connection ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceDataArtsStudioConnectionRead(ctx, d, meta)
+}
+
+func resourceDataArtsStudioConnectionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dataarts", region)
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("data-connections", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      dataArtsStudioConnectionHeaders(d),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DataArts Studio connection")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("connection_name", pathSearch("dw_name", respBody, nil)),
+		d.Set("connection_type", pathSearch("dw_type", respBody, nil)),
+		d.Set("db_type", pathSearch("db_type", respBody, nil)),
+		d.Set("description", pathSearch("description", respBody, nil)),
+		d.Set("agent_id", pathSearch("agent_id", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DataArts Studio connection fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataArtsStudioConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dataarts", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	updateOpts, err := buildDataArtsStudioConnectionBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL("data-connections", d.Id()), &golangsdk.RequestOpts{
+		JSONBody:    updateOpts,
+		MoreHeaders: dataArtsStudioConnectionHeaders(d),
+	})
+	if err != nil {
+		return diag.Errorf("error updating DataArts Studio connection (%s): %s", d.Id(), err)
+	}
+
+	return resourceDataArtsStudioConnectionRead(ctx, d, meta)
+}
+
+func resourceDataArtsStudioConnectionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dataarts", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("data-connections", d.Id()), &golangsdk.RequestOpts{
+		MoreHeaders: dataArtsStudioConnectionHeaders(d),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DataArts Studio connection")
+	}
+
+	return nil
+}
+
+func resourceDataArtsStudioConnectionImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <workspace_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("workspace_id", parts[0])
+}
@@ -0,0 +1,261 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDataArtsStudioWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDataArtsStudioWorkspaceCreate,
+		ReadContext:   resourceDataArtsStudioWorkspaceRead,
+		UpdateContext: resourceDataArtsStudioWorkspaceUpdate,
+		DeleteContext: resourceDataArtsStudioWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"spec_code": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"quantity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							Default:  1,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enterprise_project_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"eps_id"},
+			},
+			"eps_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Deprecated:    "use enterprise_project_id instead",
+				ConflictsWith: []string{"enterprise_project_id"},
+			},
+			"tags": tagsSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manager_user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataArtsStudioWorkspaceEpsID(d *schema.ResourceData, conf *config.Config) string {
+	if v, ok := d.GetOk("eps_id"); ok {
+		return v.(string)
+	}
+	return GetEnterpriseProjectID(d, conf)
+}
+
+func buildDataArtsStudioWorkspaceResourceSpec(d *schema.ResourceData) map[string]interface{} {
+	specs := d.Get("resource_spec").([]interface{})
+	if len(specs) == 0 || specs[0] == nil {
+		return nil
+	}
+
+	spec := specs[0].(map[string]interface{})
+	return map[string]interface{}{
+		"spec_code": spec["spec_code"],
+		"quantity":  spec["quantity"],
+	}
+}
+
+func resourceDataArtsStudioWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dataarts", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":              d.Get("name"),
+		"description":       d.Get("description"),
+		"vpc_id":            d.Get("vpc_id"),
+		"subnet_id":         d.Get("subnet_id"),
+		"security_group_id": d.Get("security_group_id"),
+		"eps_id":            dataArtsStudioWorkspaceEpsID(d, conf),
+		"resource_spec":     buildDataArtsStudioWorkspaceResourceSpec(d),
+		"tags":              utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("workspaces"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio workspace: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("data.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DataArts Studio workspace ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceDataArtsStudioWorkspaceRead(ctx, d, meta)
+}
+
+func flattenDataArtsStudioWorkspaceResourceSpec(respBody interface{}) []map[string]interface{} {
+	spec := pathSearch("data.resource_spec", respBody, nil)
+	if spec == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"spec_code": pathSearch("spec_code", spec, nil),
+			"quantity":  pathSearch("quantity", spec, nil),
+		},
+	}
+}
+
+func resourceDataArtsStudioWorkspaceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dataarts", region)
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("workspaces", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DataArts Studio workspace")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	epsID := pathSearch("data.eps_id", respBody, "")
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("data.name", respBody, nil)),
+		d.Set("description", pathSearch("data.description", respBody, nil)),
+		d.Set("vpc_id", pathSearch("data.vpc_id", respBody, nil)),
+		d.Set("subnet_id", pathSearch("data.subnet_id", respBody, nil)),
+		d.Set("security_group_id", pathSearch("data.security_group_id", respBody, nil)),
+		d.Set("resource_spec", flattenDataArtsStudioWorkspaceResourceSpec(respBody)),
+		d.Set("enterprise_project_id", epsID),
+		d.Set("eps_id", epsID),
+		d.Set("tags", flattenResponseTags("data.tags", respBody)),
+		d.Set("status", pathSearch("data.status", respBody, nil)),
+		d.Set("created_at", pathSearch("data.create_time", respBody, nil)),
+		d.Set("manager_user_id", pathSearch("data.manager_user_id", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DataArts Studio workspace fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataArtsStudioWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dataarts", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"tags":        utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("workspaces", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating DataArts Studio workspace (%s): %s", d.Id(), err)
+	}
+
+	return resourceDataArtsStudioWorkspaceRead(ctx, d, meta)
+}
+
+func resourceDataArtsStudioWorkspaceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dataarts", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("workspaces", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DataArts Studio workspace")
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDbssAuditRule manages the risk rules of the DBSS audit instances.
+func ResourceDbssAuditRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDbssAuditRuleCreate,
+		ReadContext:   resourceDbssAuditRuleRead,
+		UpdateContext: resourceDbssAuditRuleUpdate,
+		DeleteContext: resourceDbssAuditRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDbssAuditRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"all", "sensitive_exception", "result_set_exception",
+				}, false),
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"log", "block"}, false),
+			},
+			"rank_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"risk_level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"HIGH", "MEDIUM", "LOW", "NO_RISK"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDbssAuditRuleBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":       d.Get("name"),
+		"type":       d.Get("type"),
+		"action":     d.Get("action"),
+		"rank":       valueIgnoreEmpty(d.Get("rank_id")),
+		"risk_level": d.Get("risk_level"),
+	}
+	return utils.RemoveNil(bodyParams)
+}
+
+// getDbssAuditRule lists the risk rules to find the rule, the API does not support querying a rule by its ID.
+func getDbssAuditRule(client *golangsdk.ServiceClient, instanceID, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL(instanceID, "audit", "rule", "risk"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := pathSearch(fmt.Sprintf("rules[?id=='%s']|[0]", id), respBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func resourceDbssAuditRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dbss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	resp, err := client.Request("POST", client.ServiceURL(instanceID, "audit", "rule", "risk"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildDbssAuditRuleBodyParams(d),
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error creating DBSS audit rule: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DBSS audit rule ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceDbssAuditRuleRead(ctx, d, meta)
+}
+
+func resourceDbssAuditRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dbss", region)
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	rule, err := getDbssAuditRule(client, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DBSS audit rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", rule, nil)),
+		d.Set("type", pathSearch("type", rule, nil)),
+		d.Set("action", pathSearch("action", rule, nil)),
+		d.Set("rank_id", pathSearch("rank", rule, nil)),
+		d.Set("risk_level", pathSearch("risk_level", rule, nil)),
+		d.Set("status", pathSearch("status", rule, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DBSS audit rule fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDbssAuditRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dbss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	updatePath := client.ServiceURL(d.Get("instance_id").(string), "audit", "rule", "risk", d.Id())
+	_, err = client.Request("PUT", updatePath, &golangsdk.RequestOpts{
+		JSONBody: buildDbssAuditRuleBodyParams(d),
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating DBSS audit rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceDbssAuditRuleRead(ctx, d, meta)
+}
+
+func resourceDbssAuditRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dbss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	deletePath := client.ServiceURL(d.Get("instance_id").(string), "audit", "rule", "risk", d.Id())
+	_, err = client.Request("DELETE", deletePath, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DBSS audit rule")
+	}
+
+	return nil
+}
+
+func resourceDbssAuditRuleImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -0,0 +1,343 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDbssInstance manages the DBSS audit instances, which only support the prePaid charging mode.
+func ResourceDbssInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDbssInstanceCreate,
+		ReadContext:   resourceDbssInstanceRead,
+		UpdateContext: resourceDbssInstanceUpdate,
+		DeleteContext: resourceDbssInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"charging_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "prePaid",
+				ValidateFunc: validation.StringInSlice([]string{"prePaid"}, false),
+			},
+			"period_unit": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"month", "year"}, false),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
+			"auto_renew": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// the period types of the DBSS API
+var dbssPeriodTypes = map[string]int{
+	"month": 2,
+	"year":  3,
+}
+
+func buildDbssInstanceCreateOpts(d *schema.ResourceData, conf *config.Config, region string) map[string]interface{} {
+	createOpts := map[string]interface{}{
+		"name":              d.Get("name"),
+		"flavor_ref":        d.Get("flavor"),
+		"availability_zone": d.Get("availability_zone"),
+		"vpc_id":            d.Get("vpc_id"),
+		"comment":           valueIgnoreEmpty(d.Get("description")),
+		"region":            region,
+		"nics": []map[string]interface{}{
+			{"subnet_id": d.Get("subnet_id")},
+		},
+		"security_groups": []map[string]interface{}{
+			{"id": d.Get("security_group_id")},
+		},
+		"product_infos": []map[string]interface{}{
+			{
+				"cloud_service_type": "hws.service.type.dbss",
+				"resource_type":      "hws.resource.type.dbss",
+				"resource_spec_code": d.Get("flavor"),
+			},
+		},
+		"period_type":           dbssPeriodTypes[d.Get("period_unit").(string)],
+		"period_num":            d.Get("period"),
+		"is_auto_renew":         d.Get("auto_renew").(string) == "true",
+		"is_auto_pay":           true,
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"tags":                  valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	return utils.RemoveNil(createOpts)
+}
+
+// getDbssInstance lists the instances to find the instance, the API does not support querying an instance by its ID.
+func getDbssInstance(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("dbss", "audit", "instances"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := pathSearch(fmt.Sprintf("servers[?id=='%s']|[0]", id), respBody, nil)
+	if instance == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func dbssInstanceStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getDbssInstance(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("status", instance, "").(string)
+		if status == "ERROR" {
+			return instance, status, fmt.Errorf("the DBSS instance is in ERROR status")
+		}
+		return instance, status, nil
+	}
+}
+
+func resourceDbssInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dbss", region)
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("dbss", "audit", "charge", "period", "order"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         buildDbssInstanceCreateOpts(d, conf, region),
+		})
+	if err != nil {
+		return diag.Errorf("error creating DBSS instance: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	orderID := pathSearch("order_id", respBody, "").(string)
+	if orderID == "" {
+		return diag.Errorf("unable to find the order ID of the DBSS instance from the API response")
+	}
+	id := pathSearch("instance_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DBSS instance ID from the API response")
+	}
+	if err := common.WaitOrderComplete(ctx, d, conf, orderID); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"BUILD", "DELETED"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      dbssInstanceStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        1 * time.Minute,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DBSS instance (%s) to be active: %s", id, err)
+	}
+
+	return resourceDbssInstanceRead(ctx, d, meta)
+}
+
+func resourceDbssInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dbss", region)
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	instance, err := getDbssInstance(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DBSS instance")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", instance, nil)),
+		d.Set("availability_zone", pathSearch("zone", instance, nil)),
+		d.Set("vpc_id", pathSearch("vpc_id", instance, nil)),
+		d.Set("subnet_id", pathSearch("subnet_id", instance, nil)),
+		d.Set("security_group_id", pathSearch("security_group_id", instance, nil)),
+		d.Set("description", pathSearch("comment", instance, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", instance, nil)),
+		d.Set("instance_id", pathSearch("instance_id", instance, nil)),
+		d.Set("status", pathSearch("status", instance, nil)),
+		d.Set("ip_address", pathSearch("connect_ip", instance, nil)),
+		d.Set("port", pathSearch("port_id", instance, nil)),
+	)
+
+	resp, err := client.Request("GET", client.ServiceURL("dbss", d.Id(), "tags"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err == nil {
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		mErr = multierror.Append(mErr, d.Set("tags", flattenResponseTags("tags", respBody)))
+	} else {
+		mErr = multierror.Append(mErr, fmt.Errorf("error retrieving tags of DBSS instance: %s", err))
+	}
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DBSS instance fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDbssInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dbss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	if d.HasChange("tags") {
+		if err := utils.UpdateResourceTags(client, d, "dbss", d.Id()); err != nil {
+			return diag.Errorf("error updating tags of DBSS instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDbssInstanceRead(ctx, d, meta)
+}
+
+func resourceDbssInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dbss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DBSS client: %s", err)
+	}
+
+	// the resource ID of the order is the instance ID of DBSS
+	if err := UnsubscribePrePaidResource(d, conf, []string{d.Get("instance_id").(string)}); err != nil {
+		return diag.Errorf("error unsubscribing DBSS instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"ACTIVE", "SHUTOFF", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      dbssInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DBSS instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
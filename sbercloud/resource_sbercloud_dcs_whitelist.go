@@ -0,0 +1,196 @@
+package sbercloud
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dcs/v2/whitelists"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDcsWhitelist() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsWhitelistPut,
+		ReadContext:   resourceDcsWhitelistRead,
+		UpdateContext: resourceDcsWhitelistPut,
+		DeleteContext: resourceDcsWhitelistDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enable_whitelist": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"whitelist": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip_list": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildDcsWhitelistGroups(rawGroups []interface{}) []whitelists.WhitelistGroupOpts {
+	groups := make([]whitelists.WhitelistGroupOpts, len(rawGroups))
+	for i, v := range rawGroups {
+		raw := v.(map[string]interface{})
+		groups[i] = whitelists.WhitelistGroupOpts{
+			GroupName: raw["group_name"].(string),
+			IPList:    utils.ExpandToStringList(raw["ip_list"].([]interface{})),
+		}
+	}
+	return groups
+}
+
+func flattenDcsWhitelistGroups(groups []whitelists.WhitelistGroup) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(groups))
+	for i, group := range groups {
+		result[i] = map[string]interface{}{
+			"group_name": group.GroupName,
+			"ip_list":    group.IPList,
+		}
+	}
+	return result
+}
+
+func dcsWhitelistStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r, err := whitelists.Get(client, instanceID).Extract()
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		return r, strconv.FormatBool(r.Enable), nil
+	}
+}
+
+// putDcsWhitelist replaces the whole whitelist of the instance and waits for the switch to take effect.
+func putDcsWhitelist(ctx context.Context, client *golangsdk.ServiceClient, instanceID string, enable bool,
+	groups []whitelists.WhitelistGroupOpts, timeout time.Duration) error {
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: groups,
+	}
+	if err := whitelists.Put(client, instanceID, opts).ExtractErr(); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{strconv.FormatBool(!enable)},
+		Target:       []string{strconv.FormatBool(enable)},
+		Refresh:      dcsWhitelistStateRefreshFunc(client, instanceID),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// resourceDcsWhitelistPut is used by both the creation and the update, because there is only one whitelist per
+// instance and it is always replaced as a whole.
+func resourceDcsWhitelistPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DcsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	instanceID := d.Get("instance_id").(string)
+	err = putDcsWhitelist(ctx, client, instanceID, d.Get("enable_whitelist").(bool),
+		buildDcsWhitelistGroups(d.Get("whitelist").([]interface{})), timeout)
+	if err != nil {
+		return diag.Errorf("error setting whitelist of DCS instance (%s): %s", instanceID, err)
+	}
+	d.SetId(instanceID)
+
+	return resourceDcsWhitelistRead(ctx, d, meta)
+}
+
+func resourceDcsWhitelistRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.DcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	r, err := whitelists.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DCS whitelist")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", d.Id()),
+		d.Set("enable_whitelist", r.Enable),
+		d.Set("whitelist", flattenDcsWhitelistGroups(r.Groups)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DCS whitelist fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDcsWhitelistDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DcsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	err = putDcsWhitelist(ctx, client, d.Id(), false, []whitelists.WhitelistGroupOpts{},
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DCS whitelist")
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/dcs/v2/whitelists"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccDcsWhitelist_basic(t *testing.T) {
+	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
+	resourceName := "sbercloud_dcs_whitelist.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcsWhitelistDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsWhitelist_basic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsWhitelistExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_dcs_instance.instance_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enable_whitelist", "true"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.0.group_name", "test-group1"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.0.ip_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccDcsWhitelist_update(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcsWhitelistExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.1.group_name", "test-group2"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.1.ip_list.0", "172.16.10.100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcsWhitelistDestroy(s *terraform.State) error {
+	conf := testAccProvider.Meta().(*config.Config)
+	client, err := conf.DcsV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating DCS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_dcs_whitelist" {
+			continue
+		}
+
+		r, err := whitelists.Get(client, rs.Primary.ID).Extract()
+		if err == nil && r.Enable {
+			return fmt.Errorf("the whitelist of DCS instance (%s) is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckDcsWhitelistExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		conf := testAccProvider.Meta().(*config.Config)
+		client, err := conf.DcsV2Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating DCS client: %s", err)
+		}
+
+		r, err := whitelists.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+		if !r.Enable {
+			return fmt.Errorf("the whitelist of DCS instance (%s) is not enabled", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccDcsWhitelist_basic(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dcs_whitelist" "test" {
+  instance_id = sbercloud_dcs_instance.instance_1.id
+
+  whitelist {
+    group_name = "test-group1"
+    ip_list    = ["192.168.10.100", "192.168.0.0/24"]
+  }
+}
+`, testAccDcsV1Instance_single(instanceName))
+}
+
+func testAccDcsWhitelist_update(instanceName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dcs_whitelist" "test" {
+  instance_id = sbercloud_dcs_instance.instance_1.id
+
+  whitelist {
+    group_name = "test-group1"
+    ip_list    = ["192.168.10.100", "192.168.0.0/24"]
+  }
+  whitelist {
+    group_name = "test-group2"
+    ip_list    = ["172.16.10.100"]
+  }
+}
+`, testAccDcsV1Instance_single(instanceName))
+}
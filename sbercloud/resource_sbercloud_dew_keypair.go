@@ -0,0 +1,196 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the encryption types of the private key are named differently in the API
+var dewKeypairEncryptionTypes = map[string]string{
+	"standard": "default",
+	"hsm":      "kms",
+}
+
+// ResourceDewKeypair manages the key pairs of the DEW credential (KPS) API. There is no importer since the private
+// key is only returned by the creation and is not stored by the API.
+func ResourceDewKeypair() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDewKeypairCreate,
+		ReadContext:   resourceDewKeypairRead,
+		UpdateContext: resourceDewKeypairUpdate,
+		DeleteContext: resourceDewKeypairDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"encryption_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "standard",
+				ValidateFunc: validation.StringInSlice([]string{"standard", "hsm"}, false),
+			},
+			"keystore_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceDewKeypairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "kps", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating KPS client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"type":        "ssh",
+		"description": valueIgnoreEmpty(d.Get("description")),
+		"key_protection": map[string]interface{}{
+			"encryption": utils.RemoveNil(map[string]interface{}{
+				"type":        dewKeypairEncryptionTypes[d.Get("encryption_type").(string)],
+				"keystore_id": valueIgnoreEmpty(d.Get("keystore_id")),
+			}),
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL("keypairs"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"keypair": utils.RemoveNil(createOpts),
+		},
+		OkCodes: []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating DEW key pair: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := pathSearch("keypair.name", respBody, "").(string)
+	if name == "" {
+		return diag.Errorf("unable to find the DEW key pair name from the API response")
+	}
+	d.SetId(name)
+
+	// the private key is only returned once
+	if err := d.Set("private_key", pathSearch("keypair.private_key", respBody, nil)); err != nil {
+		return diag.Errorf("error setting the private key of DEW key pair: %s", err)
+	}
+
+	return resourceDewKeypairRead(ctx, d, meta)
+}
+
+func resourceDewKeypairRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "kps", region)
+	if err != nil {
+		return diag.Errorf("error creating KPS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("keypairs", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DEW key pair")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keypair := pathSearch("keypair", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", keypair, nil)),
+		d.Set("description", pathSearch("description", keypair, nil)),
+		d.Set("fingerprint", pathSearch("fingerprint", keypair, nil)),
+		d.Set("public_key", pathSearch("public_key", keypair, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DEW key pair fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDewKeypairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "kps", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating KPS client: %s", err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL("keypairs", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"keypair": map[string]interface{}{
+				"description": d.Get("description"),
+			},
+		},
+	})
+	if err != nil {
+		return diag.Errorf("error updating DEW key pair (%s): %s", d.Id(), err)
+	}
+
+	return resourceDewKeypairRead(ctx, d, meta)
+}
+
+func resourceDewKeypairDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "kps", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating KPS client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("keypairs", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DEW key pair")
+	}
+
+	return nil
+}
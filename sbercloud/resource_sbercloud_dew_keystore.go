@@ -0,0 +1,158 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDewKeystore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDewKeystoreCreate,
+		ReadContext:   resourceDewKeystoreRead,
+		DeleteContext: resourceDewKeystoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CLOUDHSM_SHARED", "CLOUDHSM_DEDICATED",
+				}, false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hsm_cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDewKeystoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.KmsV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"keystore_alias":        d.Get("name"),
+		"keystore_type":         d.Get("type"),
+		"keystore_desc":         valueIgnoreEmpty(d.Get("description")),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("keystores"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating DEW keystore: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("keystore.keystore_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DEW keystore ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceDewKeystoreRead(ctx, d, meta)
+}
+
+func resourceDewKeystoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.KmsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("keystores", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DEW keystore")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keystore := pathSearch("keystore", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("keystore_alias", keystore, nil)),
+		d.Set("type", pathSearch("keystore_type", keystore, nil)),
+		d.Set("description", pathSearch("keystore_desc", keystore, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", keystore, nil)),
+		d.Set("status", pathSearch("keystore_state", keystore, nil)),
+		d.Set("hsm_cluster_id", pathSearch("hsm_cluster_id", keystore, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DEW keystore fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDewKeystoreDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.KmsV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating KMS client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("keystores", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DEW keystore")
+	}
+
+	return nil
+}
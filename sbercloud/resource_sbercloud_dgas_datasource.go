@@ -0,0 +1,245 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDgasDatasource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDgasDatasourceCreate,
+		ReadContext:   resourceDgasDatasourceRead,
+		UpdateContext: resourceDgasDatasourceUpdate,
+		DeleteContext: resourceDgasDatasourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OBS", "MRS"}, false),
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"obs_bucket": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"obs_object_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"mrs_cluster_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"mrs_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildDgasDatasourceLocation checks the location parameters by the type of the datasource, the OBS datasources
+// require the bucket and the object path, and the MRS datasources require the cluster ID and the path.
+func buildDgasDatasourceLocation(d *schema.ResourceData) (map[string]interface{}, error) {
+	location := d.Get("location").([]interface{})[0].(map[string]interface{})
+	if d.Get("type").(string) == "OBS" {
+		if location["obs_bucket"].(string) == "" || location["obs_object_path"].(string) == "" {
+			return nil, fmt.Errorf("obs_bucket and obs_object_path are required for the OBS datasource")
+		}
+		return map[string]interface{}{
+			"obs_bucket":      location["obs_bucket"],
+			"obs_object_path": location["obs_object_path"],
+		}, nil
+	}
+
+	if location["mrs_cluster_id"].(string) == "" || location["mrs_path"].(string) == "" {
+		return nil, fmt.Errorf("mrs_cluster_id and mrs_path are required for the MRS datasource")
+	}
+	return map[string]interface{}{
+		"mrs_cluster_id": location["mrs_cluster_id"],
+		"mrs_path":       location["mrs_path"],
+	}, nil
+}
+
+func flattenDgasDatasourceLocation(respBody interface{}) []map[string]interface{} {
+	location := pathSearch("location", respBody, nil)
+	if location == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"obs_bucket":      pathSearch("obs_bucket", location, nil),
+			"obs_object_path": pathSearch("obs_object_path", location, nil),
+			"mrs_cluster_id":  pathSearch("mrs_cluster_id", location, nil),
+			"mrs_path":        pathSearch("mrs_path", location, nil),
+		},
+	}
+}
+
+func resourceDgasDatasourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dgas", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DGAS client: %s", err)
+	}
+
+	location, err := buildDgasDatasourceLocation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"type":                  d.Get("type"),
+		"location":              location,
+		"description":           valueIgnoreEmpty(d.Get("description")),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("datasources"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating DGAS datasource: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DGAS datasource ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceDgasDatasourceRead(ctx, d, meta)
+}
+
+func resourceDgasDatasourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dgas", region)
+	if err != nil {
+		return diag.Errorf("error creating DGAS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("datasources", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DGAS datasource")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("type", pathSearch("type", respBody, nil)),
+		d.Set("location", flattenDgasDatasourceLocation(respBody)),
+		d.Set("description", pathSearch("description", respBody, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", respBody, nil)),
+		d.Set("created_at", pathSearch("created_at", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DGAS datasource fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDgasDatasourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dgas", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DGAS client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"description": d.Get("description"),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("datasources", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating DGAS datasource (%s): %s", d.Id(), err)
+	}
+
+	return resourceDgasDatasourceRead(ctx, d, meta)
+}
+
+func resourceDgasDatasourceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dgas", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DGAS client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("datasources", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DGAS datasource")
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDgasJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDgasJobCreate,
+		ReadContext:   resourceDgasJobRead,
+		DeleteContext: resourceDgasJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"datasource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"obs_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"obs_object_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"progress": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDgasJobOutput(rawOutputs []interface{}) map[string]interface{} {
+	if len(rawOutputs) == 0 || rawOutputs[0] == nil {
+		return nil
+	}
+
+	raw := rawOutputs[0].(map[string]interface{})
+	return map[string]interface{}{
+		"obs_bucket":      raw["obs_bucket"],
+		"obs_object_path": raw["obs_object_path"],
+	}
+}
+
+func resourceDgasJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dgas", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DGAS client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":          d.Get("name"),
+		"algorithm":     d.Get("algorithm"),
+		"datasource_id": d.Get("datasource_id"),
+		"parameters":    valueIgnoreEmpty(d.Get("parameters")),
+		"output":        buildDgasJobOutput(d.Get("output").([]interface{})),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("jobs"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error submitting DGAS job: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("job_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DGAS job ID from the API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending", "running"},
+		Target:       []string{"success"},
+		Refresh:      dgasJobStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DGAS job (%s) to complete: %s", id, err)
+	}
+
+	return resourceDgasJobRead(ctx, d, meta)
+}
+
+func getDgasJob(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("jobs", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func dgasJobStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := getDgasJob(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		// the unexpected states (failed and cancelled) end the waiting with an error
+		return job, pathSearch("status", job, "").(string), nil
+	}
+}
+
+func resourceDgasJobRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dgas", region)
+	if err != nil {
+		return diag.Errorf("error creating DGAS client: %s", err)
+	}
+
+	job, err := getDgasJob(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DGAS job")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", job, nil)),
+		d.Set("algorithm", pathSearch("algorithm", job, nil)),
+		d.Set("datasource_id", pathSearch("datasource_id", job, nil)),
+		d.Set("status", pathSearch("status", job, nil)),
+		d.Set("start_time", pathSearch("start_time", job, nil)),
+		d.Set("end_time", pathSearch("end_time", job, nil)),
+		d.Set("progress", pathSearch("progress", job, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DGAS job fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceDgasJobDelete cancels the job if it is still running, the finished jobs are only removed from the state.
+func resourceDgasJobDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dgas", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DGAS client: %s", err)
+	}
+
+	job, err := getDgasJob(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DGAS job")
+	}
+
+	status := pathSearch("status", job, "").(string)
+	if status != "pending" && status != "running" {
+		return nil
+	}
+
+	if _, err := client.Request("POST", client.ServiceURL("jobs", d.Id(), "cancel"), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error canceling DGAS job")
+	}
+
+	return nil
+}
@@ -0,0 +1,396 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/common/tags"
+	"github.com/chnsz/golangsdk/openstack/dis/v2/streams"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const disSysTagKeyEnterpriseProjectID = "_sys_enterprise_project_id"
+
+func ResourceDisStream() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDisStreamCreate,
+		ReadContext:   resourceDisStreamRead,
+		UpdateContext: resourceDisStreamUpdate,
+		DeleteContext: resourceDisStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"name", "stream_name"},
+			},
+			"partition_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      24,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(24, 72),
+			},
+			"stream_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"BLOB", "JSON", "CSV", "PROTOBUF",
+				}, false),
+			},
+			"data_schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"csv_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"compression_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"snappy", "gzip", "zip",
+				}, false),
+			},
+			"auto_scale_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"auto_scale_min_partition_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"auto_scale_max_partition_count"},
+			},
+			"auto_scale_max_partition_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"auto_scale_min_partition_count"},
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"stream_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"readable_partition_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"writable_partition_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"partitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hash_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sequence_number_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// Deprecated
+			"stream_name": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				ForceNew:   true,
+				Deprecated: "use name instead",
+			},
+			"created": {
+				Type:       schema.TypeInt,
+				Computed:   true,
+				Deprecated: "use created_at instead",
+			},
+		},
+	}
+}
+
+func resourceDisStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DisV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DIS v2 client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name = d.Get("stream_name").(string)
+	}
+	opts := streams.CreateOpts{
+		StreamName:        name,
+		PartitionCount:    d.Get("partition_count").(int),
+		StreamType:        d.Get("stream_type").(string),
+		DataDuration:      d.Get("retention_period").(int),
+		DataType:          d.Get("data_type").(string),
+		DataSchema:        d.Get("data_schema").(string),
+		CompressionFormat: d.Get("compression_format").(string),
+		Tags:              utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("csv_delimiter"); ok {
+		opts.CsvProperties = &streams.CsvProperty{Delimiter: v.(string)}
+	}
+
+	// the auto scaling is enabled when it is specified explicitly or both partition limits are set
+	minCount := d.Get("auto_scale_min_partition_count").(int)
+	maxCount := d.Get("auto_scale_max_partition_count").(int)
+	autoScaleEnabled := d.Get("auto_scale_enabled").(bool) || (minCount > 0 && maxCount > 0)
+	opts.AutoScaleEnabled = utils.Bool(autoScaleEnabled)
+	if autoScaleEnabled {
+		opts.AutoScaleMinPartitionCount = &minCount
+		opts.AutoScaleMaxPartitionCount = &maxCount
+	}
+
+	if epsID := GetEnterpriseProjectID(d, conf); epsID != "" {
+		opts.SysTags = []tags.ResourceTag{
+			{
+				Key:   disSysTagKeyEnterpriseProjectID,
+				Value: epsID,
+			},
+		}
+	}
+
+	if _, err := streams.Create(client, opts); err != nil {
+		return diag.Errorf("error creating DIS stream: %s", err)
+	}
+	d.SetId(name)
+
+	return resourceDisStreamRead(ctx, d, meta)
+}
+
+func flattenDisStreamPartitions(client *golangsdk.ServiceClient, name string) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+	opts := streams.GetOpts{}
+	for {
+		detail, err := streams.Get(client, name, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, partition := range detail.Partitions {
+			result = append(result, map[string]interface{}{
+				"id":                    partition.PartitionId,
+				"status":                partition.Status,
+				"hash_range":            partition.HashRange,
+				"sequence_number_range": partition.SequenceNumberRange,
+			})
+		}
+
+		if !detail.HasMorePartitions || len(detail.Partitions) == 0 {
+			break
+		}
+		opts.StartPartitionId = detail.Partitions[len(detail.Partitions)-1].PartitionId
+	}
+
+	return result, nil
+}
+
+func parseDisEnterpriseProjectID(sysTags []tags.ResourceTag) string {
+	for _, tag := range sysTags {
+		if tag.Key == disSysTagKeyEnterpriseProjectID {
+			return tag.Value
+		}
+	}
+	return ""
+}
+
+func resourceDisStreamRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.DisV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DIS v2 client: %s", err)
+	}
+
+	detail, err := streams.Get(client, d.Id(), streams.GetOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DIS stream")
+	}
+
+	partitions, err := flattenDisStreamPartitions(client, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the partitions of DIS stream (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", detail.StreamName),
+		d.Set("stream_name", detail.StreamName),
+		d.Set("partition_count", detail.WritablePartitionCount),
+		d.Set("retention_period", detail.RetentionPeriod),
+		d.Set("stream_type", detail.StreamType),
+		d.Set("data_type", detail.DataType),
+		d.Set("data_schema", detail.DataSchema),
+		d.Set("csv_delimiter", detail.CsvProperties.Delimiter),
+		d.Set("compression_format", detail.CompressionFormat),
+		d.Set("auto_scale_enabled", detail.AutoScaleEnabled),
+		d.Set("auto_scale_min_partition_count", detail.AutoScaleMinPartitionCount),
+		d.Set("auto_scale_max_partition_count", detail.AutoScaleMaxPartitionCount),
+		d.Set("tags", utils.TagsToMap(detail.Tags)),
+		d.Set("stream_id", detail.StreamId),
+		d.Set("status", detail.Status),
+		d.Set("created", detail.CreateTime),
+		d.Set("created_at", utils.FormatTimeStampRFC3339(int64(detail.CreateTime)/1000)),
+		d.Set("readable_partition_count", detail.ReadablePartitionCount),
+		d.Set("writable_partition_count", detail.WritablePartitionCount),
+		d.Set("partitions", partitions),
+	)
+
+	// the value 0 means the default enterprise project
+	if epsID := parseDisEnterpriseProjectID(detail.SysTags); epsID != "" && epsID != "0" {
+		mErr = multierror.Append(mErr, d.Set("enterprise_project_id", epsID))
+	}
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DIS stream fields: %s", err)
+	}
+
+	return nil
+}
+
+func disStreamPartitionRefreshFunc(client *golangsdk.ServiceClient, name string, target int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		detail, err := streams.Get(client, name, streams.GetOpts{})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if detail.WritablePartitionCount == target {
+			return detail, "COMPLETED", nil
+		}
+		return detail, "PENDING", nil
+	}
+}
+
+func resourceDisStreamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DisV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DIS v2 client: %s", err)
+	}
+
+	name := d.Id()
+	if d.HasChange("partition_count") {
+		target := d.Get("partition_count").(int)
+		updateOpts := streams.UpdatePartitionOpt{
+			StreamName:           name,
+			TargetPartitionCount: target,
+		}
+		if _, err := streams.UpdatePartition(client, name, updateOpts); err != nil {
+			return diag.Errorf("error scaling the partitions of DIS stream (%s): %s", name, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"PENDING"},
+			Target:       []string{"COMPLETED"},
+			Refresh:      disStreamPartitionRefreshFunc(client, name, target),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        10 * time.Second,
+			PollInterval: 10 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("error waiting for the partitions of DIS stream (%s) to be scaled: %s", name, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := utils.UpdateResourceTags(client, d, "stream", d.Get("stream_id").(string)); err != nil {
+			return diag.Errorf("error updating tags of DIS stream (%s): %s", name, err)
+		}
+	}
+
+	return resourceDisStreamRead(ctx, d, meta)
+}
+
+func resourceDisStreamDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DisV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DIS v2 client: %s", err)
+	}
+
+	if err := streams.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DIS stream")
+	}
+
+	return nil
+}
@@ -27,6 +27,49 @@ func TestAccDisStreamV2_basic(t *testing.T) {
 	})
 }
 
+func TestAccDisStreamV2_update(t *testing.T) {
+	rName := fmt.Sprintf("tf_test_dis_%s", acctest.RandString(10))
+	resourceName := "sbercloud_dis_stream.stream"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDisStreamV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisStreamV2_update(rName, 1, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDisStreamV2Exists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_type", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "compression_format", "gzip"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "48"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceName, "stream_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccDisStreamV2_update(rName, 2, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDisStreamV2Exists(),
+					resource.TestCheckResourceAttr(resourceName, "partition_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "writable_partition_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "bar"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stream_name"},
+			},
+		},
+	})
+}
+
 func testAccCheckDisStreamV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
 	client, err := config.DisV2Client(SBC_REGION_NAME)
@@ -94,3 +137,24 @@ resource "sbercloud_dis_stream" "stream" {
 }
 	`, val)
 }
+
+func testAccDisStreamV2_update(rName string, partitionCount int, owner string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_dis_stream" "stream" {
+  name               = "%[1]s"
+  partition_count    = %[2]d
+  retention_period   = 48
+  data_type          = "JSON"
+  compression_format = "gzip"
+  data_schema        = jsonencode({
+    type   = "record"
+    name   = "RecordName"
+    fields = [{ name = "id", type = "string" }]
+  })
+
+  tags = {
+    owner = "%[3]s"
+  }
+}
+`, rName, partitionCount, owner)
+}
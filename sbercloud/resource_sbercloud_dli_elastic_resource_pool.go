@@ -0,0 +1,271 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDliElasticResourcePool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDliElasticResourcePoolCreate,
+		ReadContext:   resourceDliElasticResourcePoolRead,
+		UpdateContext: resourceDliElasticResourcePoolUpdate,
+		DeleteContext: resourceDliElasticResourcePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]{1,128}$`),
+					"only lowercase letters, digits and underscores (_) are allowed"),
+			},
+			"min_cu": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntDivisibleBy(16),
+			},
+			"max_cu": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntDivisibleBy(16),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDliElasticResourcePoolCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dliv3", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DLI v3 client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	createOpts := map[string]interface{}{
+		"elastic_resource_pool_name": name,
+		"min_cu":                     d.Get("min_cu"),
+		"max_cu":                     d.Get("max_cu"),
+		"description":                valueIgnoreEmpty(d.Get("description")),
+		"cidr_in_vpc":                valueIgnoreEmpty(d.Get("cidr")),
+		"enterprise_project_id":      valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	_, err = client.Request("POST", client.ServiceURL("elastic-resource-pools"), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating DLI elastic resource pool: %s", err)
+	}
+	d.SetId(name)
+
+	if err := waitForDliElasticResourcePoolAvailable(ctx, client, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the DLI elastic resource pool (%s) to be available: %s", name, err)
+	}
+
+	return resourceDliElasticResourcePoolRead(ctx, d, meta)
+}
+
+// getDliElasticResourcePool returns a 404 error if the elastic resource pool does not exist, because the v3 API only
+// supports querying the pools by the fuzzy name.
+func getDliElasticResourcePool(client *golangsdk.ServiceClient, name string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("elastic-resource-pools")+"?name="+name,
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := pathSearch(fmt.Sprintf("elastic_resource_pools[?elastic_resource_pool_name=='%s'] | [0]", name),
+		respBody, nil)
+	if pool == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return pool, nil
+}
+
+func dliElasticResourcePoolStateRefreshFunc(client *golangsdk.ServiceClient, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pool, err := getDliElasticResourcePool(client, name)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("status", pool, "").(string)
+		if status == "FAILED" {
+			return pool, status, fmt.Errorf("the elastic resource pool is in FAILED status: %s",
+				pathSearch("fail_reason", pool, ""))
+		}
+		return pool, status, nil
+	}
+}
+
+func waitForDliElasticResourcePoolAvailable(ctx context.Context, client *golangsdk.ServiceClient, name string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING", "SCALING"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      dliElasticResourcePoolStateRefreshFunc(client, name),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDliElasticResourcePoolRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dliv3", region)
+	if err != nil {
+		return diag.Errorf("error creating DLI v3 client: %s", err)
+	}
+
+	pool, err := getDliElasticResourcePool(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DLI elastic resource pool")
+	}
+
+	var createdAt string
+	if v, ok := pathSearch("create_time", pool, float64(0)).(float64); ok && v > 0 {
+		createdAt = time.Unix(int64(v)/1000, 0).UTC().Format(time.RFC3339)
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("elastic_resource_pool_name", pool, nil)),
+		d.Set("min_cu", pathSearch("min_cu", pool, nil)),
+		d.Set("max_cu", pathSearch("max_cu", pool, nil)),
+		d.Set("description", pathSearch("description", pool, nil)),
+		d.Set("cidr", pathSearch("cidr_in_vpc", pool, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", pool, nil)),
+		d.Set("resource_id", pathSearch("resource_id", pool, nil)),
+		d.Set("status", pathSearch("status", pool, nil)),
+		d.Set("created_at", createdAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DLI elastic resource pool fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDliElasticResourcePoolUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dliv3", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DLI v3 client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"description": d.Get("description"),
+		"min_cu":      d.Get("min_cu"),
+		"max_cu":      d.Get("max_cu"),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("elastic-resource-pools", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating DLI elastic resource pool (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForDliElasticResourcePoolAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for the DLI elastic resource pool (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceDliElasticResourcePoolRead(ctx, d, meta)
+}
+
+func resourceDliElasticResourcePoolDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dliv3", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DLI v3 client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("elastic-resource-pools", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DLI elastic resource pool")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"AVAILABLE", "DELETING", "SCALING"},
+		Target:       []string{"DELETED"},
+		Refresh:      dliElasticResourcePoolStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DLI elastic resource pool (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,433 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDliQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDliQueueCreate,
+		ReadContext:   resourceDliQueueRead,
+		UpdateContext: resourceDliQueueUpdate,
+		DeleteContext: resourceDliQueueDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]{1,128}$`),
+					"only lowercase letters, digits and underscores (_) are allowed"),
+			},
+			"queue_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "sql",
+				ValidateFunc: validation.StringInSlice([]string{"sql", "general"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cu_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.IntAtLeast(16),
+					validation.IntDivisibleBy(16),
+				),
+			},
+			"elastic_resource_pool_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"min_cu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"elastic_resource_pool_name", "max_cu"},
+				ValidateFunc: validation.IntDivisibleBy(16),
+			},
+			"max_cu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"elastic_resource_pool_name", "min_cu"},
+				ValidateFunc: validation.IntDivisibleBy(16),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"platform": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "x86_64",
+				ValidateFunc: validation.StringInSlice([]string{"x86_64", "aarch64"}, false),
+			},
+			"resource_mode": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"feature": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"basic", "ai"}, false),
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_cidr": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"management_subnet_cidr": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "management_subnet_cidr is deprecated",
+			},
+			"subnet_cidr": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "subnet_cidr is deprecated",
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDliQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.DliV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DLI v1 client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	createOpts := map[string]interface{}{
+		"queue_name":                 name,
+		"queue_type":                 d.Get("queue_type"),
+		"description":                valueIgnoreEmpty(d.Get("description")),
+		"cu_count":                   d.Get("cu_count"),
+		"enterprise_project_id":      valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"platform":                   d.Get("platform"),
+		"resource_mode":              d.Get("resource_mode"),
+		"feature":                    valueIgnoreEmpty(d.Get("feature")),
+		"elastic_resource_pool_name": valueIgnoreEmpty(d.Get("elastic_resource_pool_name")),
+		"tags":                       utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	_, err = client.Request("POST", client.ServiceURL("queues"), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating DLI queue: %s", err)
+	}
+	d.SetId(name)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      dliQueueStateRefreshFunc(client, name),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DLI queue (%s) to be available: %s", name, err)
+	}
+
+	if v, ok := d.GetOk("vpc_cidr"); ok {
+		if err := updateDliQueueCidr(client, name, v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if _, ok := d.GetOk("elastic_resource_pool_name"); ok {
+		if _, ok := d.GetOk("min_cu"); ok {
+			if err := updateDliQueueScalingPolicy(conf, d); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceDliQueueRead(ctx, d, meta)
+}
+
+// getDliQueue returns a 404 error if the queue does not exist, the queues of all types are queried, because the
+// queue type is unknown during the import.
+func getDliQueue(client *golangsdk.ServiceClient, name string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("queues")+"?queue_type=all", &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := pathSearch(fmt.Sprintf("queues[?queue_name=='%s'] | [0]", name), respBody, nil)
+	if queue == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return queue, nil
+}
+
+func dliQueueStateRefreshFunc(client *golangsdk.ServiceClient, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		queue, err := getDliQueue(client, name)
+		if err != nil {
+			// the queue is not listed until it is assigned
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "CREATING", nil
+			}
+			return nil, "", err
+		}
+		if pathSearch("is_restarting", queue, false).(bool) {
+			return queue, "RESTARTING", nil
+		}
+		return queue, "AVAILABLE", nil
+	}
+}
+
+func updateDliQueueCidr(client *golangsdk.ServiceClient, name, cidr string) error {
+	_, err := client.Request("PUT", client.ServiceURL("queues", name), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"cidr_in_vpc": cidr,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error updating the CIDR of DLI queue (%s): %s", name, err)
+	}
+	return nil
+}
+
+// updateDliQueueScalingPolicy sets the CU range of the queue in the elastic resource pool, the range takes effect
+// during the whole day.
+func updateDliQueueScalingPolicy(conf *config.Config, d *schema.ResourceData) error {
+	client, err := NewServiceClient(conf, "dliv3", GetRegion(d, conf))
+	if err != nil {
+		return fmt.Errorf("error creating DLI v3 client: %s", err)
+	}
+
+	policyOpts := map[string]interface{}{
+		"queue_scaling_policies": []map[string]interface{}{
+			{
+				"priority":          1,
+				"impact_start_time": "00:00",
+				"impact_stop_time":  "24:00",
+				"min_cu":            d.Get("min_cu"),
+				"max_cu":            d.Get("max_cu"),
+			},
+		},
+	}
+	policyURL := client.ServiceURL("elastic-resource-pools", d.Get("elastic_resource_pool_name").(string), "queues",
+		d.Id())
+	_, err = client.Request("PUT", policyURL, &golangsdk.RequestOpts{
+		JSONBody: policyOpts,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating the scaling policy of DLI queue (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func getDliQueueScalingPolicy(conf *config.Config, d *schema.ResourceData, poolName string) (interface{}, error) {
+	client, err := NewServiceClient(conf, "dliv3", GetRegion(d, conf))
+	if err != nil {
+		return nil, fmt.Errorf("error creating DLI v3 client: %s", err)
+	}
+
+	listURL := client.ServiceURL("elastic-resource-pools", poolName, "queues") + "?queue_name=" + d.Id()
+	resp, err := client.Request("GET", listURL, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return pathSearch(fmt.Sprintf("queues[?queue_name=='%s'] | [0].queue_scaling_policies[0]", d.Id()), respBody,
+		nil), nil
+}
+
+func resourceDliQueueRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.DliV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DLI v1 client: %s", err)
+	}
+
+	queue, err := getDliQueue(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DLI queue")
+	}
+
+	poolName := pathSearch("elastic_resource_pool_name", queue, "").(string)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("queue_name", queue, nil)),
+		d.Set("queue_type", pathSearch("queue_type", queue, nil)),
+		d.Set("description", pathSearch("description", queue, nil)),
+		d.Set("cu_count", pathSearch("cu_count", queue, nil)),
+		d.Set("elastic_resource_pool_name", poolName),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", queue, nil)),
+		d.Set("platform", pathSearch("platform", queue, nil)),
+		d.Set("resource_mode", pathSearch("resource_mode", queue, nil)),
+		d.Set("feature", pathSearch("feature", queue, nil)),
+		d.Set("vpc_cidr", pathSearch("cidr_in_vpc", queue, nil)),
+		d.Set("resource_id", pathSearch("resource_id", queue, nil)),
+		d.Set("status", pathSearch("status", queue, nil)),
+		d.Set("create_time", pathSearch("create_time", queue, nil)),
+	)
+
+	if poolName != "" {
+		policy, err := getDliQueueScalingPolicy(conf, d, poolName)
+		if err != nil {
+			return diag.Errorf("error retrieving the scaling policy of DLI queue (%s): %s", d.Id(), err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("min_cu", pathSearch("min_cu", policy, nil)),
+			d.Set("max_cu", pathSearch("max_cu", policy, nil)),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DLI queue fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDliQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DliV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DLI v1 client: %s", err)
+	}
+
+	if d.HasChange("cu_count") {
+		oldVal, newVal := d.GetChange("cu_count")
+		action := "scale_out"
+		cuCount := newVal.(int) - oldVal.(int)
+		if cuCount < 0 {
+			action = "scale_in"
+			cuCount = -cuCount
+		}
+		_, err = client.Request("PUT", client.ServiceURL("queues", d.Id(), "action"), &golangsdk.RequestOpts{
+			JSONBody: map[string]interface{}{
+				"action":   action,
+				"cu_count": cuCount,
+			},
+		})
+		if err != nil {
+			return diag.Errorf("error scaling DLI queue (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{fmt.Sprint(oldVal)},
+			Target:  []string{fmt.Sprint(newVal)},
+			Refresh: func() (interface{}, string, error) {
+				queue, err := getDliQueue(client, d.Id())
+				if err != nil {
+					return nil, "", err
+				}
+				return queue, fmt.Sprint(pathSearch("cu_count", queue, float64(0)).(float64)), nil
+			},
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        30 * time.Second,
+			PollInterval: 20 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("error waiting for the DLI queue (%s) to be scaled: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("vpc_cidr") {
+		if err := updateDliQueueCidr(client, d.Id(), d.Get("vpc_cidr").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("min_cu", "max_cu") {
+		if err := updateDliQueueScalingPolicy(conf, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDliQueueRead(ctx, d, meta)
+}
+
+func resourceDliQueueDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DliV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DLI v1 client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("queues", d.Id()), &golangsdk.RequestOpts{}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DLI queue")
+	}
+
+	return nil
+}
@@ -0,0 +1,398 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dms/v2/availablezones"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dms"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRabbitmqInstance extends the RabbitMQ instance resource of the huaweicloud package with the flavor based
+// instances, whose number of brokers is specified by broker_num and can be scaled out. The instances which are
+// created with product_id are still managed by the functions of the huaweicloud package.
+func ResourceDmsRabbitmqInstance() *schema.Resource {
+	r := dms.ResourceDmsRabbitmqInstance()
+	createProductInstance := r.CreateContext
+	readInstance := r.ReadContext
+	updateInstance := r.UpdateContext
+
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if v, ok := d.GetOk("subnet_id"); ok {
+			if err := d.Set("network_id", v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if _, ok := d.GetOk("flavor_id"); !ok {
+			return createProductInstance(ctx, d, meta)
+		}
+		if diags := resourceDmsRabbitmqInstanceFlavorCreate(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+		return resourceDmsRabbitmqInstanceRead(ctx, d, meta, readInstance)
+	}
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return resourceDmsRabbitmqInstanceRead(ctx, d, meta, readInstance)
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := resizeDmsRabbitmqInstanceFlavor(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return updateInstance(ctx, d, meta)
+	}
+	r.CustomizeDiff = resourceDmsRabbitmqInstanceCustomizeDiff
+
+	r.Schema["network_id"].Required = false
+	r.Schema["network_id"].Optional = true
+	r.Schema["network_id"].Computed = true
+	r.Schema["network_id"].ExactlyOneOf = []string{"network_id", "subnet_id"}
+	r.Schema["subnet_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+
+	// the storage space of the flavor based instances is extended with the brokers, the others are still recreated
+	r.Schema["storage_space"].ForceNew = false
+
+	r.Schema["product_id"].Required = false
+	r.Schema["product_id"].Optional = true
+	r.Schema["product_id"].Computed = true
+	r.Schema["product_id"].ExactlyOneOf = []string{"product_id", "flavor_id"}
+	r.Schema["flavor_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"broker_num", "storage_space"},
+	}
+	r.Schema["broker_num"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+
+	r.Schema["management_address"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	r.Schema["brokers"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return r
+}
+
+// resourceDmsRabbitmqInstanceCustomizeDiff makes sure that the brokers and the storage space are only extended, and
+// only for the flavor based instances, the API can't shrink an instance. Changing the storage space of the other
+// instances still creates a new instance.
+func resourceDmsRabbitmqInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	isFlavorInstance := d.Get("flavor_id").(string) != ""
+	if d.HasChange("storage_space") {
+		if !isFlavorInstance {
+			return d.ForceNew("storage_space")
+		}
+		oldSpace, newSpace := d.GetChange("storage_space")
+		if newSpace.(int) < oldSpace.(int) {
+			return fmt.Errorf("the storage space can't be reduced from %d to %d", oldSpace, newSpace)
+		}
+	}
+	if d.HasChange("broker_num") {
+		if !isFlavorInstance {
+			return fmt.Errorf("broker_num can only be changed for the instances which are created with flavor_id")
+		}
+		oldNum, newNum := d.GetChange("broker_num")
+		if newNum.(int) < oldNum.(int) {
+			return fmt.Errorf("the number of brokers can't be reduced from %d to %d, only scaling out is supported",
+				oldNum, newNum)
+		}
+	}
+	return nil
+}
+
+// getDmsAvailableZoneIDs converts the codes of the availability zones into the IDs which are required by the API.
+func getDmsAvailableZoneIDs(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]string, error) {
+	if v, ok := d.GetOk("available_zones"); ok {
+		return utils.ExpandToStringList(v.([]interface{})), nil
+	}
+
+	resp, err := availablezones.Get(client)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DMS availability zones: %s", err)
+	}
+	zoneIDs := make(map[string]string, len(resp.AvailableZones))
+	for _, az := range resp.AvailableZones {
+		zoneIDs[az.Code] = az.ID
+	}
+
+	result := make([]string, 0)
+	for _, code := range d.Get("availability_zones").(*schema.Set).List() {
+		id, ok := zoneIDs[code.(string)]
+		if !ok {
+			return nil, fmt.Errorf("the availability zone (%s) is not supported by DMS", code)
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+func buildDmsRabbitmqInstanceFlavorBodyParams(d *schema.ResourceData, conf *config.Config,
+	zoneIDs []string) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"description":           valueIgnoreEmpty(d.Get("description")),
+		"engine":                "rabbitmq",
+		"engine_version":        d.Get("engine_version"),
+		"product_id":            d.Get("flavor_id"),
+		"broker_num":            d.Get("broker_num"),
+		"storage_space":         d.Get("storage_space"),
+		"storage_spec_code":     d.Get("storage_spec_code"),
+		"access_user":           d.Get("access_user"),
+		"password":              d.Get("password"),
+		"vpc_id":                d.Get("vpc_id"),
+		"subnet_id":             d.Get("network_id"),
+		"security_group_id":     d.Get("security_group_id"),
+		"available_zones":       zoneIDs,
+		"maintain_begin":        valueIgnoreEmpty(d.Get("maintain_begin")),
+		"maintain_end":          valueIgnoreEmpty(d.Get("maintain_end")),
+		"ssl_enable":            d.Get("ssl_enable"),
+		"enterprise_project_id": valueIgnoreEmpty(common.GetEnterpriseProjectID(d, conf)),
+	}
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		bodyParams["tags"] = utils.ExpandResourceTags(tagRaw)
+	}
+	if v, ok := d.GetOk("public_ip_id"); ok {
+		bodyParams["enable_publicip"] = true
+		bodyParams["publicip_id"] = v
+	}
+	return utils.RemoveNil(bodyParams)
+}
+
+func waitForDmsRabbitmqInstanceRunning(ctx context.Context, client *golangsdk.ServiceClient, id string,
+	pending []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      pending,
+		Target:       []string{"RUNNING"},
+		Refresh:      dms.DmsRabbitmqInstanceStateRefreshFunc(client, id),
+		Timeout:      timeout,
+		Delay:        60 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDmsRabbitmqInstanceFlavorCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DmsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	zoneIDs, err := getDmsAvailableZoneIDs(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createPath := client.ServiceURL(client.ProjectID, "instances")
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildDmsRabbitmqInstanceFlavorBodyParams(d, conf, zoneIDs),
+	}
+	createResp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS RabbitMQ instance: %s", err)
+	}
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := pathSearch("instance_id", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DMS RabbitMQ instance ID from the API response")
+	}
+	d.SetId(id)
+
+	err = waitForDmsRabbitmqInstanceRunning(ctx, client, id, []string{"CREATING"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for DMS RabbitMQ instance (%s) to become ready: %s", id, err)
+	}
+	return nil
+}
+
+// resizeDmsRabbitmqInstanceFlavor changes the flavor, scales out the brokers and extends the storage space of a
+// flavor based instance, each of them is a separate extend operation. The storage space is extended together with
+// the brokers, because the new brokers need their storage.
+func resizeDmsRabbitmqInstanceFlavor(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.Get("flavor_id").(string) == "" || !d.HasChanges("flavor_id", "broker_num", "storage_space") {
+		return nil
+	}
+
+	conf := meta.(*config.Config)
+	client, err := conf.DmsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	// each operation is paired with the attributes of the instance which show the operation has taken effect
+	operations := make([][2]map[string]interface{}, 0, 2)
+	if d.HasChange("flavor_id") {
+		operations = append(operations, [2]map[string]interface{}{
+			{
+				"oper_type":      "vertical",
+				"new_product_id": d.Get("flavor_id"),
+			},
+			{
+				"product_id": d.Get("flavor_id"),
+			},
+		})
+	}
+	switch {
+	case d.HasChange("broker_num"):
+		operations = append(operations, [2]map[string]interface{}{
+			{
+				"oper_type":         "horizontal",
+				"new_broker_num":    d.Get("broker_num"),
+				"new_storage_space": d.Get("storage_space"),
+			},
+			{
+				"broker_num":          d.Get("broker_num"),
+				"total_storage_space": d.Get("storage_space"),
+			},
+		})
+	case d.HasChange("storage_space"):
+		operations = append(operations, [2]map[string]interface{}{
+			{
+				"oper_type":         "storage",
+				"new_storage_space": d.Get("storage_space"),
+			},
+			{
+				"total_storage_space": d.Get("storage_space"),
+			},
+		})
+	}
+
+	resizePath := client.ServiceURL(client.ProjectID, "instances", d.Id(), "extend")
+	for _, operation := range operations {
+		_, err := client.Request("POST", resizePath, &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         operation[0],
+		})
+		if err != nil {
+			return fmt.Errorf("error resizing DMS RabbitMQ instance (%s) with %s operation: %s", d.Id(),
+				operation[0]["oper_type"], err)
+		}
+
+		err = waitForDmsRabbitmqInstanceResized(ctx, client, d.Id(), operation[1], d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error waiting for DMS RabbitMQ instance (%s) to be resized with %s operation: %s",
+				d.Id(), operation[0]["oper_type"], err)
+		}
+	}
+	return nil
+}
+
+// waitForDmsRabbitmqInstanceResized waits until the instance is running with the expected attributes. The instance
+// may still be running for a while after the extend request is accepted, so the status alone doesn't tell whether
+// the operation has finished.
+func waitForDmsRabbitmqInstanceResized(ctx context.Context, client *golangsdk.ServiceClient, id string,
+	expected map[string]interface{}, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			getPath := client.ServiceURL(client.ProjectID, "instances", id)
+			getResp, err := client.Request("GET", getPath, &golangsdk.RequestOpts{KeepResponseBody: true})
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			getRespBody, err := utils.FlattenResponse(getResp)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+
+			status := pathSearch("status", getRespBody, "").(string)
+			switch status {
+			case "EXTENDING":
+				return getRespBody, "PENDING", nil
+			case "RUNNING":
+				for k, v := range expected {
+					if fmt.Sprint(pathSearch(k, getRespBody, "")) != fmt.Sprint(v) {
+						return getRespBody, "PENDING", nil
+					}
+				}
+				return getRespBody, "COMPLETED", nil
+			}
+			return getRespBody, status, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// resourceDmsRabbitmqInstanceRead reads the instance with the function of the huaweicloud package, and then sets the
+// attributes which are only returned by the raw API.
+func resourceDmsRabbitmqInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{},
+	readInstance schema.ReadContextFunc) diag.Diagnostics {
+	if diags := readInstance(ctx, d, meta); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	conf := meta.(*config.Config)
+	client, err := conf.DmsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := client.ServiceURL(client.ProjectID, "instances", d.Id())
+	getResp, err := client.Request("GET", getPath, &golangsdk.RequestOpts{KeepResponseBody: true})
+	if err != nil {
+		return diag.Errorf("error retrieving DMS RabbitMQ instance (%s): %s", d.Id(), err)
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	brokers := make([]string, 0)
+	for _, address := range strings.Split(pathSearch("connect_address", getRespBody, "").(string), ",") {
+		if address != "" {
+			brokers = append(brokers, address)
+		}
+	}
+	mErr := multierror.Append(nil,
+		d.Set("subnet_id", pathSearch("subnet_id", getRespBody, nil)),
+		d.Set("broker_num", pathSearch("broker_num", getRespBody, nil)),
+		d.Set("management_address", pathSearch("management_connect_address", getRespBody, nil)),
+		d.Set("brokers", brokers),
+	)
+	// the flavor of the flavor based instances is returned as the product ID
+	if d.Get("flavor_id").(string) != "" {
+		mErr = multierror.Append(mErr, d.Set("flavor_id", pathSearch("product_id", getRespBody, nil)))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DMS RabbitMQ instance fields: %s", err)
+	}
+
+	return nil
+}
@@ -76,6 +76,41 @@ func TestAccDmsRabbitmqInstances_withEpsId(t *testing.T) {
 	})
 }
 
+func TestAccDmsRabbitmqInstances_flavor(t *testing.T) {
+	var instance instances.Instance
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_dms_rabbitmq_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDmsRabbitmqInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqInstance_flavor(rName, 3, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsRabbitmqInstanceExists(resourceName, instance),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "c6.2u4g.cluster"),
+					resource.TestCheckResourceAttr(resourceName, "broker_num", "3"),
+					resource.TestCheckResourceAttr(resourceName, "brokers.#", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "data.sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "management_address"),
+				),
+			},
+			{
+				Config: testAccDmsRabbitmqInstance_flavor(rName, 5, 500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDmsRabbitmqInstanceExists(resourceName, instance),
+					resource.TestCheckResourceAttr(resourceName, "broker_num", "5"),
+					resource.TestCheckResourceAttr(resourceName, "storage_space", "500"),
+					resource.TestCheckResourceAttr(resourceName, "brokers.#", "5"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDmsRabbitmqInstanceDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
 	dmsClient, err := config.DmsV2Client(SBC_REGION_NAME)
@@ -214,3 +249,25 @@ resource "sbercloud_dms_rabbitmq_instance" "test" {
 }
 `, testAccDmsRabbitmqInstance_Base(rName), rName, SBC_ENTERPRISE_PROJECT_ID_TEST)
 }
+
+func testAccDmsRabbitmqInstance_flavor(rName string, brokerNum, storageSpace int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_dms_rabbitmq_instance" "test" {
+  name               = "%s"
+  description        = "rabbitmq test"
+  access_user        = "user"
+  password           = "Rabbitmqtest@123"
+  vpc_id             = data.sbercloud_vpc.test.id
+  subnet_id          = data.sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.test.id
+  availability_zones = [data.sbercloud_dms_az.test.code]
+  flavor_id          = "c6.2u4g.cluster"
+  broker_num         = %d
+  engine_version     = "3.8.35"
+  storage_space      = %d
+  storage_spec_code  = "dms.physical.storage.ultra.v2"
+}
+`, testAccDmsRabbitmqInstance_Base(rName), rName, brokerNum, storageSpace)
+}
@@ -0,0 +1,219 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dns/v2/zones"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceDNSZoneVpcAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSZoneVpcAssociationCreate,
+		ReadContext:   resourceDNSZoneVpcAssociationRead,
+		DeleteContext: resourceDNSZoneVpcAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSZoneVpcAssociationImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// the VPC can be located in a region other than the region of the private zone
+			"vpc_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func findDNSZoneRouter(zone *zones.Zone, vpcID string) *zones.RouterResult {
+	for i := range zone.Routers {
+		if zone.Routers[i].RouterID == vpcID {
+			return &zone.Routers[i]
+		}
+	}
+	return nil
+}
+
+func dnsZoneVpcAssociationStateRefreshFunc(client *golangsdk.ServiceClient, zoneID,
+	vpcID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		zone, err := zones.Get(client, zoneID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		router := findDNSZoneRouter(zone, vpcID)
+		if router == nil {
+			return zone, "DELETED", nil
+		}
+		// the status maybe one of PENDING_CREATE, PENDING_DELETE, ACTIVE or ERROR
+		status := strings.Split(router.Status, "_")[0]
+		if status == "ERROR" {
+			return zone, status, fmt.Errorf("the association between the DNS zone and the VPC is in ERROR status")
+		}
+		return zone, status, nil
+	}
+}
+
+func resourceDNSZoneVpcAssociationCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.DnsWithRegionClient(region)
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	vpcID := d.Get("vpc_id").(string)
+	vpcRegion := d.Get("vpc_region").(string)
+	if vpcRegion == "" {
+		vpcRegion = region
+	}
+
+	associateOpts := zones.RouterOpts{
+		RouterID:     vpcID,
+		RouterRegion: vpcRegion,
+	}
+	if _, err := zones.AssociateZone(client, zoneID, associateOpts).Extract(); err != nil {
+		return diag.Errorf("error associating VPC (%s) with DNS zone (%s): %s", vpcID, zoneID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", zoneID, vpcID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    dnsZoneVpcAssociationStateRefreshFunc(client, zoneID, vpcID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the VPC (%s) to be associated with DNS zone (%s): %s",
+			vpcID, zoneID, err)
+	}
+
+	return resourceDNSZoneVpcAssociationRead(ctx, d, meta)
+}
+
+func resourceDNSZoneVpcAssociationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.DnsWithRegionClient(region)
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	vpcID := strings.TrimPrefix(d.Id(), zoneID+"/")
+	zone, err := zones.Get(client, zoneID).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DNS zone")
+	}
+
+	router := findDNSZoneRouter(zone, vpcID)
+	if router == nil {
+		log.Printf("[WARN] the VPC (%s) is no longer associated with DNS zone (%s), removing from state",
+			vpcID, zoneID)
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vpc_id", router.RouterID),
+		d.Set("vpc_region", router.RouterRegion),
+		d.Set("status", router.Status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DNS zone VPC association fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDNSZoneVpcAssociationDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.DnsWithRegionClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	vpcID := strings.TrimPrefix(d.Id(), zoneID+"/")
+	disassociateOpts := zones.RouterOpts{
+		RouterID:     vpcID,
+		RouterRegion: d.Get("vpc_region").(string),
+	}
+	if _, err := zones.DisassociateZone(client, zoneID, disassociateOpts).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disassociating VPC from DNS zone")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING", "ACTIVE"},
+		Target:     []string{"DELETED"},
+		Refresh:    dnsZoneVpcAssociationStateRefreshFunc(client, zoneID, vpcID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the VPC (%s) to be disassociated from DNS zone (%s): %s",
+			vpcID, zoneID, err)
+	}
+
+	return nil
+}
+
+func resourceDNSZoneVpcAssociationImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <zone_id>/<vpc_id>")
+	}
+
+	return []*schema.ResourceData{d}, d.Set("zone_id", parts[0])
+}
@@ -0,0 +1,306 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/evs/v2/cloudvolumes"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDssDedicatedStorage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDssDedicatedStorageCreate,
+		ReadContext:   resourceDssDedicatedStorageRead,
+		UpdateContext: resourceDssDedicatedStorageUpdate,
+		DeleteContext: resourceDssDedicatedStorageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SSD", "GPSSD", "SAS"}, false),
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"capacity": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"used_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disk_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDssDedicatedStorageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DSS client: %s", err)
+	}
+
+	poolOpts := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"type":                  d.Get("type"),
+		"availability_zone":     d.Get("availability_zone"),
+		"capacity":              d.Get("capacity"),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	createOpts := map[string]interface{}{
+		"pool": utils.RemoveNil(poolOpts),
+		"tags": valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("pools"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating DSS dedicated storage: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("pool.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DSS dedicated storage ID from the API response")
+	}
+	d.SetId(id)
+
+	if err := waitForDssDedicatedStorageAvailable(ctx, client, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the DSS dedicated storage (%s) to be available: %s", id, err)
+	}
+
+	return resourceDssDedicatedStorageRead(ctx, d, meta)
+}
+
+func dssDedicatedStorageStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Request("GET", client.ServiceURL("pools", id), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := pathSearch("pool.status", respBody, "").(string)
+		if status == "error" {
+			return respBody, status, fmt.Errorf("the DSS dedicated storage is in error status")
+		}
+		return respBody, status, nil
+	}
+}
+
+func waitForDssDedicatedStorageAvailable(ctx context.Context, client *golangsdk.ServiceClient, id string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"creating", "expanding"},
+		Target:       []string{"available"},
+		Refresh:      dssDedicatedStorageStateRefreshFunc(client, id),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// listDssDiskIDs queries the EVS disks which are created in the dedicated storage.
+func listDssDiskIDs(conf *config.Config, region, id string) ([]string, error) {
+	client, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EVS client: %s", err)
+	}
+
+	volumes, err := cloudvolumes.ListPage(client, cloudvolumes.ListOpts{
+		DedicatedStorageID: id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying the disks of DSS dedicated storage (%s): %s", id, err)
+	}
+
+	result := make([]string, len(volumes))
+	for i, v := range volumes {
+		result[i] = v.ID
+	}
+	return result, nil
+}
+
+func resourceDssDedicatedStorageRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "dss", region)
+	if err != nil {
+		return diag.Errorf("error creating DSS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("pools", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DSS dedicated storage")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diskIDs, err := listDssDiskIDs(conf, region, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pool := pathSearch("pool", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", pool, nil)),
+		d.Set("type", pathSearch("type", pool, nil)),
+		d.Set("availability_zone", pathSearch("availability_zone", pool, nil)),
+		d.Set("capacity", pathSearch("capacity", pool, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", pool, nil)),
+		d.Set("tags", flattenResponseTags("tags", pool)),
+		d.Set("status", pathSearch("status", pool, nil)),
+		d.Set("used_capacity", pathSearch("used_capacity", pool, nil)),
+		d.Set("product_id", pathSearch("product_id", pool, nil)),
+		d.Set("disk_ids", diskIDs),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DSS dedicated storage fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDssDedicatedStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DSS client: %s", err)
+	}
+
+	if d.HasChange("capacity") {
+		oldCapacity, newCapacity := d.GetChange("capacity")
+		if newCapacity.(int) < oldCapacity.(int) {
+			return diag.Errorf("the capacity of DSS dedicated storage can only be expanded")
+		}
+
+		expandOpts := map[string]interface{}{
+			"capacity": newCapacity,
+		}
+		_, err = client.Request("POST", client.ServiceURL("pools", d.Id(), "expand-capacity"), &golangsdk.RequestOpts{
+			JSONBody: expandOpts,
+			OkCodes:  []int{200, 202},
+		})
+		if err != nil {
+			return diag.Errorf("error expanding the capacity of DSS dedicated storage (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForDssDedicatedStorageAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for the DSS dedicated storage (%s) to be expanded: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := utils.UpdateResourceTags(client, d, "pools", d.Id()); err != nil {
+			return diag.Errorf("error updating tags of DSS dedicated storage (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDssDedicatedStorageRead(ctx, d, meta)
+}
+
+func resourceDssDedicatedStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "dss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating DSS client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("pools", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DSS dedicated storage")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"available", "deleting"},
+		Target:       []string{"deleted"},
+		Refresh:      dssDedicatedStorageStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DSS dedicated storage (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
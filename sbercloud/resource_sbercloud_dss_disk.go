@@ -0,0 +1,228 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk/openstack/evs/v2/cloudvolumes"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/evs"
+)
+
+// ResourceDssDisk manages the EVS disks which are created in a DSS dedicated storage, the disks are scheduled to the
+// storage by the scheduler hints of the EVS API.
+func ResourceDssDisk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDssDiskCreate,
+		ReadContext:   resourceDssDiskRead,
+		UpdateContext: resourceDssDiskUpdate,
+		DeleteContext: resourceDssDiskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"storage_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SSD", "GPSSD", "SAS"}, false),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDssDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	evsV2Client, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating EVS v2 client: %s", err)
+	}
+	evsV21Client, err := conf.BlockStorageV21Client(region)
+	if err != nil {
+		return diag.Errorf("error creating EVS v2.1 client: %s", err)
+	}
+
+	createOpts := cloudvolumes.CreateOpts{
+		Volume: cloudvolumes.VolumeOpts{
+			AvailabilityZone: d.Get("availability_zone").(string),
+			VolumeType:       d.Get("type").(string),
+			Name:             d.Get("name").(string),
+			Size:             d.Get("size").(int),
+		},
+		Scheduler: &cloudvolumes.SchedulerOpts{
+			StorageID: d.Get("storage_id").(string),
+		},
+	}
+	job, err := cloudvolumes.Create(evsV21Client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating DSS disk: %s", err)
+	}
+	if len(job.VolumeIDs) < 1 {
+		return diag.Errorf("unable to find the DSS disk ID from the API response")
+	}
+	d.SetId(job.VolumeIDs[0])
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"creating"},
+		Target:       []string{"available"},
+		Refresh:      evs.CloudVolumeRefreshFunc(evsV2Client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DSS disk (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceDssDiskRead(ctx, d, meta)
+}
+
+func resourceDssDiskRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating EVS v2 client: %s", err)
+	}
+
+	volume, err := cloudvolumes.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DSS disk")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("storage_id", volume.DedicatedStorageID),
+		d.Set("availability_zone", volume.AvailabilityZone),
+		d.Set("type", volume.VolumeType),
+		d.Set("size", volume.Size),
+		d.Set("name", volume.Name),
+		d.Set("volume_id", volume.ID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DSS disk fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDssDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	evsV2Client, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating EVS v2 client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updateOpts := cloudvolumes.UpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		if _, err := cloudvolumes.Update(evsV2Client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("error updating the name of DSS disk (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("size") {
+		oldSize, newSize := d.GetChange("size")
+		if newSize.(int) < oldSize.(int) {
+			return diag.Errorf("the size of DSS disk can only be expanded")
+		}
+
+		evsV21Client, err := conf.BlockStorageV21Client(region)
+		if err != nil {
+			return diag.Errorf("error creating EVS v2.1 client: %s", err)
+		}
+		extendOpts := cloudvolumes.ExtendOpts{
+			SizeOpts: cloudvolumes.ExtendSizeOpts{
+				NewSize: newSize.(int),
+			},
+		}
+		if _, err := cloudvolumes.ExtendSize(evsV21Client, d.Id(), extendOpts).Extract(); err != nil {
+			return diag.Errorf("error expanding the size of DSS disk (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"extending"},
+			Target:       []string{"available", "in-use"},
+			Refresh:      evs.CloudVolumeRefreshFunc(evsV2Client, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        5 * time.Second,
+			PollInterval: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("error waiting for the DSS disk (%s) to be expanded: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDssDiskRead(ctx, d, meta)
+}
+
+func resourceDssDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BlockStorageV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EVS v2 client: %s", err)
+	}
+
+	if err := cloudvolumes.Delete(client, d.Id(), cloudvolumes.DeleteOpts{}).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DSS disk")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"available", "deleting"},
+		Target:       []string{"deleted"},
+		Refresh:      evs.CloudVolumeRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DSS disk (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
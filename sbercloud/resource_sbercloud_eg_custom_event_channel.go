@@ -0,0 +1,235 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceEgCustomEventChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEgCustomEventChannelCreate,
+		ReadContext:   resourceEgCustomEventChannelRead,
+		UpdateContext: resourceEgCustomEventChannelUpdate,
+		DeleteContext: resourceEgCustomEventChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cross_account_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildEgChannelPolicy builds the policy which allows the specified accounts to publish events to the channel.
+func buildEgChannelPolicy(d *schema.ResourceData, region, projectID string) map[string]interface{} {
+	accountIDs := d.Get("cross_account_ids").([]interface{})
+	if len(accountIDs) < 1 {
+		return nil
+	}
+
+	principals := make([]string, len(accountIDs))
+	for i, v := range accountIDs {
+		principals[i] = fmt.Sprintf("iam::%s:root", v.(string))
+	}
+	return map[string]interface{}{
+		"sid":    "allow_account_to_put_events",
+		"effect": "Allow",
+		"principal": map[string]interface{}{
+			"IAM": principals,
+		},
+		"action":   "eg:cloudEvents:putEvents",
+		"resource": fmt.Sprintf("urn:eg:%s:%s:channel:%s", region, projectID, d.Get("name").(string)),
+	}
+}
+
+func resourceEgCustomEventChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "eg", region)
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"eps_id":      valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"policy":      buildEgChannelPolicy(d, region, client.ProjectID),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("channels"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating EG custom event channel: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the EG custom event channel ID from the API response")
+	}
+	d.SetId(id)
+
+	// the channel has no status field, so it's considered running once it can be queried
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"RUNNING"},
+		Refresh:    egCustomEventChannelStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for EG custom event channel (%s) to become running: %s", id, err)
+	}
+
+	return resourceEgCustomEventChannelRead(ctx, d, meta)
+}
+
+func getEgCustomEventChannel(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("channels", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func egCustomEventChannelStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getEgCustomEventChannel(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "PENDING", nil
+			}
+			return nil, "", err
+		}
+		return respBody, "RUNNING", nil
+	}
+}
+
+func flattenEgChannelCrossAccountIDs(respBody interface{}) []string {
+	principals := pathSearch("policy.principal.IAM", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]string, len(principals))
+	for i, v := range principals {
+		result[i] = strings.TrimSuffix(strings.TrimPrefix(v.(string), "iam::"), ":root")
+	}
+	return result
+}
+
+func resourceEgCustomEventChannelRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "eg", region)
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	respBody, err := getEgCustomEventChannel(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving EG custom event channel")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("description", pathSearch("description", respBody, nil)),
+		d.Set("enterprise_project_id", pathSearch("eps_id", respBody, nil)),
+		d.Set("cross_account_ids", flattenEgChannelCrossAccountIDs(respBody)),
+		d.Set("created_at", pathSearch("created_time", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting EG custom event channel fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEgCustomEventChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "eg", region)
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"description": d.Get("description"),
+		"policy":      buildEgChannelPolicy(d, region, client.ProjectID),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("channels", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating EG custom event channel (%s): %s", d.Id(), err)
+	}
+
+	return resourceEgCustomEventChannelRead(ctx, d, meta)
+}
+
+func resourceEgCustomEventChannelDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "eg", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("channels", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting EG custom event channel")
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceEgCustomEventSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEgCustomEventSourceCreate,
+		ReadContext:   resourceEgCustomEventSourceRead,
+		UpdateContext: resourceEgCustomEventSourceUpdate,
+		DeleteContext: resourceEgCustomEventSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "APPLICATION",
+				ValidateFunc: validation.StringInSlice([]string{"APPLICATION", "RABBITMQ", "ROCKETMQ"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEgCustomEventSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "eg", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"channel_id":  d.Get("channel_id"),
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"type":        d.Get("type"),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("sources"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error creating EG custom event source: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the EG custom event source ID from the API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"RUNNING"},
+		Refresh:    egCustomEventSourceStateRefreshFunc(client, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for EG custom event source (%s) to become running: %s", id, err)
+	}
+
+	return resourceEgCustomEventSourceRead(ctx, d, meta)
+}
+
+func getEgCustomEventSource(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("sources", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func egCustomEventSourceStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getEgCustomEventSource(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "PENDING", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("status", respBody, "").(string)
+		switch status {
+		case "RUNNING":
+			return respBody, status, nil
+		case "CREATE_FAILED", "ERROR":
+			return respBody, status, fmt.Errorf("unexpected status: %s, detail: %v", status,
+				pathSearch("detail", respBody, nil))
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func resourceEgCustomEventSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "eg", region)
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	respBody, err := getEgCustomEventSource(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving EG custom event source")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("channel_id", pathSearch("channel_id", respBody, nil)),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("description", pathSearch("description", respBody, nil)),
+		d.Set("type", pathSearch("type", respBody, nil)),
+		d.Set("status", pathSearch("status", respBody, nil)),
+		d.Set("created_at", pathSearch("created_time", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting EG custom event source fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEgCustomEventSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "eg", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"description": d.Get("description"),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("sources", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating EG custom event source (%s): %s", d.Id(), err)
+	}
+
+	return resourceEgCustomEventSourceRead(ctx, d, meta)
+}
+
+func resourceEgCustomEventSourceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "eg", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("sources", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting EG custom event source")
+	}
+
+	return nil
+}
@@ -0,0 +1,383 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func egJsonStringSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     required,
+		Optional:     !required,
+		ValidateFunc: utils.ValidateJsonString,
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
+			return equal
+		},
+	}
+}
+
+func ResourceEgEventSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEgEventSubscriptionCreate,
+		ReadContext:   resourceEgEventSubscriptionRead,
+		UpdateContext: resourceEgEventSubscriptionUpdate,
+		DeleteContext: resourceEgEventSubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"provider_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"CUSTOM", "OFFICIAL"}, false),
+						},
+						"filter_rule": egJsonStringSchema(false),
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"provider_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"CUSTOM", "OFFICIAL"}, false),
+						},
+						"operation":              egJsonStringSchema(true),
+						"event_data_filter_rule": egJsonStringSchema(false),
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseEgJsonParam converts the JSON string to an object, the string has been validated by the schema.
+func parseEgJsonParam(v interface{}) interface{} {
+	if v == nil || v.(string) == "" {
+		return nil
+	}
+
+	var result interface{}
+	_ = json.Unmarshal([]byte(v.(string)), &result)
+	return result
+}
+
+func marshalEgJsonParam(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	result, _ := json.Marshal(v)
+	return string(result)
+}
+
+func buildEgSubscriptionSources(sources []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(sources))
+	for i, v := range sources {
+		source := v.(map[string]interface{})
+		result[i] = utils.RemoveNil(map[string]interface{}{
+			"id":            valueIgnoreEmpty(source["id"]),
+			"name":          source["name"],
+			"provider_type": source["provider_type"],
+			"filter":        parseEgJsonParam(source["filter_rule"]),
+		})
+	}
+	return result
+}
+
+func buildEgSubscriptionTargets(targets []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(targets))
+	for i, v := range targets {
+		target := v.(map[string]interface{})
+		result[i] = utils.RemoveNil(map[string]interface{}{
+			"id":            valueIgnoreEmpty(target["id"]),
+			"name":          target["name"],
+			"provider_type": target["provider_type"],
+			"detail":        parseEgJsonParam(target["operation"]),
+			"transform":     parseEgJsonParam(target["event_data_filter_rule"]),
+		})
+	}
+	return result
+}
+
+func resourceEgEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "eg", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"channel_id":  d.Get("channel_id"),
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"sources":     buildEgSubscriptionSources(d.Get("sources").([]interface{})),
+		"targets":     buildEgSubscriptionTargets(d.Get("targets").([]interface{})),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("subscriptions"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error creating EG event subscription: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the EG event subscription ID from the API response")
+	}
+	d.SetId(id)
+
+	// the subscription is created in the disabled state
+	if err := operateEgEventSubscription(ctx, client, id, "ENABLE", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error enabling EG event subscription (%s): %s", id, err)
+	}
+
+	return resourceEgEventSubscriptionRead(ctx, d, meta)
+}
+
+// operateEgEventSubscription enables or disables the subscription and waits for the operation to complete.
+func operateEgEventSubscription(ctx context.Context, client *golangsdk.ServiceClient, id, operation string,
+	timeout time.Duration) error {
+	opts := map[string]interface{}{
+		"subscriptions": []map[string]interface{}{
+			{
+				"id": id,
+			},
+		},
+		"operation": operation,
+	}
+	_, err := client.Request("POST", client.ServiceURL("subscriptions", "operation"), &golangsdk.RequestOpts{
+		JSONBody: opts,
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return err
+	}
+
+	target := "ENABLED"
+	if operation == "DISABLE" {
+		target = "DISABLED"
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{target},
+		Refresh:    egEventSubscriptionStateRefreshFunc(client, id, target),
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func getEgEventSubscription(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("subscriptions", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func egEventSubscriptionStateRefreshFunc(client *golangsdk.ServiceClient, id, target string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getEgEventSubscription(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := pathSearch("status", respBody, "").(string)
+		switch status {
+		case target:
+			return respBody, status, nil
+		case "ERROR", "FROZEN":
+			return respBody, status, fmt.Errorf("unexpected status: %s", status)
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func flattenEgSubscriptionSources(respBody interface{}) []map[string]interface{} {
+	sources := pathSearch("sources", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(sources))
+	for i, source := range sources {
+		result[i] = map[string]interface{}{
+			"id":            pathSearch("id", source, nil),
+			"name":          pathSearch("name", source, nil),
+			"provider_type": pathSearch("provider_type", source, nil),
+			"filter_rule":   marshalEgJsonParam(pathSearch("filter", source, nil)),
+		}
+	}
+	return result
+}
+
+func flattenEgSubscriptionTargets(respBody interface{}) []map[string]interface{} {
+	targets := pathSearch("targets", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(targets))
+	for i, target := range targets {
+		result[i] = map[string]interface{}{
+			"id":                     pathSearch("id", target, nil),
+			"name":                   pathSearch("name", target, nil),
+			"provider_type":          pathSearch("provider_type", target, nil),
+			"operation":              marshalEgJsonParam(pathSearch("detail", target, nil)),
+			"event_data_filter_rule": marshalEgJsonParam(pathSearch("transform", target, nil)),
+		}
+	}
+	return result
+}
+
+func resourceEgEventSubscriptionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "eg", region)
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	respBody, err := getEgEventSubscription(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving EG event subscription")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("channel_id", pathSearch("channel_id", respBody, nil)),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("description", pathSearch("description", respBody, nil)),
+		d.Set("sources", flattenEgSubscriptionSources(respBody)),
+		d.Set("targets", flattenEgSubscriptionTargets(respBody)),
+		d.Set("status", pathSearch("status", respBody, nil)),
+		d.Set("created_at", pathSearch("created_time", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting EG event subscription fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEgEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "eg", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"description": d.Get("description"),
+		"sources":     buildEgSubscriptionSources(d.Get("sources").([]interface{})),
+		"targets":     buildEgSubscriptionTargets(d.Get("targets").([]interface{})),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("subscriptions", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating EG event subscription (%s): %s", d.Id(), err)
+	}
+
+	return resourceEgEventSubscriptionRead(ctx, d, meta)
+}
+
+func resourceEgEventSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "eg", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EG client: %s", err)
+	}
+
+	// the enabled subscription cannot be deleted
+	if d.Get("status").(string) == "ENABLED" {
+		err = operateEgEventSubscription(ctx, client, d.Id(), "DISABLE", d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return common.CheckDeletedDiag(d, err, "error disabling EG event subscription")
+		}
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("subscriptions", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting EG event subscription")
+	}
+
+	return nil
+}
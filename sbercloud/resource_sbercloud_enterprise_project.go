@@ -0,0 +1,79 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/eps/v1/enterpriseprojects"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/eps"
+)
+
+// epsProjectNotEmptyErrCode is the error code returned when the enterprise project still contains resources.
+const epsProjectNotEmptyErrCode = "EPS.0021"
+
+// ResourceEnterpriseProject extends the upstream resource to delete the enterprise project after it is disabled.
+func ResourceEnterpriseProject() *schema.Resource {
+	r := eps.ResourceEnterpriseProject()
+	// the upstream resource validates the production type as "proc", but the API expects "prod"
+	r.Schema["type"].ValidateFunc = validation.StringInSlice([]string{"poc", "prod"}, false)
+	r.DeleteContext = resourceEnterpriseProjectDelete
+	return r
+}
+
+func resourceEnterpriseProjectDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.EnterpriseProjectClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EPS client: %s", err)
+	}
+
+	// an enterprise project can only be deleted after it is disabled, 2 means disabled
+	if d.Get("status").(int) != 2 {
+		actionOpts := enterpriseprojects.ActionOpts{
+			Action: "disable",
+		}
+		if _, err := enterpriseprojects.Action(client, actionOpts, d.Id()).Extract(); err != nil {
+			return diag.Errorf("error disabling enterprise project (%s): %s", d.Id(), err)
+		}
+	}
+
+	// the deletion fails if there are still resources in the enterprise project
+	_, err = client.Request("DELETE", client.ServiceURL("enterprise-projects", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		if !isEnterpriseProjectNotEmptyErr(err) {
+			return diag.Errorf("error deleting enterprise project (%s): %s", d.Id(), err)
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The enterprise project is disabled but not deleted",
+				Detail: fmt.Sprintf("The enterprise project (%s) is removed from the state, but it remains in the "+
+					"cloud. Migrate all resources out of it before deleting it: %s", d.Id(), err),
+			},
+		}
+	}
+
+	return nil
+}
+
+// isEnterpriseProjectNotEmptyErr checks whether the deletion is rejected because the enterprise project still
+// contains resources, the other errors are not expected to leave the project behind.
+func isEnterpriseProjectNotEmptyErr(err error) bool {
+	errCode, ok := err.(golangsdk.ErrDefault400)
+	if !ok {
+		return false
+	}
+	var apiErr interface{}
+	if json.Unmarshal(errCode.Body, &apiErr) != nil {
+		return false
+	}
+	return fmt.Sprint(pathSearch("error.error_code || error_code", apiErr, "")) == epsProjectNotEmptyErrCode
+}
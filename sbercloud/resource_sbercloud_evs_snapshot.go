@@ -0,0 +1,226 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/evs/v2/cloudvolumes"
+	"github.com/chnsz/golangsdk/openstack/evs/v2/snapshots"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceEvsSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEvsSnapshotCreate,
+		ReadContext:   resourceEvsSnapshotRead,
+		UpdateContext: resourceEvsSnapshotUpdate,
+		DeleteContext: resourceEvsSnapshotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// the snapshot belongs to the enterprise project of the source volume
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEvsSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BlockStorageV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EVS client: %s", err)
+	}
+
+	createOpts := snapshots.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Force:       d.Get("force").(bool),
+		Metadata:    resourceInstanceMetadataV2(d),
+	}
+	snapshot, err := snapshots.Create(client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating EVS snapshot: %s", err)
+	}
+	d.SetId(snapshot.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"creating"},
+		Target:       []string{"available"},
+		Refresh:      evsSnapshotStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the EVS snapshot (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceEvsSnapshotRead(ctx, d, meta)
+}
+
+func resourceEvsSnapshotRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating EVS client: %s", err)
+	}
+
+	snapshot, err := snapshots.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving EVS snapshot")
+	}
+
+	volume, err := cloudvolumes.Get(client, snapshot.VolumeID).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving the source volume (%s) of EVS snapshot: %s", snapshot.VolumeID, err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("volume_id", snapshot.VolumeID),
+		d.Set("name", snapshot.Name),
+		d.Set("description", snapshot.Description),
+		d.Set("metadata", snapshot.Metadata),
+		d.Set("enterprise_project_id", volume.EnterpriseProjectID),
+		d.Set("status", snapshot.Status),
+		d.Set("size", snapshot.Size),
+		d.Set("created_at", snapshot.CreatedAt.Format(time.RFC3339)),
+		d.Set("updated_at", snapshot.UpdatedAt.Format(time.RFC3339)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting EVS snapshot fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEvsSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BlockStorageV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EVS client: %s", err)
+	}
+
+	if d.HasChanges("name", "description") {
+		updateOpts := snapshots.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		if _, err := snapshots.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("error updating EVS snapshot (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceEvsSnapshotRead(ctx, d, meta)
+}
+
+func resourceEvsSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.BlockStorageV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating EVS client: %s", err)
+	}
+
+	if err := snapshots.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting EVS snapshot")
+	}
+
+	// the deletion of the snapshot of a large volume takes a while
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"available", "deleting"},
+		Target:       []string{"deleted"},
+		Refresh:      evsSnapshotStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the EVS snapshot (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func evsSnapshotStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := snapshots.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if snapshot.Status == "error" || snapshot.Status == "error_deleting" {
+			return snapshot, "", fmt.Errorf("the EVS snapshot is in %s status", snapshot.Status)
+		}
+		return snapshot, snapshot.Status, nil
+	}
+}
@@ -30,8 +30,28 @@ func TestAccEvsSnapshotV2_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "description", "Daily backup"),
 					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "size", "12"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
 				),
 			},
+			{
+				Config: testAccEvsSnapshotV2_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvsSnapshotV2Exists(resourceName, &snapshot),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Weekly backup"),
+					resource.TestCheckResourceAttrPair("sbercloud_evs_volume.from_snapshot", "snapshot_id",
+						resourceName, "id"),
+					resource.TestCheckResourceAttr("sbercloud_evs_volume.from_snapshot", "size", "12"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
 		},
 	})
 }
@@ -97,6 +117,33 @@ resource "sbercloud_evs_snapshot" "test" {
   volume_id   = sbercloud_evs_volume.test.id
   name        = "%s"
   description = "Daily backup"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+`, testAccEvsStorageV3Volume_basic(rName), rName)
+}
+
+func testAccEvsSnapshotV2_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_evs_snapshot" "test" {
+  volume_id   = sbercloud_evs_volume.test.id
+  name        = "%[2]s-update"
+  description = "Weekly backup"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+
+resource "sbercloud_evs_volume" "from_snapshot" {
+  name              = "%[2]s-from-snapshot"
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  volume_type       = "SSD"
+  snapshot_id       = sbercloud_evs_snapshot.test.id
 }
 `, testAccEvsStorageV3Volume_basic(rName), rName)
 }
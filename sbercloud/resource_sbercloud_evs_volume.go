@@ -0,0 +1,14 @@
+package sbercloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/evs"
+)
+
+// ResourceEvsVolume extends the volume resource of the evs package. The volume created from a snapshot inherits the
+// size of the snapshot, so the size is not required when the snapshot ID is specified.
+func ResourceEvsVolume() *schema.Resource {
+	volume := evs.ResourceEvsVolume()
+	volume.Schema["size"].AtLeastOneOf = []string{"size", "backup_id", "snapshot_id"}
+	return volume
+}
@@ -0,0 +1,228 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceGesBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGesBackupCreate,
+		ReadContext:   resourceGesBackupRead,
+		DeleteContext: resourceGesBackupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGesBackupImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"graph_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"backup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backup_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGesBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "gesv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating GES client: %s", err)
+	}
+
+	graphID := d.Get("graph_id").(string)
+	createOpts := map[string]interface{}{
+		"name":        valueIgnoreEmpty(d.Get("name")),
+		"description": valueIgnoreEmpty(d.Get("description")),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("graphs", graphID, "backups"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating GES backup: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupID := pathSearch("backup_id", respBody, "").(string)
+	if backupID == "" {
+		return diag.Errorf("unable to find the GES backup ID from the API response")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", graphID, backupID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"backing_up"},
+		Target:       []string{"success"},
+		Refresh:      gesBackupStateRefreshFunc(client, graphID, backupID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the GES backup (%s) to complete: %s", backupID, err)
+	}
+
+	return resourceGesBackupRead(ctx, d, meta)
+}
+
+func getGesBackup(client *golangsdk.ServiceClient, graphID, backupID string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("graphs", graphID, "backups"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	backup := pathSearch(fmt.Sprintf("backup_list[?id=='%s'] | [0]", backupID), respBody, nil)
+	if backup == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return backup, nil
+}
+
+func gesBackupStateRefreshFunc(client *golangsdk.ServiceClient, graphID, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := getGesBackup(client, graphID, backupID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := pathSearch("status", backup, "").(string)
+		if status == "failed" {
+			return backup, status, fmt.Errorf("the GES backup is in failed status")
+		}
+		return backup, status, nil
+	}
+}
+
+func resourceGesBackupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "gesv2", region)
+	if err != nil {
+		return diag.Errorf("error creating GES client: %s", err)
+	}
+
+	graphID := d.Get("graph_id").(string)
+	backupID := strings.TrimPrefix(d.Id(), graphID+"/")
+	backup, err := getGesBackup(client, graphID, backupID)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GES backup")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", backup, nil)),
+		d.Set("description", pathSearch("description", backup, nil)),
+		d.Set("backup_id", backupID),
+		d.Set("status", pathSearch("status", backup, nil)),
+		d.Set("backup_method", pathSearch("backup_method", backup, nil)),
+		d.Set("size", pathSearch("size", backup, nil)),
+		d.Set("start_time", pathSearch("start_time", backup, nil)),
+		d.Set("end_time", pathSearch("end_time", backup, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting GES backup fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGesBackupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "gesv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating GES client: %s", err)
+	}
+
+	deleteURL := client.ServiceURL("graphs", d.Get("graph_id").(string), "backups", d.Get("backup_id").(string))
+	if _, err := client.Request("DELETE", deleteURL, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GES backup")
+	}
+
+	return nil
+}
+
+func resourceGesBackupImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <graph_id>/<backup_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("graph_id", parts[0]),
+		d.Set("backup_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,91 @@
+package sbercloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccGesBackup_basic(t *testing.T) {
+	name := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "sbercloud_ges_backup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGesBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGesBackup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGesBackupExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_id", "sbercloud_ges_graph.graph", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", "success"),
+					resource.TestCheckResourceAttrSet(resourceName, "backup_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGesBackup_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_ges_backup" "test" {
+  graph_id    = sbercloud_ges_graph.graph.id
+  name        = "%s"
+  description = "created by terraform"
+}
+`, testAccGesGraphV1_basic(name), name)
+}
+
+func getGesBackupFromState(rs *terraform.ResourceState) (interface{}, error) {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := NewServiceClient(config, "gesv2", SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GES client: %s", err)
+	}
+
+	graphID := rs.Primary.Attributes["graph_id"]
+	return getGesBackup(client, graphID, strings.TrimPrefix(rs.Primary.ID, graphID+"/"))
+}
+
+func testAccCheckGesBackupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_ges_backup" {
+			continue
+		}
+
+		if _, err := getGesBackupFromState(rs); err == nil {
+			return fmt.Errorf("GES backup (%s) still exists", rs.Primary.ID)
+		} else if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckGesBackupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		_, err := getGesBackupFromState(rs)
+		return err
+	}
+}
@@ -0,0 +1,394 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the status codes of the GES graph
+const (
+	gesGraphStatusCreating = "100"
+	gesGraphStatusRunning  = "200"
+	gesGraphStatusDeleted  = "400"
+)
+
+func gesGraphPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:       schema.TypeList,
+		Computed:   true,
+		Deprecated: "the import paths are no longer returned by the GES v2 API",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"path": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ResourceGesGraph() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGesGraphCreate,
+		ReadContext:   resourceGesGraphRead,
+		DeleteContext: resourceGesGraphDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"graph_size_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"public_access": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_assign", "eip_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bind_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"auto_assign", "bind_existing",
+							}, false),
+						},
+						"eip_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"enable_https": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enable_full_textindex": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"auto_assign": {
+				Type:       schema.TypeBool,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "use public_access instead",
+			},
+			"eip_id": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "use public_access instead",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"management_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"edgeset_path":   gesGraphPathSchema(),
+			"schema_path":    gesGraphPathSchema(),
+			"vertexset_path": gesGraphPathSchema(),
+		},
+	}
+}
+
+// buildGesGraphPublicIP builds the public IP parameters from the public_access block, or from the deprecated
+// auto_assign and eip_id parameters.
+func buildGesGraphPublicIP(d *schema.ResourceData) map[string]interface{} {
+	if v, ok := d.GetOk("public_access"); ok {
+		publicAccess := v.([]interface{})[0].(map[string]interface{})
+		return utils.RemoveNil(map[string]interface{}{
+			"public_bind_type": publicAccess["bind_type"],
+			"eip_id":           valueIgnoreEmpty(publicAccess["eip_id"]),
+		})
+	}
+
+	if d.Get("auto_assign").(bool) {
+		return map[string]interface{}{
+			"public_bind_type": "auto_assign",
+		}
+	}
+	if v, ok := d.GetOk("eip_id"); ok {
+		return map[string]interface{}{
+			"public_bind_type": "bind_existing",
+			"eip_id":           v,
+		}
+	}
+	return nil
+}
+
+func resourceGesGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "gesv2", region)
+	if err != nil {
+		return diag.Errorf("error creating GES client: %s", err)
+	}
+
+	graphOpts := map[string]interface{}{
+		"name":                   d.Get("name"),
+		"region":                 region,
+		"az_code":                d.Get("availability_zone"),
+		"graph_size_type_index":  d.Get("graph_size_type"),
+		"vpc_id":                 d.Get("vpc_id"),
+		"subnet_id":              d.Get("subnet_id"),
+		"security_group_id":      d.Get("security_group_id"),
+		"public_ip":              buildGesGraphPublicIP(d),
+		"enable_https":           d.Get("enable_https"),
+		"enable_full_text_index": d.Get("enable_full_textindex"),
+		"enterprise_project_id":  valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"tags":                   valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	createOpts := map[string]interface{}{
+		"graph": utils.RemoveNil(graphOpts),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("graphs"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error creating GES graph: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the GES graph ID from the API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{gesGraphStatusCreating},
+		Target:       []string{gesGraphStatusRunning},
+		Refresh:      gesGraphStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        60 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the GES graph (%s) to be running: %s", id, err)
+	}
+
+	return resourceGesGraphRead(ctx, d, meta)
+}
+
+func getGesGraph(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("graphs", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := pathSearch("graph", respBody, nil)
+	if graph == nil || pathSearch("status", graph, "").(string) == gesGraphStatusDeleted {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return graph, nil
+}
+
+func gesGraphStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		graph, err := getGesGraph(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("status", graph, "").(string)
+		if status != gesGraphStatusCreating && status != gesGraphStatusRunning {
+			return graph, status, fmt.Errorf("unexpected status (%s) of the GES graph, the action is %s", status,
+				pathSearch("action_progress", graph, ""))
+		}
+		return graph, status, nil
+	}
+}
+
+func flattenGesGraphPaths(paths interface{}) []map[string]interface{} {
+	rawPaths, ok := paths.([]interface{})
+	if !ok || len(rawPaths) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(rawPaths))
+	for i, v := range rawPaths {
+		result[i] = map[string]interface{}{
+			"path":   pathSearch("path", v, nil),
+			"status": pathSearch("status", v, nil),
+		}
+	}
+	return result
+}
+
+func resourceGesGraphRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "gesv2", region)
+	if err != nil {
+		return diag.Errorf("error creating GES client: %s", err)
+	}
+
+	graph, err := getGesGraph(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GES graph")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", graph, nil)),
+		d.Set("availability_zone", pathSearch("az_code", graph, nil)),
+		d.Set("graph_size_type", pathSearch("graph_size_type_index", graph, nil)),
+		d.Set("vpc_id", pathSearch("vpc_id", graph, nil)),
+		d.Set("subnet_id", pathSearch("subnet_id", graph, nil)),
+		d.Set("security_group_id", pathSearch("security_group_id", graph, nil)),
+		d.Set("enable_https", pathSearch("enable_https", graph, nil)),
+		d.Set("enable_full_textindex", pathSearch("enable_full_text_index", graph, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", graph, nil)),
+		d.Set("tags", flattenResponseTags("tags", graph)),
+		d.Set("status", pathSearch("status", graph, nil)),
+		d.Set("private_ip", pathSearch("private_ip", graph, nil)),
+		d.Set("public_ip", pathSearch("public_ip", graph, nil)),
+		d.Set("management_ips", pathSearch("traffic_ip_list", graph, nil)),
+		d.Set("created", pathSearch("created", graph, nil)),
+		d.Set("version", pathSearch("data_store_version", graph, nil)),
+		d.Set("edgeset_path", flattenGesGraphPaths(pathSearch("edgeset_path", graph, nil))),
+		d.Set("schema_path", flattenGesGraphPaths(pathSearch("schema_path", graph, nil))),
+		d.Set("vertexset_path", flattenGesGraphPaths(pathSearch("vertexset_path", graph, nil))),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting GES graph fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGesGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "gesv2", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating GES client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("graphs", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GES graph")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{gesGraphStatusRunning},
+		Target:       []string{"deleted"},
+		Refresh:      gesGraphStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the GES graph (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -22,8 +22,19 @@ func TestAccGesGraphV1_basic(t *testing.T) {
 				Config: testAccGesGraphV1_basic(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGesGraphV1Exists(),
+					resource.TestCheckResourceAttr("sbercloud_ges_graph.graph", "name", name),
+					resource.TestCheckResourceAttr("sbercloud_ges_graph.graph", "graph_size_type", "0"),
+					resource.TestCheckResourceAttr("sbercloud_ges_graph.graph", "enable_https", "true"),
+					resource.TestCheckResourceAttr("sbercloud_ges_graph.graph", "tags.foo", "bar"),
+					resource.TestCheckResourceAttr("sbercloud_ges_graph.graph", "status", "200"),
+					resource.TestCheckResourceAttrSet("sbercloud_ges_graph.graph", "private_ip"),
 				),
 			},
+			{
+				ResourceName:      "sbercloud_ges_graph.graph",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -58,13 +69,18 @@ resource "sbercloud_ges_graph" "graph" {
   security_group_id = sbercloud_networking_secgroup.test.id
   subnet_id         = sbercloud_vpc_subnet.test.id
   vpc_id            = sbercloud_vpc.test.id
+  enable_https      = true
+
+  tags = {
+    foo = "bar"
+  }
 }
 	`, name, name, name, name, SBC_REGION_NAME)
 }
 
 func testAccCheckGesGraphV1Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
-	client, err := config.GesV1Client(SBC_REGION_NAME)
+	client, err := NewServiceClient(config, "gesv2", SBC_REGION_NAME)
 	if err != nil {
 		return fmt.Errorf("Error creating sdk client, err=%s", err)
 	}
@@ -93,7 +109,7 @@ func testAccCheckGesGraphV1Destroy(s *terraform.State) error {
 func testAccCheckGesGraphV1Exists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*config.Config)
-		client, err := config.GesV1Client(SBC_REGION_NAME)
+		client, err := NewServiceClient(config, "gesv2", SBC_REGION_NAME)
 		if err != nil {
 			return fmt.Errorf("Error creating sdk client, err=%s", err)
 		}
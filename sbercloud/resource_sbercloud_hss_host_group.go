@@ -0,0 +1,229 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the enterprise project ID of HSS which represents all authorized enterprise projects
+const hssAllEnterpriseProjects = "all_granted_eps"
+
+func ResourceHssHostGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHssHostGroupCreate,
+		ReadContext:   resourceHssHostGroupRead,
+		UpdateContext: resourceHssHostGroupUpdate,
+		DeleteContext: resourceHssHostGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"host_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"host_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"risk_host_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"unprotect_host_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// hssEnterpriseProjectQuery builds the query of the enterprise project for listing. All authorized enterprise projects
+// are queried if it is not specified, so that the resource can be found after it is imported.
+func hssEnterpriseProjectQuery(d *schema.ResourceData, conf *config.Config) string {
+	epsID := GetEnterpriseProjectID(d, conf)
+	if epsID == "" {
+		epsID = hssAllEnterpriseProjects
+	}
+	return "enterprise_project_id=" + url.QueryEscape(epsID)
+}
+
+// hssActionPath appends the enterprise project to the path of the creation, update and deletion, which only accept the
+// specified enterprise project.
+func hssActionPath(d *schema.ResourceData, conf *config.Config, path string) string {
+	epsID := GetEnterpriseProjectID(d, conf)
+	if epsID == "" {
+		return path
+	}
+
+	if strings.Contains(path, "?") {
+		return path + "&enterprise_project_id=" + url.QueryEscape(epsID)
+	}
+	return path + "?enterprise_project_id=" + url.QueryEscape(epsID)
+}
+
+// findHssHostGroup lists the host groups to find the group by the filter, the API does not support querying a group
+// by its ID.
+func findHssHostGroup(client *golangsdk.ServiceClient, epsQuery string, filter func(interface{}) bool) (interface{}, error) {
+	for offset := 0; ; offset += 200 {
+		listPath := client.ServiceURL("host-management", "groups") +
+			fmt.Sprintf("?limit=200&offset=%d&%s", offset, epsQuery)
+		resp, err := client.Request("GET", listPath, &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		groups := pathSearch("data_list", respBody, make([]interface{}, 0)).([]interface{})
+		for _, group := range groups {
+			if filter(group) {
+				return group, nil
+			}
+		}
+		if len(groups) < 200 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+	}
+}
+
+func resourceHssHostGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "hss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	createPath := hssActionPath(d, conf, client.ServiceURL("host-management", "groups"))
+	createOpts := map[string]interface{}{
+		"group_name":   name,
+		"host_id_list": d.Get("host_ids").(*schema.Set).List(),
+	}
+	_, err = client.Request("POST", createPath, &golangsdk.RequestOpts{
+		JSONBody: createOpts,
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error creating HSS host group: %s", err)
+	}
+
+	// the creation API does not return the group ID, and the group name is unique
+	group, err := findHssHostGroup(client, hssEnterpriseProjectQuery(d, conf), func(group interface{}) bool {
+		return pathSearch("group_name", group, "").(string) == name
+	})
+	if err != nil {
+		return diag.Errorf("error finding the HSS host group (%s) after creation: %s", name, err)
+	}
+	d.SetId(pathSearch("group_id", group, "").(string))
+
+	return resourceHssHostGroupRead(ctx, d, meta)
+}
+
+func resourceHssHostGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "hss", region)
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	group, err := findHssHostGroup(client, hssEnterpriseProjectQuery(d, conf), func(group interface{}) bool {
+		return pathSearch("group_id", group, "").(string) == d.Id()
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving HSS host group")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("group_name", group, nil)),
+		d.Set("host_ids", pathSearch("host_id_list", group, nil)),
+		d.Set("host_num", pathSearch("host_num", group, nil)),
+		d.Set("risk_host_num", pathSearch("risk_host_num", group, nil)),
+		d.Set("unprotect_host_num", pathSearch("unprotect_host_num", group, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting HSS host group fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceHssHostGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "hss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	// the hosts are added and removed by replacing the whole host list of the group
+	updateOpts := map[string]interface{}{
+		"group_id":     d.Id(),
+		"group_name":   d.Get("name"),
+		"host_id_list": d.Get("host_ids").(*schema.Set).List(),
+	}
+	updatePath := hssActionPath(d, conf, client.ServiceURL("host-management", "groups"))
+	_, err = client.Request("PUT", updatePath, &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating HSS host group (%s): %s", d.Id(), err)
+	}
+
+	return resourceHssHostGroupRead(ctx, d, meta)
+}
+
+func resourceHssHostGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "hss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	deletePath := hssActionPath(d, conf, client.ServiceURL("host-management", "groups")+"?group_id="+d.Id())
+	_, err = client.Request("DELETE", deletePath, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting HSS host group")
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the protection version which means that the protection of the host is disabled
+const hssVersionNull = "hss.version.null"
+
+var hssChargingModes = map[string]string{
+	"prePaid":  "packet_cycle",
+	"postPaid": "on_demand",
+}
+
+// ResourceHssHostProtection enables the HSS protection of a host. The protection is disabled when the resource is
+// deleted, and the ID of the resource is the host ID.
+func ResourceHssHostProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHssHostProtectionCreate,
+		ReadContext:   resourceHssHostProtectionRead,
+		UpdateContext: resourceHssHostProtectionUpdate,
+		DeleteContext: resourceHssHostProtectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHssHostProtectionImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"host_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"hss.version.basic", "hss.version.advanced", "hss.version.enterprise", "hss.version.premium",
+					"hss.version.wtp", "hss.version.container.enterprise",
+				}, false),
+			},
+			"charging_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "postPaid",
+				ValidateFunc: validation.StringInSlice([]string{"prePaid", "postPaid"}, false),
+			},
+			"quota_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"host_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func switchHssHostProtection(client *golangsdk.ServiceClient, d *schema.ResourceData, conf *config.Config,
+	version string) error {
+	switchOpts := map[string]interface{}{
+		"version":       version,
+		"charging_mode": hssChargingModes[d.Get("charging_mode").(string)],
+		"resource_id":   valueIgnoreEmpty(d.Get("quota_id")),
+		"host_id_list":  []string{d.Get("host_id").(string)},
+	}
+	switchPath := hssActionPath(d, conf, client.ServiceURL("host-management", "protection"))
+	_, err := client.Request("POST", switchPath, &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(switchOpts),
+		OkCodes:  []int{200},
+	})
+	return err
+}
+
+func resourceHssHostProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "hss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	hostID := d.Get("host_id").(string)
+	if err := switchHssHostProtection(client, d, conf, d.Get("version").(string)); err != nil {
+		return diag.Errorf("error enabling HSS protection of host (%s): %s", hostID, err)
+	}
+	d.SetId(hostID)
+
+	return resourceHssHostProtectionRead(ctx, d, meta)
+}
+
+func resourceHssHostProtectionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "hss", region)
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	getPath := client.ServiceURL("host-management", "hosts") +
+		fmt.Sprintf("?host_id=%s&%s", d.Id(), hssEnterpriseProjectQuery(d, conf))
+	resp, err := client.Request("GET", getPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving HSS host protection")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	host := pathSearch("data_list|[0]", respBody, nil)
+	version := pathSearch("version", host, "").(string)
+	if host == nil || version == "" || version == hssVersionNull {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving HSS host protection")
+	}
+
+	chargingMode := d.Get("charging_mode").(string)
+	for k, v := range hssChargingModes {
+		if v == pathSearch("charging_mode", host, "").(string) {
+			chargingMode = k
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("host_id", pathSearch("host_id", host, nil)),
+		d.Set("version", version),
+		d.Set("charging_mode", chargingMode),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", host, nil)),
+		d.Set("host_name", pathSearch("host_name", host, nil)),
+		d.Set("status", pathSearch("protect_status", host, nil)),
+		d.Set("agent_status", pathSearch("agent_status", host, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting HSS host protection fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceHssHostProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "hss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	if d.HasChange("version") {
+		if err := switchHssHostProtection(client, d, conf, d.Get("version").(string)); err != nil {
+			return diag.Errorf("error switching HSS protection version of host (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceHssHostProtectionRead(ctx, d, meta)
+}
+
+func resourceHssHostProtectionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "hss", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating HSS client: %s", err)
+	}
+
+	if err := switchHssHostProtection(client, d, conf, hssVersionNull); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling HSS host protection")
+	}
+
+	return nil
+}
+
+func resourceHssHostProtectionImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <enterprise_project_id>/<host_id>")
+	}
+
+	d.SetId(parts[1])
+	mErr := multierror.Append(nil,
+		d.Set("enterprise_project_id", parts[0]),
+		d.Set("host_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,268 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+
+	"github.com/chnsz/golangsdk/openstack/identity/v3.0/credentials"
+	"github.com/chnsz/golangsdk/openstack/identity/v3.0/users"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/encryption"
+)
+
+// identityAccessKeyLimit is the maximum number of the access keys which can be owned by an IAM user.
+const identityAccessKeyLimit = 2
+
+func ResourceIdentityAccessKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityAccessKeyCreate,
+		ReadContext:   resourceIdentityAccessKeyRead,
+		UpdateContext: resourceIdentityAccessKeyUpdate,
+		DeleteContext: resourceIdentityAccessKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
+			"secret_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_secret": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_use_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func checkIdentityAccessKeyLimit(d *schema.ResourceData, meta interface{}) error {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return fmt.Errorf("error creating IAM client: %s", err)
+	}
+
+	userID := d.Get("user_id").(string)
+	keys, err := credentials.List(client, credentials.ListOpts{UserID: userID}).Extract()
+	if err != nil {
+		return fmt.Errorf("error querying the access keys of the IAM user (%s): %s", userID, err)
+	}
+	if len(keys) >= identityAccessKeyLimit {
+		ids := make([]string, len(keys))
+		for i, key := range keys {
+			ids[i] = key.AccessKey
+		}
+		return fmt.Errorf("the IAM user (%s) already has %d access keys %v, which is the maximum number of "+
+			"access keys per user, please delete an unused access key first", userID, len(keys), ids)
+	}
+	return nil
+}
+
+func resourceIdentityAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	userID := d.Get("user_id").(string)
+	userInfo, err := users.Get(client, userID).Extract()
+	if err != nil {
+		return diag.Errorf("error fetching IAM user (%s): %s", userID, err)
+	}
+
+	if err := checkIdentityAccessKeyLimit(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := credentials.CreateOpts{
+		UserID:      userID,
+		Description: d.Get("description").(string),
+	}
+	accessKey, err := credentials.Create(client, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating IAM access key: %s", err)
+	}
+	d.SetId(accessKey.AccessKey)
+
+	// the secret key is only returned in the creation response
+	mErr := multierror.Append(nil, d.Set("user_name", userInfo.Name))
+	if v, ok := d.GetOk("pgp_key"); ok {
+		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
+		if err != nil {
+			return diag.Errorf("error retrieving PGP key: %s", err)
+		}
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, accessKey.SecretKey,
+			"IAM Access Key Secret")
+		if err != nil {
+			return diag.Errorf("error encrypting IAM access key: %s", err)
+		}
+		mErr = multierror.Append(mErr,
+			d.Set("key_fingerprint", fingerprint),
+			d.Set("encrypted_secret", encrypted),
+		)
+	} else {
+		mErr = multierror.Append(mErr, d.Set("secret_access_key", accessKey.SecretKey))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM access key fields: %s", err)
+	}
+
+	outputFile := d.Get("secret_file").(string)
+	if outputFile == "" {
+		outputFile = fmt.Sprintf("credentials-%s.csv", userInfo.Name)
+	}
+	if err := writeIdentityAccessKeyFile(outputFile, accessKey); err != nil {
+		if err := d.Set("secret", accessKey.SecretKey); err != nil {
+			return diag.FromErr(err)
+		}
+		return diag.Errorf("error saving the IAM access key to %s: %s", outputFile, err)
+	}
+
+	if d.Get("status").(string) == "inactive" {
+		updateOpts := credentials.UpdateOpts{
+			Status: "inactive",
+		}
+		if _, err := credentials.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("error disabling IAM access key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityAccessKeyRead(ctx, d, meta)
+}
+
+func resourceIdentityAccessKeyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	accessKey, err := credentials.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IAM access key")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("user_id", accessKey.UserID),
+		d.Set("description", accessKey.Description),
+		d.Set("access_key_id", accessKey.AccessKey),
+		d.Set("status", accessKey.Status),
+		d.Set("create_time", accessKey.CreateTime),
+		d.Set("last_use_time", accessKey.LastUseTime),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM access key fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIdentityAccessKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	if d.HasChanges("description", "status") {
+		opts := credentials.UpdateOpts{
+			Description: d.Get("description").(string),
+			Status:      d.Get("status").(string),
+		}
+		if _, err := credentials.Update(client, d.Id(), opts).Extract(); err != nil {
+			return diag.Errorf("error updating IAM access key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityAccessKeyRead(ctx, d, meta)
+}
+
+func resourceIdentityAccessKeyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	if err := credentials.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting IAM access key")
+	}
+
+	return nil
+}
+
+func writeIdentityAccessKeyFile(path string, cred *credentials.Credential) error {
+	csvFile, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer csvFile.Close()
+
+	// write the UTF-8 BOM so that the file can be opened by the spreadsheet applications correctly
+	if _, err := csvFile.WriteString("\xEF\xBB\xBF"); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(csvFile)
+	return writer.WriteAll([][]string{
+		{"User ID", "Access Key ID", "Secret Access Key"},
+		{cred.UserID, cred.AccessKey, cred.SecretKey},
+	})
+}
@@ -0,0 +1,275 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/identity/v3/projects"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceIdentityProject manages a sub-project of a region. The project is disabled by suspending it through the
+// extension API of IAM, and the deletion is only requested when it contains no resources unless force_destroy is set.
+func ResourceIdentityProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityProjectCreate,
+		ReadContext:   resourceIdentityProjectRead,
+		UpdateContext: resourceIdentityProjectUpdate,
+		DeleteContext: resourceIdentityProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// updateIdentityProjectStatus suspends or resumes the project, the v3 API does not accept the enabled status.
+func updateIdentityProjectStatus(client *golangsdk.ServiceClient, id string, enabled bool) error {
+	status := "normal"
+	if !enabled {
+		status = "suspended"
+	}
+	_, err := client.Request("PUT", client.ServiceURL("v3-ext", "projects", id), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"project": map[string]interface{}{"status": status},
+		},
+		OkCodes: []int{204},
+	})
+	return err
+}
+
+func resourceIdentityProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	identityClient, err := conf.IdentityV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	createOpts := projects.CreateOpts{
+		Name:        d.Get("name").(string),
+		ParentID:    d.Get("parent_id").(string),
+		Description: d.Get("description").(string),
+	}
+	project, err := projects.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating IAM project: %s", err)
+	}
+	d.SetId(project.ID)
+
+	if !d.Get("enabled").(bool) {
+		client, err := conf.IAMNoVersionClient(region)
+		if err != nil {
+			return diag.Errorf("error creating IAM client: %s", err)
+		}
+		if err := updateIdentityProjectStatus(client, d.Id(), false); err != nil {
+			return diag.Errorf("error disabling IAM project (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityProjectRead(ctx, d, meta)
+}
+
+func resourceIdentityProjectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMNoVersionClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	// the extension API returns the status of the project in addition to the v3 API
+	getResp, err := client.Request("GET", client.ServiceURL("v3-ext", "projects", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IAM project")
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	project := pathSearch("project", getRespBody, nil)
+
+	enabled := pathSearch("enabled", project, true).(bool) && pathSearch("status", project, "").(string) != "suspended"
+	mErr := multierror.Append(nil,
+		d.Set("name", pathSearch("name", project, nil)),
+		d.Set("description", pathSearch("description", project, nil)),
+		d.Set("parent_id", pathSearch("parent_id", project, nil)),
+		d.Set("enabled", enabled),
+		d.Set("domain_id", pathSearch("domain_id", project, nil)),
+		d.Set("created_at", pathSearch("created_at", project, nil)),
+		d.Set("updated_at", pathSearch("updated_at", project, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM project fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIdentityProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+
+	if d.HasChanges("name", "description") {
+		identityClient, err := conf.IdentityV3Client(region)
+		if err != nil {
+			return diag.Errorf("error creating IAM client: %s", err)
+		}
+
+		updateOpts := projects.UpdateOpts{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		if _, err := projects.Update(identityClient, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("error updating IAM project (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		client, err := conf.IAMNoVersionClient(region)
+		if err != nil {
+			return diag.Errorf("error creating IAM client: %s", err)
+		}
+		if err := updateIdentityProjectStatus(client, d.Id(), d.Get("enabled").(bool)); err != nil {
+			return diag.Errorf("error updating the status of IAM project (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceIdentityProjectRead(ctx, d, meta)
+}
+
+// isProviderProject returns whether the project is the one which the provider is configured with.
+func isProviderProject(conf *config.Config, id, name string) bool {
+	if id == conf.TenantID || name == conf.TenantName {
+		return true
+	}
+	return conf.HwClient != nil && id == conf.HwClient.ProjectID
+}
+
+// countIdentityProjectResources counts the resources of the project which are recorded by the Config service. The
+// resources are queried in the region of the project, which is the prefix of the project name.
+func countIdentityProjectResources(client *golangsdk.ServiceClient, domainID, id, name string) (int, error) {
+	regionID := strings.SplitN(name, "_", 2)[0]
+	basePath := client.ServiceURL("resource-manager", "domains", domainID, "all-resources") +
+		fmt.Sprintf("?region_id=%s&limit=200", regionID)
+
+	var count int
+	listPath := basePath
+	for {
+		resp, err := client.Request("GET", listPath, &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return 0, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return 0, err
+		}
+
+		resources := pathSearch("resources", respBody, make([]interface{}, 0)).([]interface{})
+		for _, r := range resources {
+			if pathSearch("project_id", r, "").(string) == id {
+				count++
+			}
+		}
+
+		marker := pathSearch("page_info.next_marker", respBody, "").(string)
+		if marker == "" {
+			return count, nil
+		}
+		listPath = basePath + fmt.Sprintf("&marker=%s", marker)
+	}
+}
+
+func resourceIdentityProjectDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	name := d.Get("name").(string)
+
+	if isProviderProject(conf, d.Id(), name) {
+		return diag.Errorf("IAM project (%s) is the project of the provider, it can not be deleted", d.Id())
+	}
+
+	if !d.Get("force_destroy").(bool) {
+		rmsClient, err := NewServiceClient(conf, "rms", region)
+		if err != nil {
+			return diag.Errorf("error creating RMS client: %s", err)
+		}
+		count, err := countIdentityProjectResources(rmsClient, conf.DomainID, d.Id(), name)
+		if err != nil {
+			return diag.Errorf("error retrieving the resources of IAM project (%s): %s", d.Id(), err)
+		}
+		if count > 0 {
+			return diag.Errorf("IAM project (%s) still contains %d resources, delete them first or set "+
+				"force_destroy to true", d.Id(), count)
+		}
+	}
+
+	identityClient, err := conf.IdentityV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+	if err := projects.Delete(identityClient, d.Id()).ExtractErr(); err != nil {
+		// the API returns 404 when deleting projects is not supported
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary: "Deleting projects is not supported. The project is only removed from the state, but " +
+						"it remains in the cloud.",
+				},
+			}
+		}
+		return diag.Errorf("error deleting IAM project (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceIdentityRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityRoleCreate,
+		ReadContext:   resourceIdentityRoleRead,
+		UpdateContext: resourceIdentityRoleUpdate,
+		DeleteContext: resourceIdentityRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AX", "XA"}, false),
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIdentityRolePolicy,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
+					return equal
+				},
+			},
+			"catalog": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"references": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateIdentityRolePolicy checks the policy document at plan time, the document must be a JSON object which
+// contains the Version and Statement keys.
+func validateIdentityRolePolicy(v interface{}, k string) (ws []string, errors []error) {
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &policy); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+		return
+	}
+	for _, key := range []string{"Version", "Statement"} {
+		if _, ok := policy[key]; !ok {
+			errors = append(errors, fmt.Errorf("%q must contain the %s key", k, key))
+		}
+	}
+	return
+}
+
+func buildIdentityRoleBodyParams(d *schema.ResourceData) (map[string]interface{}, error) {
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("policy").(string)), &policy); err != nil {
+		return nil, fmt.Errorf("error unmarshalling policy, please check the format of the policy document: %s", err)
+	}
+
+	return map[string]interface{}{
+		"role": map[string]interface{}{
+			"display_name": d.Get("name"),
+			"description":  d.Get("description"),
+			"type":         d.Get("type"),
+			"policy":       policy,
+		},
+	}, nil
+}
+
+func resourceIdentityRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	createOpts, err := buildIdentityRoleBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.Request("POST", client.ServiceURL("OS-ROLE", "roles"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+		OkCodes:          []int{201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating IAM custom role: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("role.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the IAM custom role ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceIdentityRoleRead(ctx, d, meta)
+}
+
+func resourceIdentityRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("OS-ROLE", "roles", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IAM custom role")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role := pathSearch("role", respBody, nil)
+	policy, err := json.Marshal(pathSearch("policy", role, nil))
+	if err != nil {
+		return diag.Errorf("error marshalling policy: %s", err)
+	}
+
+	displayName := pathSearch("display_name", role, nil)
+	mErr := multierror.Append(nil,
+		d.Set("name", displayName),
+		d.Set("display_name", displayName),
+		d.Set("description", pathSearch("description", role, nil)),
+		d.Set("type", pathSearch("type", role, nil)),
+		d.Set("policy", string(policy)),
+		d.Set("catalog", pathSearch("catalog", role, nil)),
+		d.Set("flag", pathSearch("flag", role, nil)),
+		d.Set("references", pathSearch("references", role, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IAM custom role fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIdentityRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	updateOpts, err := buildIdentityRoleBodyParams(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the custom policy is modified by the PATCH method with the full policy document
+	_, err = client.Request("PATCH", client.ServiceURL("OS-ROLE", "roles", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating IAM custom role (%s): %s", d.Id(), err)
+	}
+
+	return resourceIdentityRoleRead(ctx, d, meta)
+}
+
+func resourceIdentityRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.IAMV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IAM client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("OS-ROLE", "roles", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting IAM custom role")
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceIdentityRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdentityRoleAssignmentCreate,
+		ReadContext:   resourceIdentityRoleAssignmentRead,
+		DeleteContext: resourceIdentityRoleAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIdentityRoleAssignmentImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"project_id", "enterprise_project_id"},
+				AtLeastOneOf:  []string{"domain_id", "project_id", "enterprise_project_id"},
+			},
+			"project_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"domain_id", "enterprise_project_id"},
+			},
+			"enterprise_project_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"domain_id", "project_id"},
+			},
+		},
+	}
+}
+
+// Role assignments have no ID, the ID is built in the format <domain_id>/<project_id>/<group_id>/<role_id>, and
+// the enterprise project ID is appended as the fifth part for the enterprise project level assignments.
+func buildIdentityRoleAssignmentID(d *schema.ResourceData) string {
+	id := fmt.Sprintf("%s/%s/%s/%s", d.Get("domain_id"), d.Get("project_id"), d.Get("group_id"), d.Get("role_id"))
+	if v, ok := d.GetOk("enterprise_project_id"); ok {
+		id = fmt.Sprintf("%s/%s", id, v)
+	}
+	return id
+}
+
+// identityRoleAssignmentURL returns the URL of the assignment, the enterprise project level assignments are managed
+// by the IAM v3.0 API, the others are managed by the Keystone compatible v3 API.
+func identityRoleAssignmentURL(conf *config.Config, d *schema.ResourceData) (*golangsdk.ServiceClient, string, error) {
+	region := GetRegion(d, conf)
+	groupID := d.Get("group_id").(string)
+	roleID := d.Get("role_id").(string)
+
+	if epsID := d.Get("enterprise_project_id").(string); epsID != "" {
+		client, err := conf.IAMV3Client(region)
+		if err != nil {
+			return nil, "", fmt.Errorf("error creating IAM client: %s", err)
+		}
+		return client, client.ServiceURL("OS-PERMISSION", "enterprise-projects", epsID, "groups", groupID,
+			"roles", roleID), nil
+	}
+
+	client, err := conf.IdentityV3Client(region)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating identity client: %s", err)
+	}
+	if projectID := d.Get("project_id").(string); projectID != "" {
+		return client, client.ServiceURL("projects", projectID, "groups", groupID, "roles", roleID), nil
+	}
+	return client, client.ServiceURL("domains", d.Get("domain_id").(string), "groups", groupID, "roles", roleID), nil
+}
+
+func resourceIdentityRoleAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, url, err := identityRoleAssignmentURL(meta.(*config.Config), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Request("PUT", url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return diag.Errorf("error assigning IAM role: %s", err)
+	}
+	d.SetId(buildIdentityRoleAssignmentID(d))
+
+	return resourceIdentityRoleAssignmentRead(ctx, d, meta)
+}
+
+// checkIdentityEnterpriseProjectRoleAssignment returns a 404 error when the role is not assigned to the group in
+// the enterprise project, because the v3.0 API does not support checking a single assignment.
+func checkIdentityEnterpriseProjectRoleAssignment(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	listURL := client.ServiceURL("OS-PERMISSION", "enterprise-projects", d.Get("enterprise_project_id").(string),
+		"groups", d.Get("group_id").(string), "roles")
+	resp, err := client.Request("GET", listURL, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	roleID := d.Get("role_id").(string)
+	if pathSearch(fmt.Sprintf("roles[?id=='%s'] | [0]", roleID), respBody, nil) == nil {
+		return golangsdk.ErrDefault404{}
+	}
+	return nil
+}
+
+func resourceIdentityRoleAssignmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, url, err := identityRoleAssignmentURL(meta.(*config.Config), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the assignment may be removed outside of Terraform, so its existence is checked every time
+	if d.Get("enterprise_project_id").(string) != "" {
+		err = checkIdentityEnterpriseProjectRoleAssignment(client, d)
+	} else {
+		_, err = client.Request("HEAD", url, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+	}
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IAM role assignment")
+	}
+
+	return nil
+}
+
+func resourceIdentityRoleAssignmentDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, url, err := identityRoleAssignmentURL(meta.(*config.Config), d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Request("DELETE", url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error unassigning IAM role")
+	}
+
+	return nil
+}
+
+func resourceIdentityRoleAssignmentImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 && len(parts) != 5 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be " +
+			"<domain_id>/<project_id>/<group_id>/<role_id> or <domain_id>/<project_id>/<group_id>/<role_id>/" +
+			"<enterprise_project_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("domain_id", parts[0]),
+		d.Set("project_id", parts[1]),
+		d.Set("group_id", parts[2]),
+		d.Set("role_id", parts[3]),
+	)
+	if len(parts) == 5 {
+		mErr = multierror.Append(mErr, d.Set("enterprise_project_id", parts[4]))
+	}
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
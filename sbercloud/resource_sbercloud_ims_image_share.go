@@ -0,0 +1,223 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceImsImageShare shares an image with the projects of other accounts. The invitations stay pending until the
+// receivers accept or reject them, see ResourceImsImageShareAccepter.
+func ResourceImsImageShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImsImageShareCreate,
+		ReadContext:   resourceImsImageShareRead,
+		UpdateContext: resourceImsImageShareUpdate,
+		DeleteContext: resourceImsImageShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"projects": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func imsJobStateRefreshFunc(client *golangsdk.ServiceClient, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Request("GET", client.ServiceURL(client.ProjectID, "jobs", jobID),
+			&golangsdk.RequestOpts{
+				KeepResponseBody: true,
+			})
+		if err != nil {
+			return nil, "", err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := pathSearch("status", respBody, "").(string)
+		if status == "FAIL" {
+			return respBody, status, fmt.Errorf("the job (%s) failed: %s", jobID,
+				pathSearch("fail_reason", respBody, ""))
+		}
+		return respBody, status, nil
+	}
+}
+
+// doImsImageMemberRequest sends a request of the image member API of IMS v1 and waits for the asynchronous job.
+func doImsImageMemberRequest(ctx context.Context, client *golangsdk.ServiceClient, method string,
+	body map[string]interface{}, timeout time.Duration) error {
+	resp, err := client.Request(method, client.ServiceURL("cloudimages", "members"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         body,
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+	jobID := pathSearch("job_id", respBody, "").(string)
+	if jobID == "" {
+		return fmt.Errorf("unable to find the job ID from the API response")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"INIT", "RUNNING"},
+		Target:       []string{"SUCCESS"},
+		Refresh:      imsJobStateRefreshFunc(client, jobID),
+		Timeout:      timeout,
+		Delay:        2 * time.Second,
+		PollInterval: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func updateImsImageShareProjects(ctx context.Context, client *golangsdk.ServiceClient, method, imageID string,
+	projects []string, timeout time.Duration) error {
+	body := map[string]interface{}{
+		"images":   []string{imageID},
+		"projects": projects,
+	}
+	return doImsImageMemberRequest(ctx, client, method, body, timeout)
+}
+
+func resourceImsImageShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "imsv1", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IMS v1 client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	projects := utils.ExpandToStringListBySet(d.Get("projects").(*schema.Set))
+	err = updateImsImageShareProjects(ctx, client, "POST", imageID, projects, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error sharing IMS image (%s): %s", imageID, err)
+	}
+	d.SetId(imageID)
+
+	return resourceImsImageShareRead(ctx, d, meta)
+}
+
+func resourceImsImageShareRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ImageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating IMS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("images", d.Id(), "members"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IMS image members")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the members of all the statuses are kept, the pending and rejected invitations are still shared
+	projects := pathSearch("members[*].member_id", respBody, []interface{}{})
+	if len(projects.([]interface{})) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("image_id", d.Id()),
+		d.Set("projects", projects),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IMS image share fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceImsImageShareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "imsv1", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IMS v1 client: %s", err)
+	}
+
+	oldRaw, newRaw := d.GetChange("projects")
+	oldProjects, newProjects := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if removed := oldProjects.Difference(newProjects); removed.Len() > 0 {
+		err := updateImsImageShareProjects(ctx, client, "DELETE", d.Id(),
+			utils.ExpandToStringListBySet(removed), timeout)
+		if err != nil {
+			return diag.Errorf("error unsharing IMS image (%s): %s", d.Id(), err)
+		}
+	}
+	if added := newProjects.Difference(oldProjects); added.Len() > 0 {
+		err := updateImsImageShareProjects(ctx, client, "POST", d.Id(), utils.ExpandToStringListBySet(added),
+			timeout)
+		if err != nil {
+			return diag.Errorf("error sharing IMS image (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceImsImageShareRead(ctx, d, meta)
+}
+
+func resourceImsImageShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "imsv1", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating IMS v1 client: %s", err)
+	}
+
+	projects := utils.ExpandToStringListBySet(d.Get("projects").(*schema.Set))
+	err = updateImsImageShareProjects(ctx, client, "DELETE", d.Id(), projects, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error unsharing IMS image (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
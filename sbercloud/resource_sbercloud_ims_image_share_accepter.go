@@ -0,0 +1,134 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceImsImageShareAccepter accepts an image shared with the current project, and rejects it on deletion.
+func ResourceImsImageShareAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImsImageShareAccepterCreate,
+		ReadContext:   resourceImsImageShareAccepterRead,
+		DeleteContext: resourceImsImageShareAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// the CBR vault which stores the accepted full-ECS image
+			"vault_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func updateImsImageShareStatus(ctx context.Context, d *schema.ResourceData, conf *config.Config, status string,
+	timeout time.Duration) error {
+	client, err := NewServiceClient(conf, "imsv1", GetRegion(d, conf))
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"images":     []string{d.Get("image_id").(string)},
+		"project_id": client.ProjectID,
+		"status":     status,
+		"vault_id":   valueIgnoreEmpty(d.Get("vault_id")),
+	}
+	return doImsImageMemberRequest(ctx, client, "PUT", utils.RemoveNil(body), timeout)
+}
+
+func resourceImsImageShareAccepterCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	imageID := d.Get("image_id").(string)
+	if err := updateImsImageShareStatus(ctx, d, conf, "accepted", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error accepting the shared IMS image (%s): %s", imageID, err)
+	}
+	d.SetId(imageID)
+
+	return resourceImsImageShareAccepterRead(ctx, d, meta)
+}
+
+func resourceImsImageShareAccepterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ImageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating IMS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("images", d.Id(), "members", client.ProjectID),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the shared IMS image")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the share has been revoked by the owner or rejected by others, it's not accepted any more
+	status := pathSearch("status", respBody, "").(string)
+	if status != "accepted" {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("image_id", d.Id()),
+		d.Set("status", status),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IMS image share accepter fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceImsImageShareAccepterDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	if err := updateImsImageShareStatus(ctx, d, conf, "rejected", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return common.CheckDeletedDiag(d, err, "error rejecting the shared IMS image")
+	}
+
+	return nil
+}
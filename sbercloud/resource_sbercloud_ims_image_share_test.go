@@ -0,0 +1,142 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func TestAccImsImageShare_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_ims_image_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckImsShareProject(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImsImageShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImsImageShare_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImsImageShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "sbercloud_images_image.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "projects.*", SBC_IMS_SHARE_PROJECT_ID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccImsImageShareAccepter_basic(t *testing.T) {
+	resourceName := "sbercloud_ims_image_share_accepter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckImsSharedImage(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImsImageShareAccepter_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_id", SBC_IMS_SHARED_IMAGE_ID),
+					resource.TestCheckResourceAttr(resourceName, "status", "accepted"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func getImsImageMembers(imageID string) ([]interface{}, error) {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.ImageV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating Sbercloud IMS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("images", imageID, "members"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return pathSearch("members", respBody, []interface{}{}).([]interface{}), nil
+}
+
+func testAccCheckImsImageShareDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_ims_image_share" {
+			continue
+		}
+
+		members, err := getImsImageMembers(rs.Primary.ID)
+		if err == nil && len(members) > 0 {
+			return fmt.Errorf("IMS image %s is still shared", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImsImageShareExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		members, err := getImsImageMembers(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(members) == 0 {
+			return fmt.Errorf("IMS image %s is not shared", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccImsImageShare_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_ims_image_share" "test" {
+  image_id = sbercloud_images_image.test.id
+  projects = ["%s"]
+}
+`, testAccImsImage_basic(rName), SBC_IMS_SHARE_PROJECT_ID)
+}
+
+func testAccImsImageShareAccepter_basic() string {
+	return fmt.Sprintf(`
+resource "sbercloud_ims_image_share_accepter" "test" {
+  image_id = "%s"
+}
+`, SBC_IMS_SHARED_IMAGE_ID)
+}
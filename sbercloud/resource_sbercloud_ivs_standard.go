@@ -0,0 +1,179 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceIvsStandard submits an identity verification request. Each request consumes the quota of IVS, so the
+// result is only fetched by Create and kept in the state, the refreshes do not call the API again.
+func ResourceIvsStandard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIvsStandardCreate,
+		ReadContext:   resourceIvsStandardRead,
+		DeleteContext: resourceIvsStandardDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"id_card_front_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"id_card_back_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"face_image_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// any change of the value submits the verification request again
+			"force_refresh": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pass": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"comparison_result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenIvsStandardComparisonResult(respData interface{}) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"code":    int(pathSearch("verification_code", respData, float64(0)).(float64)),
+			"result":  pathSearch("verification_result", respData, nil),
+			"message": pathSearch("verification_message", respData, nil),
+		},
+	}
+}
+
+func resourceIvsStandardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "ivs", region)
+	if err != nil {
+		return diag.Errorf("error creating IVS client: %s", err)
+	}
+
+	epsID := GetEnterpriseProjectID(d, conf)
+	requestID := resource.UniqueId()
+	reqData := map[string]interface{}{
+		"verification_name": d.Get("name"),
+		"idcard_image1":     d.Get("id_card_front_url"),
+		"idcard_image2":     valueIgnoreEmpty(d.Get("id_card_back_url")),
+		"face_image":        d.Get("face_image_url"),
+	}
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"meta": map[string]interface{}{
+				"uuid": requestID,
+			},
+			"data": map[string]interface{}{
+				"req_data": []interface{}{utils.RemoveNil(reqData)},
+			},
+		},
+		OkCodes: []int{200},
+	}
+	if epsID != "" {
+		opts.MoreHeaders = map[string]string{
+			"Enterprise-Project-Id": epsID,
+		}
+	}
+	resp, err := client.Request("POST", client.ServiceURL("ivs-standard"), &opts)
+	if err != nil {
+		return diag.Errorf("error submitting IVS standard verification: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respData := pathSearch("result.resp_data|[0]", respBody, nil)
+	if respData == nil {
+		return diag.Errorf("unable to find the verification result from the API response")
+	}
+	// the similarity is a number in string form
+	score, _ := strconv.ParseFloat(fmt.Sprint(pathSearch("similarity", respData, "")), 64)
+
+	d.SetId(requestID)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("enterprise_project_id", epsID),
+		d.Set("pass", pathSearch("verification_result", respData, "").(string) == "valid"),
+		d.Set("score", score),
+		d.Set("comparison_result", flattenIvsStandardComparisonResult(respData)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting IVS standard verification fields: %s", err)
+	}
+
+	return resourceIvsStandardRead(ctx, d, meta)
+}
+
+func resourceIvsStandardRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// the result is kept in the state, calling the API again would consume the quota
+	return nil
+}
+
+func resourceIvsStandardDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,456 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceL7PolicyV3 manages the forwarding policies through the ELB v3 API, which also serves the listeners created
+// by the v2 API, so the policies created by the former v2 resource are kept.
+func ResourceL7PolicyV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceL7PolicyV3Create,
+		ReadContext:   resourceL7PolicyV3Read,
+		UpdateContext: resourceL7PolicyV3Update,
+		DeleteContext: resourceL7PolicyV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER", "REDIRECT_TO_URL", "FIXED_RESPONSE",
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"redirect_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_url_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"301", "302", "303", "307", "308"}, false),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "${protocol}"}, false),
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"fixed_response_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"text/plain", "text/css", "text/html", "application/javascript", "application/json",
+							}, false),
+						},
+						"message_body": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tenant_id": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				ForceNew:   true,
+				Deprecated: "tenant_id is deprecated",
+			},
+			"admin_state_up": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+				ValidateFunc: utils.ValidateTrueOnly,
+			},
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// checkL7PolicyV3Action checks that the redirection target matches the action of the policy.
+func checkL7PolicyV3Action(d *schema.ResourceData) error {
+	targets := map[string]string{
+		"REDIRECT_TO_POOL":     "redirect_pool_id",
+		"REDIRECT_TO_LISTENER": "redirect_listener_id",
+		"REDIRECT_TO_URL":      "redirect_url_config",
+		"FIXED_RESPONSE":       "fixed_response_config",
+	}
+
+	action := d.Get("action").(string)
+	for targetAction, field := range targets {
+		_, ok := d.GetOk(field)
+		if targetAction == action && !ok {
+			return fmt.Errorf("%s is required when the action is %s", field, action)
+		}
+		if targetAction != action && ok {
+			return fmt.Errorf("%s can't be specified when the action is %s", field, action)
+		}
+	}
+	return nil
+}
+
+func buildL7PolicyRedirectURLConfig(rawConfigs []interface{}) map[string]interface{} {
+	if len(rawConfigs) == 0 || rawConfigs[0] == nil {
+		return nil
+	}
+
+	raw := rawConfigs[0].(map[string]interface{})
+	return utils.RemoveNil(map[string]interface{}{
+		"status_code": raw["status_code"],
+		"protocol":    valueIgnoreEmpty(raw["protocol"]),
+		"host":        valueIgnoreEmpty(raw["host"]),
+		"port":        valueIgnoreEmpty(raw["port"]),
+		"path":        valueIgnoreEmpty(raw["path"]),
+		"query":       valueIgnoreEmpty(raw["query"]),
+	})
+}
+
+func buildL7PolicyFixedResponseConfig(rawConfigs []interface{}) map[string]interface{} {
+	if len(rawConfigs) == 0 || rawConfigs[0] == nil {
+		return nil
+	}
+
+	raw := rawConfigs[0].(map[string]interface{})
+	return utils.RemoveNil(map[string]interface{}{
+		"status_code":  raw["status_code"],
+		"content_type": valueIgnoreEmpty(raw["content_type"]),
+		"message_body": valueIgnoreEmpty(raw["message_body"]),
+	})
+}
+
+func getL7PolicyV3(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("elb", "l7policies", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return pathSearch("l7policy", respBody, nil), nil
+}
+
+func l7PolicyV3StateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		policy, err := getL7PolicyV3(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("provisioning_status", policy, "").(string)
+		if status == "ERROR" {
+			return policy, status, fmt.Errorf("the L7 policy is in ERROR status")
+		}
+		return policy, status, nil
+	}
+}
+
+func waitForL7PolicyV3(ctx context.Context, client *golangsdk.ServiceClient, id, target string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING_CREATE", "PENDING_UPDATE", "PENDING_DELETE", "ACTIVE"},
+		Target:       []string{target},
+		Refresh:      l7PolicyV3StateRefreshFunc(client, id),
+		Timeout:      timeout,
+		Delay:        3 * time.Second,
+		PollInterval: 3 * time.Second,
+	}
+	if target == "ACTIVE" {
+		stateConf.Pending = []string{"PENDING_CREATE", "PENDING_UPDATE"}
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceL7PolicyV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	if err := checkL7PolicyV3Action(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyOpts := map[string]interface{}{
+		"listener_id":           d.Get("listener_id"),
+		"action":                d.Get("action"),
+		"name":                  valueIgnoreEmpty(d.Get("name")),
+		"description":           valueIgnoreEmpty(d.Get("description")),
+		"priority":              valueIgnoreEmpty(d.Get("priority")),
+		"position":              valueIgnoreEmpty(d.Get("position")),
+		"redirect_pool_id":      valueIgnoreEmpty(d.Get("redirect_pool_id")),
+		"redirect_listener_id":  valueIgnoreEmpty(d.Get("redirect_listener_id")),
+		"redirect_url_config":   buildL7PolicyRedirectURLConfig(d.Get("redirect_url_config").([]interface{})),
+		"fixed_response_config": buildL7PolicyFixedResponseConfig(d.Get("fixed_response_config").([]interface{})),
+		"admin_state_up":        d.Get("admin_state_up"),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("elb", "l7policies"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"l7policy": utils.RemoveNil(policyOpts),
+		},
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating L7 policy: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("l7policy.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the L7 policy ID from the API response")
+	}
+	d.SetId(id)
+
+	if err := waitForL7PolicyV3(ctx, client, id, "ACTIVE", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the L7 policy (%s) to become ACTIVE: %s", id, err)
+	}
+
+	return resourceL7PolicyV3Read(ctx, d, meta)
+}
+
+func flattenL7PolicyRedirectURLConfig(policy interface{}) []map[string]interface{} {
+	urlConfig := pathSearch("redirect_url_config", policy, nil)
+	if urlConfig == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"status_code": pathSearch("status_code", urlConfig, nil),
+			"protocol":    pathSearch("protocol", urlConfig, nil),
+			"host":        pathSearch("host", urlConfig, nil),
+			"port":        pathSearch("port", urlConfig, nil),
+			"path":        pathSearch("path", urlConfig, nil),
+			"query":       pathSearch("query", urlConfig, nil),
+		},
+	}
+}
+
+func flattenL7PolicyFixedResponseConfig(policy interface{}) []map[string]interface{} {
+	responseConfig := pathSearch("fixed_response_config", policy, nil)
+	if responseConfig == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"status_code":  pathSearch("status_code", responseConfig, nil),
+			"content_type": pathSearch("content_type", responseConfig, nil),
+			"message_body": pathSearch("message_body", responseConfig, nil),
+		},
+	}
+}
+
+func resourceL7PolicyV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ElbV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	policy, err := getL7PolicyV3(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving L7 policy")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("listener_id", pathSearch("listener_id", policy, nil)),
+		d.Set("action", pathSearch("action", policy, nil)),
+		d.Set("name", pathSearch("name", policy, nil)),
+		d.Set("description", pathSearch("description", policy, nil)),
+		d.Set("priority", pathSearch("priority", policy, nil)),
+		d.Set("position", pathSearch("position", policy, nil)),
+		d.Set("redirect_pool_id", pathSearch("redirect_pool_id", policy, nil)),
+		d.Set("redirect_listener_id", pathSearch("redirect_listener_id", policy, nil)),
+		d.Set("redirect_url_config", flattenL7PolicyRedirectURLConfig(policy)),
+		d.Set("fixed_response_config", flattenL7PolicyFixedResponseConfig(policy)),
+		d.Set("tenant_id", pathSearch("project_id", policy, nil)),
+		d.Set("admin_state_up", pathSearch("admin_state_up", policy, nil)),
+		d.Set("provisioning_status", pathSearch("provisioning_status", policy, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting L7 policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceL7PolicyV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	if err := checkL7PolicyV3Action(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"priority":    valueIgnoreEmpty(d.Get("priority")),
+	}
+	switch d.Get("action").(string) {
+	case "REDIRECT_TO_POOL":
+		policyOpts["redirect_pool_id"] = d.Get("redirect_pool_id")
+	case "REDIRECT_TO_LISTENER":
+		policyOpts["redirect_listener_id"] = d.Get("redirect_listener_id")
+	case "REDIRECT_TO_URL":
+		policyOpts["redirect_url_config"] = buildL7PolicyRedirectURLConfig(d.Get("redirect_url_config").([]interface{}))
+	case "FIXED_RESPONSE":
+		policyOpts["fixed_response_config"] = buildL7PolicyFixedResponseConfig(
+			d.Get("fixed_response_config").([]interface{}))
+	}
+	_, err = client.Request("PUT", client.ServiceURL("elb", "l7policies", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"l7policy": utils.RemoveNil(policyOpts),
+		},
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating L7 policy (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForL7PolicyV3(ctx, client, d.Id(), "ACTIVE", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for the L7 policy (%s) to become ACTIVE: %s", d.Id(), err)
+	}
+
+	return resourceL7PolicyV3Read(ctx, d, meta)
+}
+
+func resourceL7PolicyV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("elb", "l7policies", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting L7 policy")
+	}
+
+	if err := waitForL7PolicyV3(ctx, client, d.Id(), "DELETED", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the L7 policy (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
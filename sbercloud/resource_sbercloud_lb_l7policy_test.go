@@ -39,6 +39,46 @@ func TestAccLBV2L7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2L7Policy_fixedResponse(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_lb_l7policy.l7policy_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLBV2L7PolicyConfig_fixedResponse(rName, "503", "Under maintenance"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(resourceName, &l7Policy),
+					resource.TestCheckResourceAttr(resourceName, "action", "FIXED_RESPONSE"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.status_code", "503"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.message_body",
+						"Under maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccCheckLBV2L7PolicyConfig_fixedResponse(rName, "404", "Not found"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists(resourceName, &l7Policy),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.status_code", "404"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.message_body", "Not found"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
 	lbClient, err := config.ElbV2Client(SBC_REGION_NAME)
@@ -127,3 +167,36 @@ resource "sbercloud_lb_l7policy" "l7policy_1" {
 }
 `, rName, rName, rName, rName)
 }
+
+func testAccCheckLBV2L7PolicyConfig_fixedResponse(rName, statusCode, body string) string {
+	return fmt.Sprintf(`
+data "sbercloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "sbercloud_lb_loadbalancer" "loadbalancer_1" {
+  name          = "%[1]s"
+  vip_subnet_id = data.sbercloud_vpc_subnet.test.subnet_id
+}
+
+resource "sbercloud_lb_listener" "listener_1" {
+  name            = "%[1]s"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = sbercloud_lb_loadbalancer.loadbalancer_1.id
+}
+
+resource "sbercloud_lb_l7policy" "l7policy_1" {
+  name        = "%[1]s"
+  action      = "FIXED_RESPONSE"
+  priority    = 10
+  listener_id = sbercloud_lb_listener.listener_1.id
+
+  fixed_response_config {
+    status_code  = "%[2]s"
+    content_type = "text/plain"
+    message_body = "%[3]s"
+  }
+}
+`, rName, statusCode, body)
+}
@@ -0,0 +1,339 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceL7RuleV3 manages the forwarding rules of the policies through the ELB v3 API.
+func ResourceL7RuleV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceL7RuleV3Create,
+		ReadContext:   resourceL7RuleV3Read,
+		UpdateContext: resourceL7RuleV3Update,
+		DeleteContext: resourceL7RuleV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceL7RuleV3ImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"l7policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"HOST_NAME", "PATH", "METHOD", "HEADER", "QUERY_STRING", "SOURCE_IP",
+				}, false),
+			},
+			"compare_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EQUAL_TO", "REGEX", "STARTS_WITH"}, false),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			// the name of the header or the query parameter to be matched
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				ForceNew:   true,
+				Deprecated: "tenant_id is deprecated",
+			},
+			"admin_state_up": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      true,
+				ValidateFunc: utils.ValidateTrueOnly,
+			},
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildL7RuleV3Conditions builds the matching conditions of the rule. The HEADER and QUERY_STRING rules are matched
+// by key-value pairs, which are only accepted in conditions.
+func buildL7RuleV3Conditions(d *schema.ResourceData) []map[string]interface{} {
+	ruleType := d.Get("type").(string)
+	if ruleType != "HEADER" && ruleType != "QUERY_STRING" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"key":   d.Get("key"),
+			"value": d.Get("value"),
+		},
+	}
+}
+
+func checkL7RuleV3Key(d *schema.ResourceData) error {
+	ruleType := d.Get("type").(string)
+	key := d.Get("key").(string)
+	if (ruleType == "HEADER" || ruleType == "QUERY_STRING") && key == "" {
+		return fmt.Errorf("key is required when the type is %s", ruleType)
+	}
+	if ruleType != "HEADER" && ruleType != "QUERY_STRING" && key != "" {
+		return fmt.Errorf("key can't be specified when the type is %s", ruleType)
+	}
+	return nil
+}
+
+func getL7RuleV3(client *golangsdk.ServiceClient, policyID, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("elb", "l7policies", policyID, "rules", id),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return pathSearch("rule", respBody, nil), nil
+}
+
+func l7RuleV3StateRefreshFunc(client *golangsdk.ServiceClient, policyID, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := getL7RuleV3(client, policyID, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("provisioning_status", rule, "").(string)
+		if status == "ERROR" {
+			return rule, status, fmt.Errorf("the L7 rule is in ERROR status")
+		}
+		return rule, status, nil
+	}
+}
+
+func waitForL7RuleV3(ctx context.Context, client *golangsdk.ServiceClient, policyID, id, target string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING_CREATE", "PENDING_UPDATE", "PENDING_DELETE", "ACTIVE"},
+		Target:       []string{target},
+		Refresh:      l7RuleV3StateRefreshFunc(client, policyID, id),
+		Timeout:      timeout,
+		Delay:        3 * time.Second,
+		PollInterval: 3 * time.Second,
+	}
+	if target == "ACTIVE" {
+		stateConf.Pending = []string{"PENDING_CREATE", "PENDING_UPDATE"}
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceL7RuleV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	if err := checkL7RuleV3Key(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyID := d.Get("l7policy_id").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	// the rules can't be added while the policy is being created or updated
+	if err := waitForL7PolicyV3(ctx, client, policyID, "ACTIVE", timeout); err != nil {
+		return diag.Errorf("error waiting for the L7 policy (%s) to become ACTIVE: %s", policyID, err)
+	}
+
+	ruleOpts := map[string]interface{}{
+		"type":           d.Get("type"),
+		"compare_type":   d.Get("compare_type"),
+		"value":          d.Get("value"),
+		"conditions":     buildL7RuleV3Conditions(d),
+		"admin_state_up": d.Get("admin_state_up"),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("elb", "l7policies", policyID, "rules"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody: map[string]interface{}{
+				"rule": utils.RemoveNil(ruleOpts),
+			},
+			OkCodes: []int{201},
+		})
+	if err != nil {
+		return diag.Errorf("error creating L7 rule: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("rule.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the L7 rule ID from the API response")
+	}
+	d.SetId(id)
+
+	if err := waitForL7RuleV3(ctx, client, policyID, id, "ACTIVE", timeout); err != nil {
+		return diag.Errorf("error waiting for the L7 rule (%s) to become ACTIVE: %s", id, err)
+	}
+
+	return resourceL7RuleV3Read(ctx, d, meta)
+}
+
+func resourceL7RuleV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ElbV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	policyID := d.Get("l7policy_id").(string)
+	rule, err := getL7RuleV3(client, policyID, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving L7 rule")
+	}
+
+	policy, err := getL7PolicyV3(client, policyID)
+	if err != nil {
+		return diag.Errorf("error retrieving the L7 policy (%s) of the rule: %s", policyID, err)
+	}
+
+	// the key of the HEADER and QUERY_STRING rules is only returned in conditions
+	key := pathSearch("key", rule, "").(string)
+	if key == "" {
+		key = pathSearch("conditions[0].key", rule, "").(string)
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("l7policy_id", policyID),
+		d.Set("type", pathSearch("type", rule, nil)),
+		d.Set("compare_type", pathSearch("compare_type", rule, nil)),
+		d.Set("value", pathSearch("value", rule, nil)),
+		d.Set("key", key),
+		d.Set("listener_id", pathSearch("listener_id", policy, nil)),
+		d.Set("tenant_id", pathSearch("project_id", rule, nil)),
+		d.Set("admin_state_up", pathSearch("admin_state_up", rule, nil)),
+		d.Set("provisioning_status", pathSearch("provisioning_status", rule, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting L7 rule fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceL7RuleV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	policyID := d.Get("l7policy_id").(string)
+	ruleOpts := map[string]interface{}{
+		"compare_type": d.Get("compare_type"),
+		"value":        d.Get("value"),
+		"conditions":   buildL7RuleV3Conditions(d),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("elb", "l7policies", policyID, "rules", d.Id()),
+		&golangsdk.RequestOpts{
+			JSONBody: map[string]interface{}{
+				"rule": utils.RemoveNil(ruleOpts),
+			},
+			OkCodes: []int{200},
+		})
+	if err != nil {
+		return diag.Errorf("error updating L7 rule (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForL7RuleV3(ctx, client, policyID, d.Id(), "ACTIVE", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for the L7 rule (%s) to become ACTIVE: %s", d.Id(), err)
+	}
+
+	return resourceL7RuleV3Read(ctx, d, meta)
+}
+
+func resourceL7RuleV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	policyID := d.Get("l7policy_id").(string)
+	_, err = client.Request("DELETE", client.ServiceURL("elb", "l7policies", policyID, "rules", d.Id()),
+		&golangsdk.RequestOpts{
+			OkCodes: []int{204},
+		})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting L7 rule")
+	}
+
+	if err := waitForL7RuleV3(ctx, client, policyID, d.Id(), "DELETED", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the L7 rule (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceL7RuleV3ImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <l7policy_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("l7policy_id", parts[0])
+}
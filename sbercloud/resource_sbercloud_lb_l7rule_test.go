@@ -50,6 +50,47 @@ func TestAccLBV2L7Rule_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2L7Rule_header(t *testing.T) {
+	var l7rule l7rules.Rule
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_lb_l7rule.l7rule_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7RuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLBV2L7RuleConfig_header(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7RuleExists(resourceName, &l7rule),
+					resource.TestCheckResourceAttr(resourceName, "type", "HEADER"),
+					resource.TestCheckResourceAttr(resourceName, "compare_type", "EQUAL_TO"),
+					resource.TestCheckResourceAttr(resourceName, "key", "X-Canary"),
+					resource.TestCheckResourceAttr(resourceName, "value", "true"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccLBV2L7RuleImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccLBV2L7RuleImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["l7policy_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckLBV2L7RuleDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
 	lbClient, err := config.ElbV2Client(SBC_REGION_NAME)
@@ -188,3 +229,17 @@ resource "sbercloud_lb_l7rule" "l7rule_1" {
 }
 `, testAccCheckLBV2L7RuleConfig(rName))
 }
+
+func testAccCheckLBV2L7RuleConfig_header(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_lb_l7rule" "l7rule_1" {
+  l7policy_id  = sbercloud_lb_l7policy.l7policy_1.id
+  type         = "HEADER"
+  compare_type = "EQUAL_TO"
+  key          = "X-Canary"
+  value        = "true"
+}
+`, testAccCheckLBV2L7RuleConfig(rName))
+}
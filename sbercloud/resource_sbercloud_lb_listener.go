@@ -0,0 +1,95 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/lb"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceListenerV2 extends the listener resource of the lb package with the custom security policy, which is only
+// managed by the ELB v3 API.
+func ResourceListenerV2() *schema.Resource {
+	listener := lb.ResourceListenerV2()
+	listener.Schema["security_policy_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	createContext, readContext, updateContext := listener.CreateContext, listener.ReadContext, listener.UpdateContext
+	listener.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if diags := createContext(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+		if _, ok := d.GetOk("security_policy_id"); ok {
+			if err := updateListenerSecurityPolicy(d, meta.(*config.Config)); err != nil {
+				return diag.Errorf("error setting the security policy of LB listener (%s): %s", d.Id(), err)
+			}
+		}
+		return listener.ReadContext(ctx, d, meta)
+	}
+	listener.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := readContext(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, readListenerSecurityPolicy(d, meta.(*config.Config))...)
+	}
+	listener.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if d.HasChange("security_policy_id") {
+			if err := updateListenerSecurityPolicy(d, meta.(*config.Config)); err != nil {
+				return diag.Errorf("error updating the security policy of LB listener (%s): %s", d.Id(), err)
+			}
+		}
+		return updateContext(ctx, d, meta)
+	}
+
+	return listener
+}
+
+func updateListenerSecurityPolicy(d *schema.ResourceData, conf *config.Config) error {
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return err
+	}
+
+	// the listener falls back to the system policy specified by tls_ciphers_policy if the ID is null
+	_, err = client.Request("PUT", client.ServiceURL("elb", "listeners", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"listener": map[string]interface{}{
+				"security_policy_id": valueIgnoreEmpty(d.Get("security_policy_id")),
+			},
+		},
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func readListenerSecurityPolicy(d *schema.ResourceData, conf *config.Config) diag.Diagnostics {
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("elb", "listeners", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the security policy of LB listener")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("security_policy_id", pathSearch("listener.security_policy_id", respBody, nil)); err != nil {
+		return diag.Errorf("error setting LB listener fields: %s", err)
+	}
+	return nil
+}
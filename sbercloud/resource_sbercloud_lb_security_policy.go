@@ -0,0 +1,208 @@
+package sbercloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceLBSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLBSecurityPolicyCreate,
+		ReadContext:   resourceLBSecurityPolicyRead,
+		UpdateContext: resourceLBSecurityPolicyUpdate,
+		DeleteContext: resourceLBSecurityPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocols": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"}, false),
+				},
+			},
+			"ciphers": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceLBSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	policyOpts := map[string]interface{}{
+		"name":                  valueIgnoreEmpty(d.Get("name")),
+		"description":           valueIgnoreEmpty(d.Get("description")),
+		"protocols":             d.Get("protocols"),
+		"ciphers":               d.Get("ciphers"),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("elb", "security-policies"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"security_policy": utils.RemoveNil(policyOpts),
+		},
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating ELB security policy: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("security_policy.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the ELB security policy ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceLBSecurityPolicyRead(ctx, d, meta)
+}
+
+func getLBSecurityPolicy(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("elb", "security-policies", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return pathSearch("security_policy", respBody, nil), nil
+}
+
+func resourceLBSecurityPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ElbV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	policy, err := getLBSecurityPolicy(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ELB security policy")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", policy, nil)),
+		d.Set("description", pathSearch("description", policy, nil)),
+		d.Set("protocols", pathSearch("protocols", policy, nil)),
+		d.Set("ciphers", pathSearch("ciphers", policy, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", policy, nil)),
+		d.Set("listeners", pathSearch("listeners[*].id", policy, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ELB security policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLBSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	// the listeners using the policy apply the new protocols and ciphers without being recreated
+	policyOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"protocols":   d.Get("protocols"),
+		"ciphers":     d.Get("ciphers"),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("elb", "security-policies", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"security_policy": policyOpts,
+		},
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating ELB security policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceLBSecurityPolicyRead(ctx, d, meta)
+}
+
+func resourceLBSecurityPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.ElbV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB v3 client: %s", err)
+	}
+
+	// the API only reports a conflict, so the listeners still using the policy are checked in advance
+	policy, err := getLBSecurityPolicy(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ELB security policy")
+	}
+	listeners := utils.ExpandToStringList(pathSearch("listeners[*].id", policy, []interface{}{}).([]interface{}))
+	if len(listeners) > 0 {
+		return diag.Errorf("unable to delete ELB security policy (%s), it's still used by the listeners: %s",
+			d.Id(), strings.Join(listeners, ", "))
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("elb", "security-policies", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting ELB security policy")
+	}
+
+	return nil
+}
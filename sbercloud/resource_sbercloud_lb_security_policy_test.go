@@ -0,0 +1,140 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccLBSecurityPolicy_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_lb_security_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBSecurityPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBSecurityPolicy_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.0", "TLSv1.2"),
+					resource.TestCheckResourceAttr(resourceName, "ciphers.#", "2"),
+				),
+			},
+			{
+				Config: testAccLBSecurityPolicy_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBSecurityPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.0", "TLSv1.3"),
+					resource.TestCheckResourceAttr(resourceName, "ciphers.#", "1"),
+					resource.TestCheckResourceAttrPair("sbercloud_lb_listener.test", "security_policy_id",
+						resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"listeners"},
+			},
+		},
+	})
+}
+
+func testAccCheckLBSecurityPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	client, err := config.ElbV3Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating Sbercloud ELB v3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_lb_security_policy" {
+			continue
+		}
+
+		_, err := client.Request("GET", client.ServiceURL("elb", "security-policies", rs.Primary.ID),
+			&golangsdk.RequestOpts{})
+		if err == nil {
+			return fmt.Errorf("ELB security policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBSecurityPolicyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		client, err := config.ElbV3Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating Sbercloud ELB v3 client: %s", err)
+		}
+
+		_, err = client.Request("GET", client.ServiceURL("elb", "security-policies", rs.Primary.ID),
+			&golangsdk.RequestOpts{})
+		return err
+	}
+}
+
+func testAccLBSecurityPolicy_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_lb_security_policy" "test" {
+  name        = "%s"
+  description = "created by acc test"
+  protocols   = ["TLSv1.2", "TLSv1.3"]
+  ciphers     = ["ECDHE-RSA-AES256-GCM-SHA384", "TLS_AES_256_GCM_SHA384"]
+}
+`, rName)
+}
+
+func testAccLBSecurityPolicy_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "sbercloud_lb_loadbalancer" "test" {
+  name          = "%[2]s"
+  vip_subnet_id = data.sbercloud_vpc_subnet.test.subnet_id
+}
+
+resource "sbercloud_lb_security_policy" "test" {
+  name        = "%[2]s"
+  description = "created by acc test"
+  protocols   = ["TLSv1.3"]
+  ciphers     = ["TLS_AES_256_GCM_SHA384"]
+}
+
+resource "sbercloud_lb_listener" "test" {
+  name                      = "%[2]s"
+  protocol                  = "TERMINATED_HTTPS"
+  protocol_port             = 443
+  loadbalancer_id           = sbercloud_lb_loadbalancer.test.id
+  default_tls_container_ref = sbercloud_lb_certificate.certificate_1.id
+  security_policy_id        = sbercloud_lb_security_policy.test.id
+}
+`, testAccLBV2CertificateConfig_basic(rName), rName)
+}
@@ -0,0 +1,95 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/elb/v2/whitelists"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/lb"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceWhitelistV2 extends the whitelist resource of the lb package. Deleting the resource disables the whitelist
+// of the listener instead of removing it, so the creation adopts the whitelist which is left on the listener.
+func ResourceWhitelistV2() *schema.Resource {
+	whitelist := lb.ResourceWhitelistV2()
+
+	createContext := whitelist.CreateContext
+	whitelist.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		conf := meta.(*config.Config)
+		client, err := conf.LoadBalancerClient(GetRegion(d, conf))
+		if err != nil {
+			return diag.Errorf("error creating ELB client: %s", err)
+		}
+
+		listenerID := d.Get("listener_id").(string)
+		whitelistID, err := getListenerWhitelistID(client, listenerID)
+		if err != nil {
+			return diag.Errorf("error retrieving the whitelist of LB listener (%s): %s", listenerID, err)
+		}
+		if whitelistID == "" {
+			return createContext(ctx, d, meta)
+		}
+
+		_, err = client.Request("PUT", client.ServiceURL("elb", "whitelists", whitelistID), &golangsdk.RequestOpts{
+			JSONBody: map[string]interface{}{
+				"whitelist": map[string]interface{}{
+					"enable_whitelist": d.Get("enable_whitelist"),
+					"whitelist":        d.Get("whitelist"),
+				},
+			},
+			OkCodes: []int{200},
+		})
+		if err != nil {
+			return diag.Errorf("error updating the whitelist (%s) of LB listener (%s): %s", whitelistID,
+				listenerID, err)
+		}
+		d.SetId(whitelistID)
+		return whitelist.ReadContext(ctx, d, meta)
+	}
+	whitelist.DeleteContext = resourceWhitelistV2Delete
+
+	return whitelist
+}
+
+// getListenerWhitelistID returns the ID of the whitelist of the listener, or an empty string if there is none.
+func getListenerWhitelistID(client *golangsdk.ServiceClient, listenerID string) (string, error) {
+	listPath := client.ServiceURL("elb", "whitelists") + "?listener_id=" + url.QueryEscape(listenerID)
+	resp, err := client.Request("GET", listPath, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	expression := fmt.Sprintf("whitelists[?listener_id=='%s']|[0].id", listenerID)
+	return pathSearch(expression, respBody, "").(string), nil
+}
+
+// resourceWhitelistV2Delete disables the whitelist, the listener keeps the whitelist addresses.
+func resourceWhitelistV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.LoadBalancerClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ELB client: %s", err)
+	}
+
+	enableWhitelist := false
+	opts := whitelists.UpdateOpts{
+		EnableWhitelist: &enableWhitelist,
+	}
+	if _, err := whitelists.Update(client, d.Id(), opts).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling LB whitelist")
+	}
+
+	return nil
+}
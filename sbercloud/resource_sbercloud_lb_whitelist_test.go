@@ -49,9 +49,10 @@ func testAccCheckLBV2WhitelistDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := whitelists.Get(elbClient, rs.Primary.ID).Extract()
-		if err == nil {
-			return fmt.Errorf("Whitelist still exists: %s", rs.Primary.ID)
+		// the whitelist is disabled instead of removed, and it is gone with the listener
+		found, err := whitelists.Get(elbClient, rs.Primary.ID).Extract()
+		if err == nil && found.EnableWhitelist {
+			return fmt.Errorf("Whitelist is still enabled: %s", rs.Primary.ID)
 		}
 	}
 
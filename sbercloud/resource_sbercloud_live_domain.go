@@ -0,0 +1,292 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/live/v1"
+	live "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/live/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceLiveDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiveDomainCreate,
+		ReadContext:   resourceLiveDomainRead,
+		UpdateContext: resourceLiveDomainUpdate,
+		DeleteContext: resourceLiveDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pull", "push"}, false),
+			},
+			// the application is part of the streaming URLs, the API doesn't store it with the domain
+			"app_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "live",
+			},
+			"ingest_domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+			},
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getLiveDomain(client *v1.LiveClient, domain string) (*live.DecoupledLiveDomainInfo, error) {
+	resp, err := client.ShowDomain(&live.ShowDomainRequest{Domain: &domain})
+	if err != nil {
+		return nil, err
+	}
+	if resp.DomainInfo == nil || len(*resp.DomainInfo) == 0 {
+		return nil, fmt.Errorf("unable to find the Live domain (%s) from the API response", domain)
+	}
+	return &(*resp.DomainInfo)[0], nil
+}
+
+func waitForLiveDomainStatus(ctx context.Context, client *v1.LiveClient, domain, status string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			detail, err := getLiveDomain(client, domain)
+			if err != nil {
+				return nil, "", err
+			}
+			if utils.MarshalValue(detail.Status) == status {
+				return detail, "COMPLETED", nil
+			}
+			return detail, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func updateLiveDomainStatus(ctx context.Context, client *v1.LiveClient, domain, status string,
+	timeout time.Duration) error {
+	reqStatus := live.GetLiveDomainModifyReqStatusEnum().ON
+	if status == "off" {
+		reqStatus = live.GetLiveDomainModifyReqStatusEnum().OFF
+	}
+	_, err := client.UpdateDomain(&live.UpdateDomainRequest{
+		Body: &live.LiveDomainModifyReq{
+			Domain: domain,
+			Status: &reqStatus,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return waitForLiveDomainStatus(ctx, client, domain, status, timeout)
+}
+
+func associateLiveDomain(client *v1.LiveClient, d *schema.ResourceData) error {
+	ingestDomain := d.Get("ingest_domain_name").(string)
+	if d.Get("domain_type").(string) == "push" {
+		return fmt.Errorf("an ingest domain cannot be associated with another ingest domain")
+	}
+
+	_, err := client.CreateDomainMapping(&live.CreateDomainMappingRequest{
+		Body: &live.DomainMapping{
+			PullDomain: d.Get("domain").(string),
+			PushDomain: ingestDomain,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error associating the streaming domain with the ingest domain (%s): %s", ingestDomain, err)
+	}
+	return nil
+}
+
+func resourceLiveDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.HcLiveV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	domainType := live.GetLiveDomainCreateReqDomainTypeEnum().PUSH
+	if d.Get("domain_type").(string) == "pull" {
+		domainType = live.GetLiveDomainCreateReqDomainTypeEnum().PULL
+	}
+	domain := d.Get("domain").(string)
+	_, err = client.CreateDomain(&live.CreateDomainRequest{
+		Body: &live.LiveDomainCreateReq{
+			Domain:     domain,
+			DomainType: domainType,
+			Region:     region,
+		},
+	})
+	if err != nil {
+		return diag.Errorf("error creating Live domain: %s", err)
+	}
+	d.SetId(domain)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := waitForLiveDomainStatus(ctx, client, domain, "on", timeout); err != nil {
+		return diag.Errorf("error waiting for Live domain (%s) to be enabled: %s", domain, err)
+	}
+
+	if _, ok := d.GetOk("ingest_domain_name"); ok {
+		if err := associateLiveDomain(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("status").(string) == "off" {
+		if err := updateLiveDomainStatus(ctx, client, domain, "off", timeout); err != nil {
+			return diag.Errorf("error disabling Live domain (%s): %s", domain, err)
+		}
+	}
+
+	return resourceLiveDomainRead(ctx, d, meta)
+}
+
+func resourceLiveDomainRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.HcLiveV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	detail, err := getLiveDomain(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Live domain")
+	}
+
+	var createdAt string
+	if detail.CreateTime != nil {
+		createdAt = time.Time(*detail.CreateTime).UTC().Format(time.RFC3339)
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("domain", detail.Domain),
+		d.Set("domain_type", utils.MarshalValue(detail.DomainType)),
+		d.Set("ingest_domain_name", detail.RelatedDomain),
+		d.Set("status", utils.MarshalValue(detail.Status)),
+		d.Set("cname", detail.DomainCname),
+		d.Set("created_at", createdAt),
+	)
+	// the application is not returned by the API, the default value is used after importing
+	if _, ok := d.GetOk("app_name"); !ok {
+		mErr = multierror.Append(mErr, d.Set("app_name", "live"))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting Live domain fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLiveDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	domain := d.Id()
+	if d.HasChange("ingest_domain_name") {
+		oldIngestDomain, newIngestDomain := d.GetChange("ingest_domain_name")
+		if oldIngestDomain.(string) != "" {
+			_, err := client.DeleteDomainMapping(&live.DeleteDomainMappingRequest{
+				PullDomain: domain,
+				PushDomain: oldIngestDomain.(string),
+			})
+			if err != nil {
+				return diag.Errorf("error removing the association between the Live domain (%s) and the ingest "+
+					"domain (%s): %s", domain, oldIngestDomain, err)
+			}
+		}
+		if newIngestDomain.(string) != "" {
+			if err := associateLiveDomain(client, d); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("status") {
+		err := updateLiveDomainStatus(ctx, client, domain, d.Get("status").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error updating the status of Live domain (%s): %s", domain, err)
+		}
+	}
+
+	return resourceLiveDomainRead(ctx, d, meta)
+}
+
+func resourceLiveDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	// the domain must be disabled before the deletion
+	domain := d.Id()
+	if d.Get("status").(string) != "off" {
+		if err := updateLiveDomainStatus(ctx, client, domain, "off", d.Timeout(schema.TimeoutDelete)); err != nil {
+			return common.CheckDeletedDiag(d, err, "error disabling Live domain")
+		}
+	}
+
+	if _, err := client.DeleteDomain(&live.DeleteDomainRequest{Domain: domain}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting Live domain")
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	live "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/live/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceLiveRecordConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiveRecordConfigCreate,
+		ReadContext:   resourceLiveRecordConfigRead,
+		UpdateContext: resourceLiveRecordConfigUpdate,
+		DeleteContext: resourceLiveRecordConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// the asterisk (*) means all streams of the application
+			"stream_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+			"type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"obs_addr": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"location": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"object": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"record_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "CONTINUOUS_RECORD",
+							ValidateFunc: validation.StringInSlice([]string{
+								"CONTINUOUS_RECORD", "COMMAND_RECORD",
+							}, false),
+						},
+						"record_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "HLS",
+							ValidateFunc: validation.StringInSlice([]string{"HLS", "FLV", "MP4"}, false),
+						},
+						// in seconds
+						"record_cycle": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(60, 43200),
+						},
+						"record_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildLiveRecordConfigOpts(d *schema.ResourceData) (*live.RecordRuleRequest, error) {
+	var recordType live.RecordRuleRequestRecordType
+	if err := recordType.UnmarshalJSON([]byte(d.Get("type.0.record_type").(string))); err != nil {
+		return nil, fmt.Errorf("error parsing the record_type: %s", err)
+	}
+	var location live.RecordObsFileAddrLocation
+	if err := location.UnmarshalJSON([]byte(d.Get("type.0.obs_addr.0.location").(string))); err != nil {
+		return nil, fmt.Errorf("error parsing the location of obs_addr: %s", err)
+	}
+
+	recordConfig := live.DefaultRecordConfig{
+		ObsAddr: &live.RecordObsFileAddr{
+			Location: location,
+			Bucket:   d.Get("type.0.obs_addr.0.bucket").(string),
+			Object:   d.Get("type.0.obs_addr.0.object").(string),
+		},
+	}
+	recordCycle := int32(d.Get("type.0.record_cycle").(int))
+	recordPrefix := utils.StringIgnoreEmpty(d.Get("type.0.record_prefix").(string))
+	switch d.Get("type.0.record_format").(string) {
+	case "FLV":
+		recordConfig.RecordFormat = []live.VideoFormatVar{live.GetVideoFormatVarEnum().FLV}
+		recordConfig.FlvConfig = &live.FlvRecordConfig{RecordCycle: recordCycle, RecordPrefix: recordPrefix}
+	case "MP4":
+		recordConfig.RecordFormat = []live.VideoFormatVar{live.GetVideoFormatVarEnum().MP4}
+		recordConfig.Mp4Config = &live.Mp4RecordConfig{RecordCycle: recordCycle, RecordPrefix: recordPrefix}
+	default:
+		recordConfig.RecordFormat = []live.VideoFormatVar{live.GetVideoFormatVarEnum().HLS}
+		recordConfig.HlsConfig = &live.HlsRecordConfig{RecordCycle: recordCycle, RecordPrefix: recordPrefix}
+	}
+
+	return &live.RecordRuleRequest{
+		PublishDomain:       d.Get("domain").(string),
+		App:                 d.Get("app_name").(string),
+		Stream:              d.Get("stream_name").(string),
+		RecordType:          &recordType,
+		DefaultRecordConfig: &recordConfig,
+	}, nil
+}
+
+func flattenLiveRecordConfigType(recordType *live.ShowRecordRuleResponseRecordType,
+	recordConfig *live.DefaultRecordConfig) []map[string]interface{} {
+	if recordConfig == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	if recordType != nil {
+		result["record_type"] = recordType.Value()
+	}
+	if recordConfig.ObsAddr != nil {
+		result["obs_addr"] = []map[string]interface{}{
+			{
+				"location": utils.MarshalValue(recordConfig.ObsAddr.Location),
+				"bucket":   recordConfig.ObsAddr.Bucket,
+				"object":   recordConfig.ObsAddr.Object,
+			},
+		}
+	}
+	// only one format is configured by this resource, the configurations of other formats may be returned as well
+	if len(recordConfig.RecordFormat) == 0 {
+		return []map[string]interface{}{result}
+	}
+	format := utils.MarshalValue(recordConfig.RecordFormat[0])
+	result["record_format"] = format
+	switch {
+	case format == "HLS" && recordConfig.HlsConfig != nil:
+		result["record_cycle"] = recordConfig.HlsConfig.RecordCycle
+		result["record_prefix"] = recordConfig.HlsConfig.RecordPrefix
+	case format == "FLV" && recordConfig.FlvConfig != nil:
+		result["record_cycle"] = recordConfig.FlvConfig.RecordCycle
+		result["record_prefix"] = recordConfig.FlvConfig.RecordPrefix
+	case format == "MP4" && recordConfig.Mp4Config != nil:
+		result["record_cycle"] = recordConfig.Mp4Config.RecordCycle
+		result["record_prefix"] = recordConfig.Mp4Config.RecordPrefix
+	}
+	return []map[string]interface{}{result}
+}
+
+func resourceLiveRecordConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	createOpts, err := buildLiveRecordConfigOpts(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.CreateRecordRule(&live.CreateRecordRuleRequest{Body: createOpts})
+	if err != nil {
+		return diag.Errorf("error creating Live record configuration: %s", err)
+	}
+	if resp.Id == nil {
+		return diag.Errorf("unable to find the Live record configuration ID from the API response")
+	}
+	d.SetId(*resp.Id)
+
+	return resourceLiveRecordConfigRead(ctx, d, meta)
+}
+
+func resourceLiveRecordConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.HcLiveV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	resp, err := client.ShowRecordRule(&live.ShowRecordRuleRequest{Id: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Live record configuration")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("domain", resp.PublishDomain),
+		d.Set("app_name", resp.App),
+		d.Set("stream_name", resp.Stream),
+		d.Set("type", flattenLiveRecordConfigType(resp.RecordType, resp.DefaultRecordConfig)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting Live record configuration fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLiveRecordConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	updateOpts, err := buildLiveRecordConfigOpts(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.UpdateRecordRule(&live.UpdateRecordRuleRequest{
+		Id:   d.Id(),
+		Body: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating Live record configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceLiveRecordConfigRead(ctx, d, meta)
+}
+
+func resourceLiveRecordConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	if _, err := client.DeleteRecordRule(&live.DeleteRecordRuleRequest{Id: d.Id()}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting Live record configuration")
+	}
+
+	return nil
+}
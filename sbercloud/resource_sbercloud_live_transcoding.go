@@ -0,0 +1,272 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	live "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/live/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceLiveTranscoding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLiveTranscodingCreate,
+		ReadContext:   resourceLiveTranscodingRead,
+		UpdateContext: resourceLiveTranscodingUpdate,
+		DeleteContext: resourceLiveTranscodingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"app_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quality": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,64}$`),
+								"the name can contain a maximum of 64 characters, only letters, digits and hyphens "+
+									"(-) are allowed"),
+						},
+						"codecs": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "H264",
+							ValidateFunc: validation.StringInSlice([]string{"H264", "H265"}, false),
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(32, 3840),
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(32, 2160),
+						},
+						"bitrate": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(40, 30000),
+						},
+						"frame_rate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 30),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "RTMP",
+							ValidateFunc: validation.StringInSlice([]string{"RTMP", "HLS", "DASH"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildLiveTranscodingQualities(rawQualities []interface{}) ([]live.QualityInfo, error) {
+	result := make([]live.QualityInfo, len(rawQualities))
+	for i, rawQuality := range rawQualities {
+		quality := rawQuality.(map[string]interface{})
+
+		var codec live.QualityInfoCodec
+		if err := codec.UnmarshalJSON([]byte(quality["codecs"].(string))); err != nil {
+			return nil, fmt.Errorf("error parsing the codecs of quality %d: %s", i, err)
+		}
+		var protocol live.QualityInfoProtocol
+		if err := protocol.UnmarshalJSON([]byte(quality["protocol"].(string))); err != nil {
+			return nil, fmt.Errorf("error parsing the protocol of quality %d: %s", i, err)
+		}
+
+		result[i] = live.QualityInfo{
+			TemplateName:   utils.String(quality["name"].(string)),
+			Quality:        quality["name"].(string),
+			Codec:          &codec,
+			Width:          int32(quality["width"].(int)),
+			Height:         int32(quality["height"].(int)),
+			Bitrate:        int32(quality["bitrate"].(int)),
+			VideoFrameRate: utils.Int32(int32(quality["frame_rate"].(int))),
+			Protocol:       &protocol,
+		}
+	}
+	return result, nil
+}
+
+func flattenLiveTranscodingQualities(qualities *[]live.QualityInfo) []map[string]interface{} {
+	if qualities == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(*qualities))
+	for i, quality := range *qualities {
+		result[i] = map[string]interface{}{
+			"name":       quality.Quality,
+			"width":      quality.Width,
+			"height":     quality.Height,
+			"bitrate":    quality.Bitrate,
+			"frame_rate": quality.VideoFrameRate,
+		}
+		if quality.Codec != nil {
+			result[i]["codecs"] = quality.Codec.Value()
+		}
+		if quality.Protocol != nil {
+			result[i]["protocol"] = quality.Protocol.Value()
+		}
+	}
+	return result
+}
+
+func parseLiveTranscodingID(id string) (domain, appName string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format, want '<domain>/<app_name>', but got '%s'", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceLiveTranscodingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	qualities, err := buildLiveTranscodingQualities(d.Get("quality").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createOpts := live.StreamTranscodingTemplate{
+		Domain:      d.Get("domain").(string),
+		AppName:     d.Get("app_name").(string),
+		QualityInfo: qualities,
+	}
+	_, err = client.CreateTranscodingsTemplate(&live.CreateTranscodingsTemplateRequest{Body: &createOpts})
+	if err != nil {
+		return diag.Errorf("error creating Live transcoding: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", createOpts.Domain, createOpts.AppName))
+
+	return resourceLiveTranscodingRead(ctx, d, meta)
+}
+
+func resourceLiveTranscodingRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.HcLiveV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	domain, appName, err := parseLiveTranscodingID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.ShowTranscodingsTemplate(&live.ShowTranscodingsTemplateRequest{
+		Domain:  domain,
+		AppName: &appName,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Live transcoding")
+	}
+
+	var template *live.AppQualityInfo
+	if resp.Templates != nil {
+		for i, v := range *resp.Templates {
+			if v.AppName != nil && *v.AppName == appName {
+				template = &(*resp.Templates)[i]
+				break
+			}
+		}
+	}
+	if template == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving Live transcoding")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("domain", domain),
+		d.Set("app_name", appName),
+		d.Set("quality", flattenLiveTranscodingQualities(template.QualityInfo)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting Live transcoding fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLiveTranscodingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	qualities, err := buildLiveTranscodingQualities(d.Get("quality").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.UpdateTranscodingsTemplate(&live.UpdateTranscodingsTemplateRequest{
+		Body: &live.StreamTranscodingTemplate{
+			Domain:      d.Get("domain").(string),
+			AppName:     d.Get("app_name").(string),
+			QualityInfo: qualities,
+		},
+	})
+	if err != nil {
+		return diag.Errorf("error updating Live transcoding (%s): %s", d.Id(), err)
+	}
+
+	return resourceLiveTranscodingRead(ctx, d, meta)
+}
+
+func resourceLiveTranscodingDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcLiveV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Live v1 client: %s", err)
+	}
+
+	_, err = client.DeleteTranscodingsTemplate(&live.DeleteTranscodingsTemplateRequest{
+		Domain:  d.Get("domain").(string),
+		AppName: d.Get("app_name").(string),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting Live transcoding")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/meeting"
+)
+
+// ResourceMeetingConference extends the conference resource of the meeting package with the VMR flag and exposes
+// the time zone and the conference UUID under the names used by the other SberCloud resources.
+func ResourceMeetingConference() *schema.Resource {
+	conference := meeting.ResourceConference()
+	conference.Schema["meeting_room_id"].Required = false
+	conference.Schema["meeting_room_id"].Optional = true
+	conference.Schema["vmr_flag"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	}
+	conference.Schema["time_zone_id"] = &schema.Schema{
+		Type:          schema.TypeInt,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"timezone_id"},
+	}
+	conference.Schema["timezone_id"].Deprecated = "use time_zone_id instead"
+	conference.Schema["conference_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	conference.Schema["conf_uuid"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	createContext, readContext, updateContext := conference.CreateContext, conference.ReadContext,
+		conference.UpdateContext
+	conference.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := prepareMeetingConferenceOpts(d); err != nil {
+			return diag.FromErr(err)
+		}
+		return createContext(ctx, d, meta)
+	}
+	conference.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := readContext(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		mErr := multierror.Append(nil,
+			d.Set("conference_id", d.Id()),
+			d.Set("conf_uuid", d.Get("conference_uuid")),
+			d.Set("time_zone_id", d.Get("timezone_id")),
+			d.Set("vmr_flag", d.Get("meeting_room_id").(string) != ""),
+		)
+		if err := mErr.ErrorOrNil(); err != nil {
+			return append(diags, diag.Errorf("error setting meeting conference fields: %s", err)...)
+		}
+		return diags
+	}
+	conference.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := prepareMeetingConferenceOpts(d); err != nil {
+			return diag.FromErr(err)
+		}
+		return updateContext(ctx, d, meta)
+	}
+
+	return conference
+}
+
+// prepareMeetingConferenceOpts checks the VMR flag against the meeting room and copies the time zone to the field
+// read by the upstream resource.
+func prepareMeetingConferenceOpts(d *schema.ResourceData) error {
+	roomID := d.Get("meeting_room_id").(string)
+	if rawFlag := d.GetRawConfig().GetAttr("vmr_flag"); !rawFlag.IsNull() {
+		if rawFlag.True() && roomID == "" {
+			return fmt.Errorf("meeting_room_id must be specified when vmr_flag is true")
+		}
+		if rawFlag.False() && roomID != "" {
+			return fmt.Errorf("meeting_room_id can't be specified when vmr_flag is false")
+		}
+	}
+
+	if v, ok := d.GetOk("time_zone_id"); ok {
+		return d.Set("timezone_id", v)
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceMlsInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMlsInstanceCreate,
+		ReadContext:   resourceMlsInstanceRead,
+		UpdateContext: resourceMlsInstanceUpdate,
+		DeleteContext: resourceMlsInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mrs_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"public_ip_bind_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "not_use",
+				ValidateFunc: validation.StringInSlice([]string{"auto_assign", "not_use"}, false),
+			},
+			"agency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inner_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMlsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.MlsV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating MLS client: %s", err)
+	}
+
+	instanceOpts := map[string]interface{}{
+		"name":      d.Get("name"),
+		"version":   d.Get("version"),
+		"flavorRef": d.Get("flavor"),
+		"agency":    valueIgnoreEmpty(d.Get("agency")),
+		"mrsCluster": map[string]interface{}{
+			"id": d.Get("mrs_cluster_id"),
+		},
+		"network": utils.RemoveNil(map[string]interface{}{
+			"vpcId":           d.Get("vpc_id"),
+			"subnetId":        d.Get("subnet_id"),
+			"securityGroupId": valueIgnoreEmpty(d.Get("security_group_id")),
+			"availableZone":   d.Get("availability_zone"),
+			"publicIP": map[string]interface{}{
+				"bindType": d.Get("public_ip_bind_type"),
+			},
+		}),
+		"enterpriseProjectId": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	createOpts := map[string]interface{}{
+		"instance": utils.RemoveNil(instanceOpts),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("instances"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+		OkCodes:          []int{200, 202},
+	})
+	if err != nil {
+		return diag.Errorf("error creating MLS instance: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("instance.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the MLS instance ID from the API response")
+	}
+	d.SetId(id)
+
+	if err := waitForMlsInstanceAvailable(ctx, client, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the MLS instance (%s) to be available: %s", id, err)
+	}
+
+	return resourceMlsInstanceRead(ctx, d, meta)
+}
+
+func mlsInstanceStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Request("GET", client.ServiceURL("instances", id), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := pathSearch("instance.status", respBody, "").(string)
+		if status == "ERROR" {
+			return respBody, status, fmt.Errorf("the MLS instance is in error status")
+		}
+		return respBody, status, nil
+	}
+}
+
+// waitForMlsInstanceAvailable waits for the creation or the resizing of the instance, which takes about 5 to 15
+// minutes.
+func waitForMlsInstanceAvailable(ctx context.Context, client *golangsdk.ServiceClient, id string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING", "RESIZING", "Pending"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      mlsInstanceStateRefreshFunc(client, id),
+		Timeout:      timeout,
+		Delay:        60 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceMlsInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.MlsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating MLS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("instances", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving MLS instance")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance := pathSearch("instance", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", instance, nil)),
+		d.Set("version", pathSearch("version", instance, nil)),
+		d.Set("flavor", pathSearch("flavorRef", instance, nil)),
+		d.Set("mrs_cluster_id", pathSearch("mrsCluster.id", instance, nil)),
+		d.Set("availability_zone", pathSearch("network.availableZone", instance, nil)),
+		d.Set("vpc_id", pathSearch("network.vpcId", instance, nil)),
+		d.Set("subnet_id", pathSearch("network.subnetId", instance, nil)),
+		d.Set("security_group_id", pathSearch("network.securityGroupId", instance, nil)),
+		d.Set("public_ip_bind_type", pathSearch("network.publicIP.bindType", instance, nil)),
+		d.Set("agency", pathSearch("agency", instance, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterpriseProjectId", instance, nil)),
+		d.Set("status", pathSearch("status", instance, nil)),
+		d.Set("public_endpoint", pathSearch("publicEndPoint", instance, nil)),
+		d.Set("private_endpoint", pathSearch("privateEndPoint", instance, nil)),
+		d.Set("inner_endpoint", pathSearch("innerEndPoint", instance, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting MLS instance fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMlsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.MlsV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating MLS client: %s", err)
+	}
+
+	if d.HasChange("flavor") {
+		resizeOpts := map[string]interface{}{
+			"resize": map[string]interface{}{
+				"flavorRef": d.Get("flavor"),
+			},
+		}
+		_, err = client.Request("POST", client.ServiceURL("instances", d.Id(), "resize"), &golangsdk.RequestOpts{
+			JSONBody: resizeOpts,
+			OkCodes:  []int{200, 202},
+		})
+		if err != nil {
+			return diag.Errorf("error resizing MLS instance (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForMlsInstanceAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for the MLS instance (%s) to be resized: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMlsInstanceRead(ctx, d, meta)
+}
+
+func resourceMlsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.MlsV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating MLS client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("instances", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting MLS instance")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"AVAILABLE", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      mlsInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the MLS instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
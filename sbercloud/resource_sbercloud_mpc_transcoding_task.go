@@ -0,0 +1,402 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func mpcObjectSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"object": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func ResourceMpcTranscodingTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMpcTranscodingTaskCreate,
+		ReadContext:   resourceMpcTranscodingTaskRead,
+		DeleteContext: resourceMpcTranscodingTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     mpcObjectSchema(),
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     mpcObjectSchema(),
+			},
+			"template_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"output_filenames": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"watermarks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"template_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"input": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     mpcObjectSchema(),
+						},
+					},
+				},
+			},
+			"thumbnail": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem:     mpcObjectSchema(),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "TIME",
+							ValidateFunc: validation.StringInSlice([]string{"TIME", "DOTS"}, false),
+						},
+						"time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"dots": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+						"width": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"height": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"9", "10"}, false),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_file_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildMpcObject(rawObjects []interface{}) map[string]interface{} {
+	if len(rawObjects) == 0 || rawObjects[0] == nil {
+		return nil
+	}
+
+	raw := rawObjects[0].(map[string]interface{})
+	return map[string]interface{}{
+		"bucket":   raw["bucket"],
+		"location": raw["location"],
+		"object":   raw["object"],
+	}
+}
+
+func flattenMpcObject(obj interface{}) []map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"bucket":   pathSearch("bucket", obj, nil),
+			"location": pathSearch("location", obj, nil),
+			"object":   pathSearch("object", obj, nil),
+		},
+	}
+}
+
+func buildMpcTranscodingTaskWatermarks(rawWatermarks []interface{}) []map[string]interface{} {
+	if len(rawWatermarks) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(rawWatermarks))
+	for i, v := range rawWatermarks {
+		raw := v.(map[string]interface{})
+		result[i] = map[string]interface{}{
+			"template_id": raw["template_id"],
+			"input":       buildMpcObject(raw["input"].([]interface{})),
+		}
+	}
+	return result
+}
+
+func buildMpcTranscodingTaskThumbnail(rawThumbnails []interface{}) map[string]interface{} {
+	if len(rawThumbnails) == 0 || rawThumbnails[0] == nil {
+		return nil
+	}
+
+	raw := rawThumbnails[0].(map[string]interface{})
+	params := map[string]interface{}{
+		"type":   raw["type"],
+		"time":   valueIgnoreEmpty(raw["time"]),
+		"dots":   valueIgnoreEmpty(raw["dots"]),
+		"width":  valueIgnoreEmpty(raw["width"]),
+		"height": valueIgnoreEmpty(raw["height"]),
+	}
+	return map[string]interface{}{
+		"out":    buildMpcObject(raw["output"].([]interface{})),
+		"params": utils.RemoveNil(params),
+	}
+}
+
+func resourceMpcTranscodingTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "mpc", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating MPC client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"input":                 buildMpcObject(d.Get("input").([]interface{})),
+		"output":                buildMpcObject(d.Get("output").([]interface{})),
+		"trans_template_id":     []int{d.Get("template_id").(int)},
+		"output_filenames":      valueIgnoreEmpty(d.Get("output_filenames")),
+		"watermarks":            buildMpcTranscodingTaskWatermarks(d.Get("watermarks").([]interface{})),
+		"thumbnail":             buildMpcTranscodingTaskThumbnail(d.Get("thumbnail").([]interface{})),
+		"priority":              valueIgnoreEmpty(d.Get("priority")),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("transcodings"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating MPC transcoding task: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := convertToStr(pathSearch("task_id", respBody, ""))
+	if id == "" {
+		return diag.Errorf("unable to find the MPC transcoding task ID from the API response")
+	}
+	d.SetId(id)
+
+	// transcoding is asynchronous, the task is complete once it has either succeeded or failed
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"WAITING", "TRANSCODING"},
+		Target:       []string{"SUCCEEDED", "FAILED"},
+		Refresh:      mpcTranscodingTaskStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	task, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the MPC transcoding task (%s) to complete: %s", id, err)
+	}
+	if pathSearch("status", task, "").(string) == "FAILED" {
+		return diag.Errorf("the MPC transcoding task (%s) failed: %s", id, pathSearch("description", task, ""))
+	}
+
+	return resourceMpcTranscodingTaskRead(ctx, d, meta)
+}
+
+// getMpcTranscodingTask returns a 404 error when the task does not exist, because the list API is used to query
+// a single task.
+func getMpcTranscodingTask(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("transcodings")+"?task_id="+id, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	task := pathSearch("task_array[0]", respBody, nil)
+	if task == nil || pathSearch("status", task, "").(string) == "NO_TASK" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return task, nil
+}
+
+func mpcTranscodingTaskStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := getMpcTranscodingTask(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return task, pathSearch("status", task, "").(string), nil
+	}
+}
+
+func resourceMpcTranscodingTaskRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "mpc", region)
+	if err != nil {
+		return diag.Errorf("error creating MPC client: %s", err)
+	}
+
+	task, err := getMpcTranscodingTask(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving MPC transcoding task")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("input", flattenMpcObject(pathSearch("input", task, nil))),
+		d.Set("output", flattenMpcObject(pathSearch("output", task, nil))),
+		d.Set("task_id", convertToStr(pathSearch("task_id", task, ""))),
+		d.Set("status", pathSearch("status", task, nil)),
+		d.Set("create_time", pathSearch("create_time", task, nil)),
+		d.Set("end_time", pathSearch("end_time", task, nil)),
+		d.Set("description", pathSearch("description", task, nil)),
+		d.Set("output_file_names", pathSearch("output_file_name", task, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting MPC transcoding task fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceMpcTranscodingTaskDelete cancels the task if it is still in progress, the completed tasks are only
+// removed from the state.
+func resourceMpcTranscodingTaskDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "mpc", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating MPC client: %s", err)
+	}
+
+	task, err := getMpcTranscodingTask(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving MPC transcoding task")
+	}
+
+	status := pathSearch("status", task, "").(string)
+	if status != "WAITING" && status != "TRANSCODING" {
+		return nil
+	}
+
+	deleteURL := client.ServiceURL("transcodings") + "?task_id=" + d.Id()
+	if _, err := client.Request("DELETE", deleteURL, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error canceling MPC transcoding task")
+	}
+
+	return nil
+}
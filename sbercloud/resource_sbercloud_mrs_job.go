@@ -0,0 +1,364 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/mrs/v2/jobs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	mrsJobStateCompleted = "Completed"
+	mrsJobStateFailed    = "Failed"
+)
+
+// the created state is documented as New, unlike the other states which are in upper case
+var mrsJobPendingStates = []string{"New", "NEW", "NEW_SAVING", "SUBMITTED", "ACCEPTED", "RUNNING"}
+
+// ResourceMrsJob runs a job on an MRS cluster once. All arguments are ForceNew, so running the job again requires
+// a new resource.
+func ResourceMrsJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMrsJobCreate,
+		ReadContext:   resourceMrsJobRead,
+		DeleteContext: resourceMrsJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMrsJobImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"MapReduce", "SparkSubmit", "HiveScript", "SparkSql",
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`),
+					"the name consists of 1 to 64 characters, only letters, digits, hyphens (-) and underscores (_) "+
+						"are allowed"),
+			},
+			"jar_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"input": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"arguments": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"hive_script_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"submit_job_once": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildMrsJobArguments returns the positional arguments of the job:
+//   - MapReduce: <jar_path> <arguments> <input> <output>
+//   - SparkSubmit: --master yarn-cluster <jar_path> <arguments> <input> <output>
+//   - HiveScript: <arguments> <hive_script_path>
+//   - SparkSql: <arguments>, the last argument is the SQL statement
+func buildMrsJobArguments(d *schema.ResourceData) ([]string, error) {
+	jobType := d.Get("type").(string)
+	arguments := utils.ExpandToStringList(d.Get("arguments").([]interface{}))
+	jarPath := d.Get("jar_path").(string)
+	scriptPath := d.Get("hive_script_path").(string)
+
+	result := make([]string, 0, len(arguments)+5)
+	switch jobType {
+	case "MapReduce", "SparkSubmit":
+		if jarPath == "" {
+			return nil, fmt.Errorf("jar_path is required for the %s job", jobType)
+		}
+		if jobType == "SparkSubmit" {
+			result = append(result, "--master", "yarn-cluster")
+		}
+		result = append(result, jarPath)
+		result = append(result, arguments...)
+		for _, key := range []string{"input", "output"} {
+			if v := d.Get(key).(string); v != "" {
+				result = append(result, v)
+			}
+		}
+	case "HiveScript":
+		if scriptPath == "" {
+			return nil, fmt.Errorf("hive_script_path is required for the HiveScript job")
+		}
+		result = append(result, arguments...)
+		result = append(result, scriptPath)
+	default:
+		if len(arguments) == 0 {
+			return nil, fmt.Errorf("arguments is required for the SparkSql job")
+		}
+		result = append(result, arguments...)
+	}
+	return result, nil
+}
+
+func buildMrsJobProperties(d *schema.ResourceData) map[string]string {
+	properties := d.Get("properties").(map[string]interface{})
+	result := make(map[string]string, len(properties))
+	for k, v := range properties {
+		result[k] = v.(string)
+	}
+	return result
+}
+
+// getMrsJobByName returns the last submitted job with the name on the cluster, or nil if there is no such job.
+func getMrsJobByName(client *golangsdk.ServiceClient, clusterID, name string) (*jobs.Job, error) {
+	pages, err := jobs.List(client, clusterID, jobs.ListOpts{JobName: name, SortBy: "desc"}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allJobs, err := jobs.ExtractJobs(pages)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range allJobs {
+		// the name is a fuzzy filter
+		if job.JobName == name {
+			return &job, nil
+		}
+	}
+	return nil, nil
+}
+
+// flattenMrsJobState returns the state of the job, the final states of the API are merged into Completed and Failed.
+func flattenMrsJobState(job *jobs.Job) string {
+	switch job.JobState {
+	case "FINISHED":
+		if job.JobResult == "SUCCEEDED" {
+			return mrsJobStateCompleted
+		}
+		return mrsJobStateFailed
+	case "FAILED", "KILLED":
+		return mrsJobStateFailed
+	}
+	return job.JobState
+}
+
+func mrsJobStateRefreshFunc(client *golangsdk.ServiceClient, clusterID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := jobs.Get(client, clusterID, jobID).Extract()
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		return job, flattenMrsJobState(job), nil
+	}
+}
+
+func resourceMrsJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.MrsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating MRS client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	if d.Get("submit_job_once").(bool) {
+		job, err := getMrsJobByName(client, clusterID, name)
+		if err != nil {
+			return diag.Errorf("error retrieving MRS jobs of cluster (%s): %s", clusterID, err)
+		}
+		if job != nil {
+			d.SetId(job.JobId)
+		}
+	}
+
+	if d.Id() == "" {
+		arguments, err := buildMrsJobArguments(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts := jobs.CreateOpts{
+			JobType:    d.Get("type").(string),
+			JobName:    name,
+			Arguments:  arguments,
+			Properties: buildMrsJobProperties(d),
+		}
+		resp, err := jobs.Create(client, clusterID, opts).Extract()
+		if err != nil {
+			return diag.Errorf("error submitting MRS job: %s", err)
+		}
+		d.SetId(resp.JobSubmitResult.JobId)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      mrsJobPendingStates,
+		Target:       []string{mrsJobStateCompleted, mrsJobStateFailed},
+		Refresh:      mrsJobStateRefreshFunc(client, clusterID, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for MRS job (%s) to complete: %s", d.Id(), err)
+	}
+
+	return resourceMrsJobRead(ctx, d, meta)
+}
+
+func flattenMrsJobTime(milliseconds int) string {
+	if milliseconds == 0 {
+		return ""
+	}
+	return utils.FormatTimeStampRFC3339(int64(milliseconds) / 1000)
+}
+
+func resourceMrsJobRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.MrsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating MRS client: %s", err)
+	}
+
+	job, err := jobs.Get(client, d.Get("cluster_id").(string), d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving MRS job")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("type", job.JobType),
+		d.Set("name", job.JobName),
+		d.Set("state", flattenMrsJobState(job)),
+		d.Set("start_time", flattenMrsJobTime(job.StartedTime)),
+		d.Set("end_time", flattenMrsJobTime(job.FinishedTime)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting MRS job fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceMrsJobDelete terminates the job if it is still running, and deletes the record of the job.
+func resourceMrsJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.MrsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating MRS client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	job, err := jobs.Get(client, clusterID, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving MRS job")
+	}
+
+	state := flattenMrsJobState(job)
+	if state != mrsJobStateCompleted && state != mrsJobStateFailed {
+		killPath := client.ServiceURL("clusters", clusterID, "job-executions", d.Id(), "kill")
+		_, err = client.Request("POST", killPath, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+		if err != nil {
+			return diag.Errorf("error terminating MRS job (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      mrsJobPendingStates,
+			Target:       []string{mrsJobStateCompleted, mrsJobStateFailed},
+			Refresh:      mrsJobStateRefreshFunc(client, clusterID, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutDelete),
+			Delay:        5 * time.Second,
+			PollInterval: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("error waiting for MRS job (%s) to be terminated: %s", d.Id(), err)
+		}
+	}
+
+	err = jobs.Delete(client, clusterID, jobs.DeleteOpts{JobIds: []string{d.Id()}}).ExtractErr()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting MRS job")
+	}
+
+	return nil
+}
+
+func resourceMrsJobImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <cluster_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
@@ -0,0 +1,59 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
+)
+
+// ResourceNetworkingSecGroup extends the security group resource of the huaweicloud package with the option to ignore
+// the rules which are managed outside Terraform, e.g. by auto-scaling or by the instances joining the group.
+func ResourceNetworkingSecGroup() *schema.Resource {
+	secgroup := huaweicloud.ResourceNetworkingSecGroup()
+	secgroup.Schema["ignore_externally_managed_rules"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+	upstreamRead := secgroup.ReadContext
+	secgroup.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return resourceNetworkingSecGroupRead(ctx, d, meta, upstreamRead)
+	}
+	return secgroup
+}
+
+// resourceNetworkingSecGroupRead keeps the rules of the groups which ignore the externally managed rules to the ones
+// already recorded in the state, the rules added to the group afterwards are left out, and the removed ones are
+// still dropped. The rules attribute is computed only, so it can not be handled in the diff. Unlike ignore_changes,
+// it is scoped to the specific groups.
+func resourceNetworkingSecGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{},
+	upstreamRead schema.ReadContextFunc) diag.Diagnostics {
+	// all rules are recorded when the group is created or imported
+	if d.IsNewResource() || !d.Get("ignore_externally_managed_rules").(bool) {
+		return upstreamRead(ctx, d, meta)
+	}
+
+	knownRules := make(map[string]bool)
+	for _, rule := range d.Get("rules").([]interface{}) {
+		if r, ok := rule.(map[string]interface{}); ok {
+			knownRules[r["id"].(string)] = true
+		}
+	}
+
+	diags := upstreamRead(ctx, d, meta)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	rules := make([]interface{}, 0, len(knownRules))
+	for _, rule := range d.Get("rules").([]interface{}) {
+		if r, ok := rule.(map[string]interface{}); ok && knownRules[r["id"].(string)] {
+			rules = append(rules, rule)
+		}
+	}
+	if err := d.Set("rules", rules); err != nil {
+		return append(diags, diag.Errorf("error setting the rules of security group: %s", err)...)
+	}
+	return diags
+}
@@ -84,6 +84,46 @@ func TestAccNetworkingV2SecGroup_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroup_ignoreExternallyManagedRules(t *testing.T) {
+	var security_group groups.SecGroup
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroup_ignoreExternallyManagedRules(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"sbercloud_networking_secgroup.secgroup_1", &security_group),
+					resource.TestCheckResourceAttr(
+						"sbercloud_networking_secgroup.secgroup_1", "ignore_externally_managed_rules", "true"),
+					resource.TestCheckResourceAttr(
+						"sbercloud_networking_secgroup.secgroup_1", "rules.#", "0"),
+				),
+			},
+			{
+				// the rule added by the other resource after the group is created is not recorded by the refresh
+				Config: testAccNetworkingV2SecGroup_ignoreExternallyManagedRules(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sbercloud_networking_secgroup.secgroup_1", "rules.#", "0"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroup_ignoreExternallyManagedRules(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sbercloud_networking_secgroup.secgroup_1", "ignore_externally_managed_rules", "false"),
+					resource.TestCheckResourceAttr(
+						"sbercloud_networking_secgroup.secgroup_1", "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2SecGroupDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
 	networkingClient, err := config.NetworkingV2Client(SBC_REGION_NAME)
@@ -181,3 +221,24 @@ resource "sbercloud_networking_secgroup" "secgroup_1" {
   }
 }
 `
+
+func testAccNetworkingV2SecGroup_ignoreExternallyManagedRules(ignore bool) string {
+	return fmt.Sprintf(`
+resource "sbercloud_networking_secgroup" "secgroup_1" {
+  name                            = "security_group_1"
+  description                     = "terraform security group acceptance test"
+  delete_default_rules            = true
+  ignore_externally_managed_rules = %t
+}
+
+resource "sbercloud_networking_secgroup_rule" "rule_1" {
+  security_group_id = sbercloud_networking_secgroup.secgroup_1.id
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  remote_ip_prefix  = "0.0.0.0/0"
+}
+`, ignore)
+}
@@ -0,0 +1,219 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// the device owner of the Neutron ports which are used as virtual IPs
+const networkingVipDeviceOwner = "neutron:VIP_PORT"
+
+// ResourceNetworkingVip manages a virtual IP, which is a Neutron port without a device. The address of the port can
+// be moved between the instances which are associated with it.
+func ResourceNetworkingVip() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingVipCreate,
+		ReadContext:   resourceNetworkingVipRead,
+		UpdateContext: resourceNetworkingVipUpdate,
+		DeleteContext: resourceNetworkingVipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func networkingVipStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		port, err := ports.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return port, "DELETED", nil
+			}
+			return nil, "ERROR", err
+		}
+		return port, port.Status, nil
+	}
+}
+
+func resourceNetworkingVipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	opts := ports.CreateOpts{
+		NetworkID:   d.Get("network_id").(string),
+		Name:        d.Get("name").(string),
+		DeviceOwner: networkingVipDeviceOwner,
+	}
+	// without the fixed IPs, an address of the first subnet of the network is allocated
+	subnetID := d.Get("subnet_id").(string)
+	ipAddress := d.Get("ip_address").(string)
+	if subnetID != "" || ipAddress != "" {
+		opts.FixedIPs = []ports.IP{
+			{
+				SubnetID:  subnetID,
+				IPAddress: ipAddress,
+			},
+		}
+	}
+
+	vip, err := ports.Create(client, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating networking VIP: %s", err)
+	}
+	d.SetId(vip.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"BUILD"},
+		Target:       []string{"DOWN", "ACTIVE"},
+		Refresh:      networkingVipStateRefreshFunc(client, vip.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        3 * time.Second,
+		PollInterval: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for networking VIP (%s) to become available: %s", vip.ID, err)
+	}
+
+	return resourceNetworkingVipRead(ctx, d, meta)
+}
+
+func resourceNetworkingVipRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	vip, err := ports.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving networking VIP")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("network_id", vip.NetworkID),
+		d.Set("name", vip.Name),
+		d.Set("tenant_id", vip.TenantID),
+		d.Set("port_id", vip.ID),
+		d.Set("mac_address", vip.MACAddress),
+		d.Set("status", vip.Status),
+	)
+	if len(vip.FixedIPs) > 0 {
+		mErr = multierror.Append(mErr,
+			d.Set("subnet_id", vip.FixedIPs[0].SubnetID),
+			d.Set("ip_address", vip.FixedIPs[0].IPAddress),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting networking VIP fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkingVipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	_, err = ports.Update(client, d.Id(), ports.UpdateOpts{Name: d.Get("name").(string)}).Extract()
+	if err != nil {
+		return diag.Errorf("error updating networking VIP (%s): %s", d.Id(), err)
+	}
+
+	return resourceNetworkingVipRead(ctx, d, meta)
+}
+
+func resourceNetworkingVipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	if err := ports.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting networking VIP")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"DOWN", "ACTIVE", "BUILD"},
+		Target:       []string{"DELETED"},
+		Refresh:      networkingVipStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        3 * time.Second,
+		PollInterval: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for networking VIP (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceNetworkingVipAssociate associates the ports of the instances with a virtual IP. The association is stored
+// in the allowed address pairs of the VIP port, which contain the fixed IPs of the associated ports, so a VIP can be
+// managed by only one resource. The VIP itself is kept when the resource is deleted.
+func ResourceNetworkingVipAssociate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingVipAssociateCreate,
+		ReadContext:   resourceNetworkingVipAssociateRead,
+		UpdateContext: resourceNetworkingVipAssociateUpdate,
+		DeleteContext: resourceNetworkingVipAssociateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkingVipAssociateImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vip_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vip_subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// buildNetworkingVipAddressPairs returns the fixed IPs of the ports as the allowed address pairs of the VIP.
+func buildNetworkingVipAddressPairs(client *golangsdk.ServiceClient, vip *ports.Port,
+	portIDs []string) ([]ports.AddressPair, error) {
+	pairs := make([]ports.AddressPair, 0, len(portIDs))
+	for _, portID := range portIDs {
+		port, err := ports.Get(client, portID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving port (%s): %s", portID, err)
+		}
+		if port.NetworkID != vip.NetworkID {
+			return nil, fmt.Errorf("port (%s) does not belong to the network (%s) of the VIP", portID, vip.NetworkID)
+		}
+		if len(port.FixedIPs) == 0 {
+			return nil, fmt.Errorf("port (%s) has no IP address", portID)
+		}
+		pairs = append(pairs, ports.AddressPair{IPAddress: port.FixedIPs[0].IPAddress})
+	}
+	return pairs, nil
+}
+
+func updateNetworkingVipAssociation(client *golangsdk.ServiceClient, vipID string, portIDs []string) error {
+	vip, err := ports.Get(client, vipID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving networking VIP (%s): %s", vipID, err)
+	}
+	pairs, err := buildNetworkingVipAddressPairs(client, vip, portIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = ports.Update(client, vipID, ports.UpdateOpts{AllowedAddressPairs: &pairs}).Extract()
+	if err != nil {
+		return fmt.Errorf("error associating ports with networking VIP (%s): %s", vipID, err)
+	}
+	return nil
+}
+
+func resourceNetworkingVipAssociateCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	vipID := d.Get("vip_id").(string)
+	config.MutexKV.Lock(vipID)
+	defer config.MutexKV.Unlock(vipID)
+
+	portIDs := utils.ExpandToStringList(d.Get("port_ids").([]interface{}))
+	if err := updateNetworkingVipAssociation(client, vipID, portIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(vipID)
+
+	return resourceNetworkingVipAssociateRead(ctx, d, meta)
+}
+
+// flattenNetworkingVipPorts returns the ports of the network whose fixed IPs are in the allowed address pairs of the
+// VIP. The ports which are already in the configuration keep their order.
+func flattenNetworkingVipPorts(client *golangsdk.ServiceClient, vip *ports.Port,
+	configured []string) (portIDs, addresses []string, err error) {
+	pages, err := ports.List(client, ports.ListOpts{NetworkID: vip.NetworkID}).AllPages()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving ports of network (%s): %s", vip.NetworkID, err)
+	}
+	networkPorts, err := ports.ExtractPorts(pages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pairs := make(map[string]bool, len(vip.AllowedAddressPairs))
+	for _, pair := range vip.AllowedAddressPairs {
+		pairs[pair.IPAddress] = true
+	}
+	associated := make(map[string]string)
+	for _, port := range networkPorts {
+		if port.ID != vip.ID && len(port.FixedIPs) > 0 && pairs[port.FixedIPs[0].IPAddress] {
+			associated[port.ID] = port.FixedIPs[0].IPAddress
+		}
+	}
+
+	for _, id := range configured {
+		if address, ok := associated[id]; ok {
+			portIDs = append(portIDs, id)
+			addresses = append(addresses, address)
+			delete(associated, id)
+		}
+	}
+	for id, address := range associated {
+		portIDs = append(portIDs, id)
+		addresses = append(addresses, address)
+	}
+	return portIDs, addresses, nil
+}
+
+func resourceNetworkingVipAssociateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	vip, err := ports.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving networking VIP")
+	}
+
+	configured := utils.ExpandToStringList(d.Get("port_ids").([]interface{}))
+	portIDs, addresses, err := flattenNetworkingVipPorts(client, vip, configured)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(portIDs) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vip_id", vip.ID),
+		d.Set("port_ids", portIDs),
+		d.Set("ip_addresses", addresses),
+	)
+	if len(vip.FixedIPs) > 0 {
+		mErr = multierror.Append(mErr,
+			d.Set("vip_ip_address", vip.FixedIPs[0].IPAddress),
+			d.Set("vip_subnet_id", vip.FixedIPs[0].SubnetID),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting networking VIP associate fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkingVipAssociateUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	config.MutexKV.Lock(d.Id())
+	defer config.MutexKV.Unlock(d.Id())
+
+	portIDs := utils.ExpandToStringList(d.Get("port_ids").([]interface{}))
+	if err := updateNetworkingVipAssociation(client, d.Id(), portIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNetworkingVipAssociateRead(ctx, d, meta)
+}
+
+func resourceNetworkingVipAssociateDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	config.MutexKV.Lock(d.Id())
+	defer config.MutexKV.Unlock(d.Id())
+
+	pairs := make([]ports.AddressPair, 0)
+	_, err = ports.Update(client, d.Id(), ports.UpdateOpts{AllowedAddressPairs: &pairs}).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disassociating ports from networking VIP")
+	}
+
+	return nil
+}
+
+func resourceNetworkingVipAssociateImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, d.Set("vip_id", d.Id())
+}
@@ -0,0 +1,169 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccNetworkingVip_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_networking_vip.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingVipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingVip_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "network_id", "sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id",
+						"sbercloud_vpc_subnet.test", "subnet_id"),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", "192.168.0.100"),
+					resource.TestCheckResourceAttrPair(resourceName, "port_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "mac_address"),
+				),
+			},
+			{
+				Config: testAccNetworkingVip_basic(rName, rName+"-update"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkingVipAssociate_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_networking_vip_associate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingVipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingVipAssociate_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "vip_id", "sbercloud_networking_vip.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "port_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "vip_ip_address",
+						"sbercloud_networking_vip.test", "ip_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingVipDestroy(s *terraform.State) error {
+	conf := testAccProvider.Meta().(*config.Config)
+	client, err := conf.NetworkingV2Client(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_networking_vip" {
+			continue
+		}
+
+		if _, err := ports.Get(client, rs.Primary.ID).Extract(); err == nil {
+			return fmt.Errorf("networking VIP %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNetworkingVip_base(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  vpc_id     = sbercloud_vpc.test.id
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+`, rName)
+}
+
+func testAccNetworkingVip_basic(rName, vipName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_networking_vip" "test" {
+  name       = "%s"
+  network_id = sbercloud_vpc_subnet.test.id
+  subnet_id  = sbercloud_vpc_subnet.test.subnet_id
+  ip_address = "192.168.0.100"
+}
+`, testAccNetworkingVip_base(rName), vipName)
+}
+
+func testAccNetworkingVipAssociate_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_availability_zones" "test" {}
+
+data "sbercloud_compute_flavors" "test" {
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+data "sbercloud_images_image" "test" {
+  name        = "Ubuntu 18.04 server 64bit"
+  most_recent = true
+}
+
+resource "sbercloud_compute_instance" "test" {
+  count = 2
+
+  name              = "%[2]s-${count.index}"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+
+  network {
+    uuid = sbercloud_vpc_subnet.test.id
+  }
+}
+
+resource "sbercloud_networking_vip" "test" {
+  name       = "%[2]s"
+  network_id = sbercloud_vpc_subnet.test.id
+}
+
+resource "sbercloud_networking_vip_associate" "test" {
+  vip_id   = sbercloud_networking_vip.test.id
+  port_ids = sbercloud_compute_instance.test[*].network.0.port
+}
+`, testAccNetworkingVip_base(rName), rName)
+}
@@ -0,0 +1,188 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceObsBucketCorsRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketCorsRulePut,
+		ReadContext:   resourceObsBucketCorsRuleRead,
+		UpdateContext: resourceObsBucketCorsRulePut,
+		DeleteContext: resourceObsBucketCorsRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cors_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"allowed_origins": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"GET", "PUT", "HEAD", "POST", "DELETE",
+								}, false),
+							},
+						},
+						"allowed_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  100,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildObsBucketCorsRules(rawRules []interface{}) []obs.CorsRule {
+	rules := make([]obs.CorsRule, len(rawRules))
+	for i, v := range rawRules {
+		raw := v.(map[string]interface{})
+		rules[i] = obs.CorsRule{
+			ID:            raw["id"].(string),
+			AllowedOrigin: utils.ExpandToStringList(raw["allowed_origins"].([]interface{})),
+			AllowedMethod: utils.ExpandToStringList(raw["allowed_methods"].([]interface{})),
+			AllowedHeader: utils.ExpandToStringList(raw["allowed_headers"].([]interface{})),
+			ExposeHeader:  utils.ExpandToStringList(raw["expose_headers"].([]interface{})),
+			MaxAgeSeconds: raw["max_age_seconds"].(int),
+		}
+	}
+	return rules
+}
+
+func flattenObsBucketCorsRules(rules []obs.CorsRule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = map[string]interface{}{
+			"id":              rule.ID,
+			"allowed_origins": rule.AllowedOrigin,
+			"allowed_methods": rule.AllowedMethod,
+			"allowed_headers": rule.AllowedHeader,
+			"expose_headers":  rule.ExposeHeader,
+			"max_age_seconds": rule.MaxAgeSeconds,
+		}
+	}
+	return result
+}
+
+// resourceObsBucketCorsRulePut is used by both the creation and the update, because the CORS configuration of the
+// bucket is always replaced as a whole.
+func resourceObsBucketCorsRulePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	corsInput := &obs.SetBucketCorsInput{
+		Bucket: bucket,
+		BucketCors: obs.BucketCors{
+			CorsRules: buildObsBucketCorsRules(d.Get("cors_rule").([]interface{})),
+		},
+	}
+	if _, err := obsClient.SetBucketCors(corsInput); err != nil {
+		return diag.Errorf("error setting CORS rules of OBS bucket (%s): %s", bucket, err)
+	}
+	d.SetId(bucket)
+
+	return resourceObsBucketCorsRuleRead(ctx, d, meta)
+}
+
+func resourceObsBucketCorsRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	obsClient, err := conf.ObjectStorageClient(region)
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	output, err := obsClient.GetBucketCors(d.Id())
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok &&
+			(obsError.Code == "NoSuchCORSConfiguration" || obsError.Code == "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving CORS rules of OBS bucket (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("cors_rule", flattenObsBucketCorsRules(output.CorsRules)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket CORS rule fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceObsBucketCorsRuleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	if _, err := obsClient.DeleteBucketCors(d.Id()); err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchBucket" {
+			return nil
+		}
+		return diag.Errorf("error deleting CORS rules of OBS bucket (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccObsBucketCorsRule_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "sbercloud_obs_bucket_cors_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOBS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketCorsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketCorsRule_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists("sbercloud_obs_bucket.bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "sbercloud_obs_bucket.bucket", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.id", "rule-1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccObsBucketCorsRule_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.expose_headers.0", "ETag"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.1.allowed_origins.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.1.max_age_seconds", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketCorsRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	obsClient, err := config.ObjectStorageClient(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating SberCloud OBS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_obs_bucket_cors_rule" {
+			continue
+		}
+
+		_, err := obsClient.GetBucketCors(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("CORS rules of SberCloud OBS bucket %s still exist", rs.Primary.ID)
+		}
+		if obsError, ok := err.(obs.ObsError); !ok ||
+			(obsError.Code != "NoSuchCORSConfiguration" && obsError.Code != "NoSuchBucket") {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccObsBucketCorsRule_basic(randInt int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_cors_rule" "test" {
+  bucket = sbercloud_obs_bucket.bucket.bucket
+
+  cors_rule {
+    id              = "rule-1"
+    allowed_headers = ["*"]
+    allowed_methods = ["PUT", "POST"]
+    allowed_origins = ["https://www.example.com"]
+    max_age_seconds = 3000
+  }
+}
+`, randInt)
+}
+
+func testAccObsBucketCorsRule_update(randInt int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_cors_rule" "test" {
+  bucket = sbercloud_obs_bucket.bucket.bucket
+
+  cors_rule {
+    id              = "rule-1"
+    allowed_headers = ["*"]
+    allowed_methods = ["GET"]
+    allowed_origins = ["https://www.example.com"]
+    expose_headers  = ["ETag"]
+    max_age_seconds = 3000
+  }
+
+  cors_rule {
+    allowed_methods = ["HEAD"]
+    allowed_origins = ["*"]
+  }
+}
+`, randInt)
+}
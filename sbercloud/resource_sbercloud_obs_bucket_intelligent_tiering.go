@@ -0,0 +1,135 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// the objects are transitioned to the infrequent access tier after they are not accessed for the days
+const obsIntelligentTieringAccessTier = "INFREQUENT_ACCESS"
+
+type obsIntelligentTieringConfiguration struct {
+	XMLName    xml.Name `xml:"IntelligentTieringConfiguration"`
+	Status     string   `xml:"Status"`
+	Days       int      `xml:"Tiering>Days"`
+	AccessTier string   `xml:"Tiering>AccessTier"`
+}
+
+func ResourceObsBucketIntelligentTiering() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketIntelligentTieringPut,
+		ReadContext:   resourceObsBucketIntelligentTieringRead,
+		UpdateContext: resourceObsBucketIntelligentTieringPut,
+		DeleteContext: resourceObsBucketIntelligentTieringDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Enabled",
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Suspended"}, false),
+			},
+			"transition_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+		},
+	}
+}
+
+func putObsBucketIntelligentTiering(conf *config.Config, region, bucket, status string, days int) error {
+	body, err := xml.Marshal(obsIntelligentTieringConfiguration{
+		Status:     status,
+		Days:       days,
+		AccessTier: obsIntelligentTieringAccessTier,
+	})
+	if err != nil {
+		return err
+	}
+	queryParams := map[string]string{"intelligent-tiering": ""}
+	_, err = doObsBucketSubResourceRequest(conf, region, "PUT", bucket, queryParams, body)
+	return err
+}
+
+// resourceObsBucketIntelligentTieringPut is used by both the creation and the update, because the intelligent tiering
+// configuration is always replaced as a whole.
+func resourceObsBucketIntelligentTieringPut(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	bucket := d.Get("bucket").(string)
+
+	err := putObsBucketIntelligentTiering(conf, GetRegion(d, conf), bucket, d.Get("status").(string),
+		d.Get("transition_days").(int))
+	if err != nil {
+		return diag.Errorf("error setting intelligent tiering of OBS bucket (%s): %s", bucket, err)
+	}
+	d.SetId(bucket)
+
+	return resourceObsBucketIntelligentTieringRead(ctx, d, meta)
+}
+
+func resourceObsBucketIntelligentTieringRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+
+	queryParams := map[string]string{"intelligent-tiering": ""}
+	respBody, err := doObsBucketSubResourceRequest(conf, region, "GET", d.Id(), queryParams, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving OBS bucket intelligent tiering")
+	}
+
+	var tiering obsIntelligentTieringConfiguration
+	if err := xml.Unmarshal(respBody, &tiering); err != nil {
+		return diag.Errorf("error parsing OBS bucket intelligent tiering: %s", err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("status", tiering.Status),
+		d.Set("transition_days", tiering.Days),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket intelligent tiering fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceObsBucketIntelligentTieringDelete suspends the intelligent tiering, the configuration can't be removed.
+func resourceObsBucketIntelligentTieringDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+
+	err := putObsBucketIntelligentTiering(conf, GetRegion(d, conf), d.Id(), "Suspended",
+		d.Get("transition_days").(int))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error suspending OBS bucket intelligent tiering")
+	}
+
+	return nil
+}
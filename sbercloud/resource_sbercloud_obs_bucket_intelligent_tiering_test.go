@@ -0,0 +1,94 @@
+package sbercloud
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccObsBucketIntelligentTiering_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "sbercloud_obs_bucket_intelligent_tiering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOBS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketIntelligentTieringDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObsBucketIntelligentTiering_basic(rInt, "Enabled", 20),
+				ExpectError: regexp.MustCompile(`expected transition_days to be at least \(30\)`),
+			},
+			{
+				Config: testAccObsBucketIntelligentTiering_basic(rInt, "Enabled", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "sbercloud_obs_bucket.bucket", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "transition_days", "30"),
+				),
+			},
+			{
+				Config: testAccObsBucketIntelligentTiering_basic(rInt, "Suspended", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Suspended"),
+					resource.TestCheckResourceAttr(resourceName, "transition_days", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketIntelligentTieringDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_obs_bucket_intelligent_tiering" {
+			continue
+		}
+
+		respBody, err := doObsBucketSubResourceRequest(config, SBC_REGION_NAME, "GET", rs.Primary.ID,
+			map[string]string{"intelligent-tiering": ""}, nil)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+
+		var tiering obsIntelligentTieringConfiguration
+		if err := xml.Unmarshal(respBody, &tiering); err != nil {
+			return err
+		}
+		if tiering.Status != "Suspended" {
+			return fmt.Errorf("the intelligent tiering of SberCloud OBS bucket %s is not suspended: %s",
+				rs.Primary.ID, tiering.Status)
+		}
+	}
+	return nil
+}
+
+func testAccObsBucketIntelligentTiering_basic(randInt int, status string, days int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_intelligent_tiering" "test" {
+  bucket          = sbercloud_obs_bucket.bucket.bucket
+  status          = "%s"
+  transition_days = %d
+}
+`, randInt, status, days)
+}
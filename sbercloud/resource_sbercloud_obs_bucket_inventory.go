@@ -0,0 +1,368 @@
+package sbercloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+type obsInventoryConfiguration struct {
+	XMLName                xml.Name                `xml:"InventoryConfiguration"`
+	ID                     string                  `xml:"Id"`
+	IsEnabled              bool                    `xml:"IsEnabled"`
+	Filter                 *obsInventoryFilter     `xml:"Filter,omitempty"`
+	Destination            obsInventoryDestination `xml:"Destination>S3BucketDestination"`
+	Frequency              string                  `xml:"Schedule>Frequency"`
+	IncludedObjectVersions string                  `xml:"IncludedObjectVersions"`
+	OptionalFields         []string                `xml:"OptionalFields>Field,omitempty"`
+}
+
+type obsInventoryFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type obsInventoryDestination struct {
+	AccountID  string                  `xml:"AccountId,omitempty"`
+	Bucket     string                  `xml:"Bucket"`
+	Format     string                  `xml:"Format"`
+	Prefix     string                  `xml:"Prefix,omitempty"`
+	Encryption *obsInventoryEncryption `xml:"Encryption,omitempty"`
+}
+
+type obsInventoryEncryption struct {
+	SSES3  *struct{}           `xml:"SSE-S3,omitempty"`
+	SSEKMS *obsInventorySSEKMS `xml:"SSE-KMS,omitempty"`
+}
+
+type obsInventorySSEKMS struct {
+	KeyID string `xml:"KeyId"`
+}
+
+type obsRequestError struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func ResourceObsBucketInventory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketInventoryPut,
+		ReadContext:   resourceObsBucketInventoryRead,
+		UpdateContext: resourceObsBucketInventoryPut,
+		DeleteContext: resourceObsBucketInventoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObsBucketInventoryImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"inventory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Daily", "Weekly"}, false),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "CSV",
+							ValidateFunc: validation.StringInSlice([]string{"CSV"}, false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"account_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"SSE-S3", "SSE-KMS"}, false),
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"included_object_versions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Current",
+				ValidateFunc: validation.StringInSlice([]string{"All", "Current"}, false),
+			},
+			"filter_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"optional_fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// doObsBucketSubResourceRequest sends a request of a bucket sub-resource API. The OBS signature of the SDK does not
+// sign some sub-resources, e.g. inventory and object-lock, so the request is signed with the V4 signature, which
+// covers all the query parameters.
+func doObsBucketSubResourceRequest(conf *config.Config, region, method, bucket string,
+	queryParams map[string]string, body []byte) ([]byte, error) {
+	endpoint := fmt.Sprintf("https://obs.%s.%s/", region, conf.Cloud)
+	if v, ok := conf.Endpoints["obs"]; ok {
+		endpoint = v
+	}
+	obsClient, err := obs.New(conf.AccessKey, conf.SecretKey, endpoint, obs.WithSignature(obs.SignatureV4),
+		obs.WithRegion(region), obs.WithSecurityToken(conf.SecurityToken))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+	defer obsClient.Close()
+
+	headers := make(map[string]string)
+	if body != nil {
+		headers["Content-Type"] = "application/xml"
+		headers["Content-MD5"] = obs.Base64Md5(body)
+	}
+	signed, err := obsClient.CreateSignedUrl(&obs.CreateSignedUrlInput{
+		Method:      obs.HttpMethodType(method),
+		Bucket:      bucket,
+		Headers:     headers,
+		QueryParams: queryParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, signed.SignedUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header = signed.ActualSignedRequestHeaders
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: conf.Insecure},
+		},
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	if resp.StatusCode >= 300 {
+		var obsErr obsRequestError
+		_ = xml.Unmarshal(respBody, &obsErr)
+		return nil, fmt.Errorf("status code: %d, code: %s, message: %s", resp.StatusCode, obsErr.Code,
+			obsErr.Message)
+	}
+	return respBody, nil
+}
+
+func doObsBucketInventoryRequest(conf *config.Config, region, method, bucket, inventoryID string,
+	body []byte) ([]byte, error) {
+	queryParams := map[string]string{
+		"inventory": "",
+		"id":        inventoryID,
+	}
+	return doObsBucketSubResourceRequest(conf, region, method, bucket, queryParams, body)
+}
+
+func buildObsBucketInventoryConfiguration(d *schema.ResourceData) obsInventoryConfiguration {
+	inventory := obsInventoryConfiguration{
+		ID:                     d.Get("inventory_id").(string),
+		IsEnabled:              d.Get("is_enabled").(bool),
+		Frequency:              d.Get("frequency").(string),
+		IncludedObjectVersions: d.Get("included_object_versions").(string),
+		OptionalFields:         utils.ExpandToStringList(d.Get("optional_fields").([]interface{})),
+		Destination: obsInventoryDestination{
+			Bucket:    d.Get("destination.0.bucket").(string),
+			Format:    d.Get("destination.0.format").(string),
+			Prefix:    d.Get("destination.0.prefix").(string),
+			AccountID: d.Get("destination.0.account_id").(string),
+		},
+	}
+	if prefix := d.Get("filter_prefix").(string); prefix != "" {
+		inventory.Filter = &obsInventoryFilter{Prefix: prefix}
+	}
+
+	switch d.Get("destination.0.encryption.0.type").(string) {
+	case "SSE-S3":
+		inventory.Destination.Encryption = &obsInventoryEncryption{SSES3: &struct{}{}}
+	case "SSE-KMS":
+		inventory.Destination.Encryption = &obsInventoryEncryption{
+			SSEKMS: &obsInventorySSEKMS{KeyID: d.Get("destination.0.encryption.0.kms_key_id").(string)},
+		}
+	}
+	return inventory
+}
+
+func flattenObsBucketInventoryDestination(destination obsInventoryDestination) []map[string]interface{} {
+	result := map[string]interface{}{
+		"bucket":     destination.Bucket,
+		"format":     destination.Format,
+		"prefix":     destination.Prefix,
+		"account_id": destination.AccountID,
+	}
+	if encryption := destination.Encryption; encryption != nil {
+		if encryption.SSEKMS != nil {
+			result["encryption"] = []map[string]interface{}{
+				{"type": "SSE-KMS", "kms_key_id": encryption.SSEKMS.KeyID},
+			}
+		} else if encryption.SSES3 != nil {
+			result["encryption"] = []map[string]interface{}{
+				{"type": "SSE-S3"},
+			}
+		}
+	}
+	return []map[string]interface{}{result}
+}
+
+// resourceObsBucketInventoryPut is used by both the creation and the update, because the inventory configuration is
+// always replaced as a whole.
+func resourceObsBucketInventoryPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	bucket := d.Get("bucket").(string)
+	inventoryID := d.Get("inventory_id").(string)
+
+	body, err := xml.Marshal(buildObsBucketInventoryConfiguration(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = doObsBucketInventoryRequest(conf, GetRegion(d, conf), "PUT", bucket, inventoryID, body)
+	if err != nil {
+		return diag.Errorf("error setting inventory (%s) of OBS bucket (%s): %s", inventoryID, bucket, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", bucket, inventoryID))
+
+	return resourceObsBucketInventoryRead(ctx, d, meta)
+}
+
+func resourceObsBucketInventoryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	bucket := d.Get("bucket").(string)
+	inventoryID := d.Get("inventory_id").(string)
+
+	respBody, err := doObsBucketInventoryRequest(conf, region, "GET", bucket, inventoryID, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving OBS bucket inventory")
+	}
+
+	var inventory obsInventoryConfiguration
+	if err := xml.Unmarshal(respBody, &inventory); err != nil {
+		return diag.Errorf("error parsing OBS bucket inventory: %s", err)
+	}
+
+	filterPrefix := ""
+	if inventory.Filter != nil {
+		filterPrefix = inventory.Filter.Prefix
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("is_enabled", inventory.IsEnabled),
+		d.Set("frequency", inventory.Frequency),
+		d.Set("included_object_versions", inventory.IncludedObjectVersions),
+		d.Set("filter_prefix", filterPrefix),
+		d.Set("optional_fields", inventory.OptionalFields),
+		d.Set("destination", flattenObsBucketInventoryDestination(inventory.Destination)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket inventory fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceObsBucketInventoryDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	bucket := d.Get("bucket").(string)
+	inventoryID := d.Get("inventory_id").(string)
+
+	_, err := doObsBucketInventoryRequest(conf, GetRegion(d, conf), "DELETE", bucket, inventoryID, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting OBS bucket inventory")
+	}
+
+	return nil
+}
+
+func resourceObsBucketInventoryImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <bucket>/<inventory_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("bucket", parts[0]),
+		d.Set("inventory_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
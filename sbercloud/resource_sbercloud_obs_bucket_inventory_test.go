@@ -0,0 +1,116 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccObsBucketInventory_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "sbercloud_obs_bucket_inventory.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOBS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketInventoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketInventory_basic(rInt, "Daily", "Current"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketInventoryExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "sbercloud_obs_bucket.source", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "inventory_id", "inventory-1"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "included_object_versions", "Current"),
+					resource.TestCheckResourceAttr(resourceName, "filter_prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "optional_fields.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.bucket",
+						"sbercloud_obs_bucket.destination", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.format", "CSV"),
+				),
+			},
+			{
+				Config: testAccObsBucketInventory_basic(rInt, "Weekly", "All"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketInventoryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Weekly"),
+					resource.TestCheckResourceAttr(resourceName, "included_object_versions", "All"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketInventoryDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_obs_bucket_inventory" {
+			continue
+		}
+
+		_, err := doObsBucketInventoryRequest(config, SBC_REGION_NAME, "GET", rs.Primary.Attributes["bucket"],
+			rs.Primary.Attributes["inventory_id"], nil)
+		if err == nil {
+			return fmt.Errorf("SberCloud OBS bucket inventory %s still exists", rs.Primary.ID)
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckObsBucketInventoryExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		_, err := doObsBucketInventoryRequest(config, SBC_REGION_NAME, "GET", rs.Primary.Attributes["bucket"],
+			rs.Primary.Attributes["inventory_id"], nil)
+		return err
+	}
+}
+
+func testAccObsBucketInventory_basic(randInt int, frequency, versions string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "source" {
+  bucket = "tf-test-bucket-%[1]d"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket" "destination" {
+  bucket = "tf-test-bucket-inventory-%[1]d"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_inventory" "test" {
+  bucket                   = sbercloud_obs_bucket.source.bucket
+  inventory_id             = "inventory-1"
+  frequency                = "%[2]s"
+  included_object_versions = "%[3]s"
+  filter_prefix            = "logs/"
+  optional_fields          = ["Size", "LastModifiedDate"]
+
+  destination {
+    bucket = sbercloud_obs_bucket.destination.bucket
+    prefix = "inventory/"
+  }
+}
+`, randInt, frequency, versions)
+}
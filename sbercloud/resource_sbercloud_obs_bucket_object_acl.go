@@ -0,0 +1,312 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+var obsObjectPermissions = []string{
+	string(obs.PermissionRead), string(obs.PermissionReadAcp), string(obs.PermissionWriteAcp),
+	string(obs.PermissionFullControl),
+}
+
+// ResourceObsBucketObjectAcl manages the ACL of an object. The ACL of the object is reverted to private when the
+// resource is deleted.
+func ResourceObsBucketObjectAcl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketObjectAclPut,
+		ReadContext:   resourceObsBucketObjectAclRead,
+		UpdateContext: resourceObsBucketObjectAclPut,
+		DeleteContext: resourceObsBucketObjectAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObsBucketObjectAclImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"public_permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(obsObjectPermissions, false),
+				},
+			},
+			"owner_permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(obsObjectPermissions, false),
+				},
+			},
+			"account_permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"access_to_bucket": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(obsObjectPermissions, false),
+							},
+						},
+					},
+				},
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func isObsObjectNotFound(err error) bool {
+	obsError, ok := err.(obs.ObsError)
+	return ok && (obsError.StatusCode == 404 || obsError.Code == "NoSuchKey" || obsError.Code == "NoSuchBucket")
+}
+
+func buildObsObjectAclGrants(d *schema.ResourceData, ownerID string) []obs.Grant {
+	grants := make([]obs.Grant, 0)
+	for _, permission := range d.Get("public_permission").(*schema.Set).List() {
+		grants = append(grants, obs.Grant{
+			Grantee: obs.Grantee{
+				Type: obs.GranteeGroup,
+				URI:  obs.GroupAllUsers,
+			},
+			Permission: obs.PermissionType(permission.(string)),
+		})
+	}
+	for _, permission := range d.Get("owner_permission").(*schema.Set).List() {
+		grants = append(grants, obs.Grant{
+			Grantee: obs.Grantee{
+				Type: obs.GranteeUser,
+				ID:   ownerID,
+			},
+			Permission: obs.PermissionType(permission.(string)),
+		})
+	}
+	for _, raw := range d.Get("account_permissions").(*schema.Set).List() {
+		account := raw.(map[string]interface{})
+		for _, permission := range account["access_to_bucket"].(*schema.Set).List() {
+			grants = append(grants, obs.Grant{
+				Grantee: obs.Grantee{
+					Type: obs.GranteeUser,
+					ID:   account["account_id"].(string),
+				},
+				Permission: obs.PermissionType(permission.(string)),
+			})
+		}
+	}
+	return grants
+}
+
+// flattenObsObjectAclGrants reconstructs the permissions from the grants of the ACL, the grants of an account are
+// merged into one block.
+func flattenObsObjectAclGrants(grants []obs.Grant, ownerID string) (public, owner []string,
+	accounts []map[string]interface{}) {
+	public = make([]string, 0)
+	owner = make([]string, 0)
+	accountIDs := make([]string, 0)
+	accountPermissions := make(map[string][]string)
+	for _, grant := range grants {
+		permission := string(grant.Permission)
+		switch {
+		case grant.Grantee.Type == obs.GranteeGroup && strings.HasSuffix(string(grant.Grantee.URI),
+			string(obs.GroupAllUsers)):
+			public = append(public, permission)
+		case grant.Grantee.Type == obs.GranteeUser && grant.Grantee.ID == ownerID:
+			owner = append(owner, permission)
+		case grant.Grantee.Type == obs.GranteeUser:
+			if _, ok := accountPermissions[grant.Grantee.ID]; !ok {
+				accountIDs = append(accountIDs, grant.Grantee.ID)
+			}
+			accountPermissions[grant.Grantee.ID] = append(accountPermissions[grant.Grantee.ID], permission)
+		}
+	}
+
+	accounts = make([]map[string]interface{}, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		accounts = append(accounts, map[string]interface{}{
+			"account_id":       id,
+			"access_to_bucket": accountPermissions[id],
+		})
+	}
+	return
+}
+
+func getObsObjectAcl(obsClient *obs.ObsClient, d *schema.ResourceData) (*obs.GetObjectAclOutput, error) {
+	return obsClient.GetObjectAcl(&obs.GetObjectAclInput{
+		Bucket:    d.Get("bucket").(string),
+		Key:       d.Get("key").(string),
+		VersionId: d.Get("version_id").(string),
+	})
+}
+
+// resourceObsBucketObjectAclPut is used by both the creation and the update, because the whole ACL document is
+// replaced every time.
+func resourceObsBucketObjectAclPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	// the owner is required by the ACL document, and it is only returned by the query of the ACL
+	output, err := getObsObjectAcl(obsClient, d)
+	if err != nil {
+		return diag.Errorf("error retrieving ACL of OBS object (%s/%s): %s", bucket, key, err)
+	}
+
+	input := &obs.SetObjectAclInput{
+		Bucket:    bucket,
+		Key:       key,
+		VersionId: d.Get("version_id").(string),
+		AccessControlPolicy: obs.AccessControlPolicy{
+			Owner: obs.Owner{
+				ID: output.Owner.ID,
+			},
+			Grants: buildObsObjectAclGrants(d, output.Owner.ID),
+		},
+	}
+	if _, err := obsClient.SetObjectAcl(input); err != nil {
+		return diag.Errorf("error setting ACL of OBS object (%s/%s): %s", bucket, key, err)
+	}
+
+	if d.IsNewResource() {
+		id := fmt.Sprintf("%s/%s", bucket, key)
+		if versionID := d.Get("version_id").(string); versionID != "" {
+			id = fmt.Sprintf("%s/%s", id, versionID)
+		}
+		d.SetId(id)
+	}
+
+	return resourceObsBucketObjectAclRead(ctx, d, meta)
+}
+
+func resourceObsBucketObjectAclRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	obsClient, err := conf.ObjectStorageClient(region)
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	output, err := getObsObjectAcl(obsClient, d)
+	if err != nil {
+		if isObsObjectNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving ACL of OBS object (%s): %s", d.Id(), err)
+	}
+
+	public, owner, accounts := flattenObsObjectAclGrants(output.Grants, output.Owner.ID)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("public_permission", public),
+		d.Set("owner_permission", owner),
+		d.Set("account_permissions", accounts),
+		d.Set("owner_id", output.Owner.ID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS object ACL fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceObsBucketObjectAclDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	input := &obs.SetObjectAclInput{
+		Bucket:    d.Get("bucket").(string),
+		Key:       d.Get("key").(string),
+		VersionId: d.Get("version_id").(string),
+		ACL:       obs.AclPrivate,
+	}
+	if _, err := obsClient.SetObjectAcl(input); err != nil {
+		if isObsObjectNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("error resetting ACL of OBS object (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceObsBucketObjectAclImportState accepts both <bucket>/<key> and <bucket>/<key>/<version_id>. Because the key
+// may contain slashes, the last part is only regarded as the version ID if the whole key does not exist.
+func resourceObsBucketObjectAclImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <bucket>/<key> or " +
+			"<bucket>/<key>/<version_id>")
+	}
+
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(GetRegion(d, conf))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket, key, versionID := parts[0], parts[1], ""
+	_, err = obsClient.GetObjectAcl(&obs.GetObjectAclInput{Bucket: bucket, Key: key})
+	if err != nil {
+		index := strings.LastIndex(key, "/")
+		if !isObsObjectNotFound(err) || index == -1 {
+			return nil, fmt.Errorf("error retrieving ACL of OBS object (%s): %s", d.Id(), err)
+		}
+		key, versionID = key[:index], key[index+1:]
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("bucket", bucket),
+		d.Set("key", key),
+		d.Set("version_id", versionID),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
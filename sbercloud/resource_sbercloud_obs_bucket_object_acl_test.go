@@ -0,0 +1,117 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccObsBucketObjectAcl_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "sbercloud_obs_bucket_object_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOBS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketObjectAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectAcl_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "sbercloud_obs_bucket.bucket", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "key", "test-key"),
+					resource.TestCheckResourceAttr(resourceName, "public_permission.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "owner_permission.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccObsBucketObjectAcl_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "public_permission.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "owner_permission.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketObjectAclDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	obsClient, err := config.ObjectStorageClient(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating SberCloud OBS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_obs_bucket_object_acl" {
+			continue
+		}
+
+		output, err := obsClient.GetObjectAcl(&obs.GetObjectAclInput{
+			Bucket: rs.Primary.Attributes["bucket"],
+			Key:    rs.Primary.Attributes["key"],
+		})
+		if err != nil {
+			if isObsObjectNotFound(err) {
+				continue
+			}
+			return err
+		}
+		public, _, accounts := flattenObsObjectAclGrants(output.Grants, output.Owner.ID)
+		if len(public) > 0 || len(accounts) > 0 {
+			return fmt.Errorf("ACL of SberCloud OBS object %s is not reverted to private", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccObsBucketObjectAcl_base(randInt int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_object" "object" {
+  bucket  = sbercloud_obs_bucket.bucket.bucket
+  key     = "test-key"
+  content = "some_bucket_content"
+}
+`, randInt)
+}
+
+func testAccObsBucketObjectAcl_basic(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_object_acl" "test" {
+  bucket            = sbercloud_obs_bucket.bucket.bucket
+  key               = sbercloud_obs_bucket_object.object.key
+  public_permission = ["READ"]
+  owner_permission  = ["FULL_CONTROL"]
+}
+`, testAccObsBucketObjectAcl_base(randInt))
+}
+
+func testAccObsBucketObjectAcl_update(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_object_acl" "test" {
+  bucket           = sbercloud_obs_bucket.bucket.bucket
+  key              = sbercloud_obs_bucket_object.object.key
+  owner_permission = ["READ", "WRITE_ACP"]
+}
+`, testAccObsBucketObjectAcl_base(randInt))
+}
@@ -0,0 +1,124 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceObsBucketRequestPayment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketRequestPaymentPut,
+		ReadContext:   resourceObsBucketRequestPaymentRead,
+		UpdateContext: resourceObsBucketRequestPaymentPut,
+		DeleteContext: resourceObsBucketRequestPaymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"payer": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(obs.BucketOwnerPayer), string(obs.RequesterPayer),
+				}, false),
+			},
+		},
+	}
+}
+
+func setObsBucketPayer(obsClient *obs.ObsClient, bucket string, payer obs.PayerType) error {
+	_, err := obsClient.SetBucketRequestPayment(&obs.SetBucketRequestPaymentInput{
+		Bucket: bucket,
+		BucketPayer: obs.BucketPayer{
+			Payer: payer,
+		},
+	})
+	return err
+}
+
+// resourceObsBucketRequestPaymentPut is used by both the creation and the update, because the payer is the only
+// setting of the request payment configuration.
+func resourceObsBucketRequestPaymentPut(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	if err := setObsBucketPayer(obsClient, bucket, obs.PayerType(d.Get("payer").(string))); err != nil {
+		return diag.Errorf("error setting request payment of OBS bucket (%s): %s", bucket, err)
+	}
+	d.SetId(bucket)
+
+	return resourceObsBucketRequestPaymentRead(ctx, d, meta)
+}
+
+func resourceObsBucketRequestPaymentRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	obsClient, err := conf.ObjectStorageClient(region)
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	output, err := obsClient.GetBucketRequestPayment(d.Id())
+	if err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchBucket" {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving request payment of OBS bucket (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("payer", string(output.Payer)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket request payment fields: %s", err)
+	}
+
+	return nil
+}
+
+// resourceObsBucketRequestPaymentDelete reverts the bucket to the default mode, the bucket owner pays the requests.
+func resourceObsBucketRequestPaymentDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	obsClient, err := conf.ObjectStorageClient(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OBS client: %s", err)
+	}
+
+	if err := setObsBucketPayer(obsClient, d.Id(), obs.BucketOwnerPayer); err != nil {
+		if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchBucket" {
+			return nil
+		}
+		return diag.Errorf("error resetting request payment of OBS bucket (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
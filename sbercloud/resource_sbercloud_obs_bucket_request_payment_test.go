@@ -0,0 +1,84 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/obs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccObsBucketRequestPayment_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "sbercloud_obs_bucket_request_payment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOBS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketRequestPaymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketRequestPayment_basic(rInt, "Requester"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists("sbercloud_obs_bucket.bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "sbercloud_obs_bucket.bucket", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "payer", "Requester"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccObsBucketRequestPayment_basic(rInt, "BucketOwner"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payer", "BucketOwner"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketRequestPaymentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*config.Config)
+	obsClient, err := config.ObjectStorageClient(SBC_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating SberCloud OBS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sbercloud_obs_bucket_request_payment" {
+			continue
+		}
+
+		output, err := obsClient.GetBucketRequestPayment(rs.Primary.ID)
+		if err != nil {
+			if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "NoSuchBucket" {
+				continue
+			}
+			return err
+		}
+		if output.Payer != obs.BucketOwnerPayer {
+			return fmt.Errorf("the payer of SberCloud OBS bucket %s is not reset: %s", rs.Primary.ID, output.Payer)
+		}
+	}
+	return nil
+}
+
+func testAccObsBucketRequestPayment_basic(randInt int, payer string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "bucket" {
+  bucket = "tf-test-bucket-%d"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_request_payment" "test" {
+  bucket = sbercloud_obs_bucket.bucket.bucket
+  payer  = "%s"
+}
+`, randInt, payer)
+}
@@ -0,0 +1,183 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+type obsObjectLockConfiguration struct {
+	XMLName           xml.Name                `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string                  `xml:"ObjectLockEnabled"`
+	Rule              *obsObjectLockRetention `xml:"Rule>DefaultRetention,omitempty"`
+}
+
+type obsObjectLockRetention struct {
+	Mode  string `xml:"Mode"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+var obsObjectLockQueryParams = map[string]string{"object-lock": ""}
+
+// ResourceObsBucketWormPolicy manages the default WORM (write once read many) retention of a bucket. OBS does not
+// allow to disable WORM once it is enabled for a bucket, so deleting the resource only removes the default retention.
+func ResourceObsBucketWormPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObsBucketWormPolicyPut,
+		ReadContext:   resourceObsBucketWormPolicyRead,
+		UpdateContext: resourceObsBucketWormPolicyPut,
+		DeleteContext: resourceObsBucketWormPolicyDelete,
+
+		CustomizeDiff: resourceObsBucketWormPolicyCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"worm_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"retention_period_days": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 36500),
+				ConflictsWith: []string{"retention_period_years"},
+			},
+			"retention_period_years": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceObsBucketWormPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	days := d.Get("retention_period_days").(int)
+	years := d.Get("retention_period_years").(int)
+	if d.Get("worm_enabled").(bool) {
+		if days == 0 && years == 0 {
+			return fmt.Errorf("one of retention_period_days and retention_period_years must be specified when " +
+				"worm_enabled is true")
+		}
+		return nil
+	}
+	if days != 0 || years != 0 {
+		return fmt.Errorf("retention_period_days and retention_period_years can't be specified when worm_enabled " +
+			"is false")
+	}
+	return nil
+}
+
+// buildObsObjectLockConfiguration builds the object-lock configuration, the configuration without a rule keeps the
+// bucket WORM enabled but removes its default retention.
+func buildObsObjectLockConfiguration(d *schema.ResourceData) *obsObjectLockConfiguration {
+	configuration := obsObjectLockConfiguration{
+		ObjectLockEnabled: "Enabled",
+	}
+	if d.Get("worm_enabled").(bool) {
+		configuration.Rule = &obsObjectLockRetention{
+			Mode:  "COMPLIANCE",
+			Days:  d.Get("retention_period_days").(int),
+			Years: d.Get("retention_period_years").(int),
+		}
+	}
+	return &configuration
+}
+
+func putObsBucketObjectLock(conf *config.Config, region, bucket string,
+	configuration *obsObjectLockConfiguration) error {
+	body, err := xml.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+	_, err = doObsBucketSubResourceRequest(conf, region, "PUT", bucket, obsObjectLockQueryParams, body)
+	return err
+}
+
+func resourceObsBucketWormPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	bucket := d.Get("bucket").(string)
+
+	if err := putObsBucketObjectLock(conf, region, bucket, buildObsObjectLockConfiguration(d)); err != nil {
+		return diag.Errorf("error setting WORM policy of OBS bucket (%s): %s", bucket, err)
+	}
+	if d.IsNewResource() {
+		d.SetId(bucket)
+	}
+
+	return resourceObsBucketWormPolicyRead(ctx, d, meta)
+}
+
+func resourceObsBucketWormPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+
+	respBody, err := doObsBucketSubResourceRequest(conf, region, "GET", d.Id(), obsObjectLockQueryParams, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving OBS bucket WORM policy")
+	}
+
+	var configuration obsObjectLockConfiguration
+	if err := xml.Unmarshal(respBody, &configuration); err != nil {
+		return diag.Errorf("error parsing WORM policy of OBS bucket (%s): %s", d.Id(), err)
+	}
+
+	wormEnabled := configuration.Rule != nil
+	days, years := 0, 0
+	if wormEnabled {
+		days, years = configuration.Rule.Days, configuration.Rule.Years
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bucket", d.Id()),
+		d.Set("worm_enabled", wormEnabled),
+		d.Set("retention_period_days", days),
+		d.Set("retention_period_years", years),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OBS bucket WORM policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceObsBucketWormPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+
+	// WORM can't be disabled once it is enabled, the most the deletion can do is to remove the default retention
+	configuration := obsObjectLockConfiguration{
+		ObjectLockEnabled: "Enabled",
+	}
+	if err := putObsBucketObjectLock(conf, region, d.Id(), &configuration); err != nil {
+		return common.CheckDeletedDiag(d, err, fmt.Sprintf("error removing the default retention of OBS bucket "+
+			"(%s), WORM itself can't be disabled for the bucket and the protected objects stay locked until their "+
+			"retention expires", d.Id()))
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package sbercloud
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObsBucketWormPolicy_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "sbercloud_obs_bucket_worm_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckOBS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObsBucketWormPolicy_invalid(rInt),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("one of retention_period_days and retention_period_years must be"),
+			},
+			{
+				Config: testAccObsBucketWormPolicy_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "sbercloud_obs_bucket.bucket", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "worm_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period_days", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccObsBucketWormPolicy_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "worm_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period_days", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObsBucketWormPolicy_base(randInt int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-bucket-%d"
+  acl           = "private"
+  force_destroy = true
+}
+`, randInt)
+}
+
+func testAccObsBucketWormPolicy_invalid(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_worm_policy" "test" {
+  bucket       = sbercloud_obs_bucket.bucket.bucket
+  worm_enabled = true
+}
+`, testAccObsBucketWormPolicy_base(randInt))
+}
+
+func testAccObsBucketWormPolicy_basic(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_worm_policy" "test" {
+  bucket                = sbercloud_obs_bucket.bucket.bucket
+  worm_enabled          = true
+  retention_period_days = 1
+}
+`, testAccObsBucketWormPolicy_base(randInt))
+}
+
+func testAccObsBucketWormPolicy_update(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_obs_bucket_worm_policy" "test" {
+  bucket       = sbercloud_obs_bucket.bucket.bucket
+  worm_enabled = false
+}
+`, testAccObsBucketWormPolicy_base(randInt))
+}
@@ -0,0 +1,627 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
+	v2 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/oms/v2"
+	oms "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/oms/v2/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The statuses of the OMS migration task.
+const (
+	omsTaskStatusWaiting   = "1"
+	omsTaskStatusExecuting = "2"
+	omsTaskStatusFailed    = "4"
+	omsTaskStatusSucceeded = "5"
+)
+
+func ResourceOmsMigrationTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOmsMigrationTaskCreate,
+		ReadContext:   resourceOmsMigrationTaskRead,
+		UpdateContext: resourceOmsMigrationTaskUpdate,
+		DeleteContext: resourceOmsMigrationTaskDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"task_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"object", "prefix", "list", "url_list",
+				}, false),
+			},
+			"src_node": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "HuaweiCloud",
+							ValidateFunc: validation.StringInSlice([]string{
+								"AWS", "Azure", "Aliyun", "Tencent", "HuaweiCloud", "QingCloud", "KingsoftCloud",
+								"Baidu", "Qiniu", "URLSource", "UCloud",
+							}, false),
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ak": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"sk": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							RequiredWith: []string{"src_node.0.ak"},
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"object_key": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_file": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"obs_bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"list_file_key": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"dst_node": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						// the access key and secret key of the provider are used if omitted
+						"ak": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"sk": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							RequiredWith: []string{"dst_node.0.ak"},
+						},
+					},
+				},
+			},
+			"source_cdn": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+						},
+						"authentication_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "NONE",
+							ValidateFunc: validation.StringInSlice([]string{
+								"NONE", "QINIU_PRIVATE_AUTHENTICATION", "ALIYUN_OSS_A", "ALIYUN_OSS_B", "ALIYUN_OSS_C",
+								"KSYUN_PRIVATE_AUTHENTICATION",
+							}, false),
+						},
+						"authentication_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"enable_kms": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"smn_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic_urn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"trigger_conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"FAILURE", "SUCCESS"}, false),
+							},
+						},
+						"language": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "en-us",
+							ValidateFunc: validation.StringInSlice([]string{"zh-cn", "en-us"}, false),
+						},
+					},
+				},
+			},
+			"enable_restore": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enable_failed_object_recording": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"bandwidth_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// in MB/s, the API takes byte/s
+						"max_bandwidth": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 200),
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"progress": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"success_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fail_num": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildOmsTaskSrcNode(rawSrcNode []interface{}) *oms.SrcNodeReq {
+	if len(rawSrcNode) == 0 {
+		return nil
+	}
+	srcNode := rawSrcNode[0].(map[string]interface{})
+
+	result := oms.SrcNodeReq{
+		CloudType: utils.StringIgnoreEmpty(srcNode["cloud_type"].(string)),
+		Region:    utils.StringIgnoreEmpty(srcNode["region"].(string)),
+		Ak:        utils.StringIgnoreEmpty(srcNode["ak"].(string)),
+		Sk:        utils.StringIgnoreEmpty(srcNode["sk"].(string)),
+		Bucket:    utils.StringIgnoreEmpty(srcNode["bucket"].(string)),
+	}
+	if rawKeys := srcNode["object_key"].([]interface{}); len(rawKeys) > 0 {
+		// an empty key means the whole bucket, so it's kept as is
+		keys := make([]string, len(rawKeys))
+		for i, key := range rawKeys {
+			keys[i], _ = key.(string)
+		}
+		result.ObjectKey = &keys
+	}
+	if rawListFile := srcNode["list_file"].([]interface{}); len(rawListFile) > 0 {
+		listFile := rawListFile[0].(map[string]interface{})
+		result.ListFile = &oms.ListFile{
+			ObsBucket:   listFile["obs_bucket"].(string),
+			ListFileKey: listFile["list_file_key"].(string),
+		}
+	}
+	return &result
+}
+
+func buildOmsTaskDstNode(conf *config.Config, rawDstNode []interface{}) (*oms.DstNodeReq, error) {
+	if len(rawDstNode) == 0 {
+		return nil, nil
+	}
+	dstNode := rawDstNode[0].(map[string]interface{})
+
+	result := oms.DstNodeReq{
+		Region: dstNode["region"].(string),
+		Bucket: dstNode["bucket"].(string),
+		Ak:     dstNode["ak"].(string),
+		Sk:     dstNode["sk"].(string),
+	}
+	if result.Ak == "" {
+		if conf.AccessKey == "" || conf.SecretKey == "" {
+			return nil, fmt.Errorf("the ak and sk of dst_node must be specified if the provider doesn't use AK/SK " +
+				"authentication")
+		}
+		result.Ak, result.Sk = conf.AccessKey, conf.SecretKey
+		if conf.SecurityToken != "" {
+			result.SecurityToken = utils.String(conf.SecurityToken)
+		}
+	}
+	return &result, nil
+}
+
+func buildOmsTaskSourceCdn(rawSourceCdn []interface{}) (*oms.SourceCdnReq, error) {
+	if len(rawSourceCdn) == 0 {
+		return nil, nil
+	}
+	sourceCdn := rawSourceCdn[0].(map[string]interface{})
+
+	result := oms.SourceCdnReq{
+		Domain:            sourceCdn["domain"].(string),
+		Protocol:          oms.GetSourceCdnReqProtocolEnum().HTTPS,
+		AuthenticationKey: utils.StringIgnoreEmpty(sourceCdn["authentication_key"].(string)),
+	}
+	if sourceCdn["protocol"].(string) == "http" {
+		result.Protocol = oms.GetSourceCdnReqProtocolEnum().HTTP
+	}
+
+	var authenticationType oms.SourceCdnReqAuthenticationType
+	if err := authenticationType.UnmarshalJSON([]byte(sourceCdn["authentication_type"].(string))); err != nil {
+		return nil, fmt.Errorf("error parsing the authentication_type of source_cdn: %s", err)
+	}
+	result.AuthenticationType = authenticationType
+	return &result, nil
+}
+
+func buildOmsTaskSmnConfig(rawSmnConfig []interface{}) *oms.SmnConfig {
+	if len(rawSmnConfig) == 0 {
+		return nil
+	}
+	smnConfig := rawSmnConfig[0].(map[string]interface{})
+
+	language := oms.GetSmnConfigLanguageEnum().EN_US
+	if smnConfig["language"].(string) == "zh-cn" {
+		language = oms.GetSmnConfigLanguageEnum().ZH_CN
+	}
+	return &oms.SmnConfig{
+		TopicUrn:          smnConfig["topic_urn"].(string),
+		TriggerConditions: utils.ExpandToStringList(smnConfig["trigger_conditions"].([]interface{})),
+		Language:          &language,
+	}
+}
+
+func buildOmsTaskBandwidthPolicy(rawPolicies []interface{}) []oms.BandwidthPolicyDto {
+	result := make([]oms.BandwidthPolicyDto, len(rawPolicies))
+	for i, rawPolicy := range rawPolicies {
+		policy := rawPolicy.(map[string]interface{})
+		result[i] = oms.BandwidthPolicyDto{
+			MaxBandwidth: int64(policy["max_bandwidth"].(int)) * 1024 * 1024,
+			Start:        policy["start"].(string),
+			End:          policy["end"].(string),
+		}
+	}
+	return result
+}
+
+func parseOmsTaskID(id string) (int64, error) {
+	taskID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("the task ID must be an integer: %s", err)
+	}
+	return taskID, nil
+}
+
+func resourceOmsMigrationTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcOmsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OMS client: %s", err)
+	}
+
+	var taskType oms.CreateTaskReqTaskType
+	if err := taskType.UnmarshalJSON([]byte(d.Get("task_type").(string))); err != nil {
+		return diag.Errorf("error parsing the task_type: %s", err)
+	}
+	dstNode, err := buildOmsTaskDstNode(conf, d.Get("dst_node").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sourceCdn, err := buildOmsTaskSourceCdn(d.Get("source_cdn").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createOpts := oms.CreateTaskReq{
+		TaskType:                    &taskType,
+		SrcNode:                     buildOmsTaskSrcNode(d.Get("src_node").([]interface{})),
+		DstNode:                     dstNode,
+		SourceCdn:                   sourceCdn,
+		EnableKms:                   utils.Bool(d.Get("enable_kms").(bool)),
+		Description:                 utils.StringIgnoreEmpty(d.Get("description").(string)),
+		SmnConfig:                   buildOmsTaskSmnConfig(d.Get("smn_config").([]interface{})),
+		EnableRestore:               utils.Bool(d.Get("enable_restore").(bool)),
+		EnableFailedObjectRecording: utils.Bool(d.Get("enable_failed_object_recording").(bool)),
+	}
+	if rawPolicies := d.Get("bandwidth_policy").([]interface{}); len(rawPolicies) > 0 {
+		policies := buildOmsTaskBandwidthPolicy(rawPolicies)
+		createOpts.BandwidthPolicy = &policies
+	}
+
+	resp, err := client.CreateTask(&oms.CreateTaskRequest{Body: &createOpts})
+	if err != nil {
+		return diag.Errorf("error creating OMS migration task: %s", err)
+	}
+	if resp.Id == nil {
+		return diag.Errorf("unable to find the OMS migration task ID from the API response")
+	}
+	d.SetId(strconv.FormatInt(*resp.Id, 10))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"0", omsTaskStatusWaiting},
+		Target:       []string{omsTaskStatusExecuting, omsTaskStatusSucceeded},
+		Refresh:      omsMigrationTaskStatusRefreshFunc(client, *resp.Id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the OMS migration task (%s) to be started: %s", d.Id(), err)
+	}
+
+	return resourceOmsMigrationTaskRead(ctx, d, meta)
+}
+
+func omsMigrationTaskStatusRefreshFunc(client *v2.OmsClient, taskID int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.ShowTask(&oms.ShowTaskRequest{TaskId: taskID})
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.Status == nil {
+			return resp, "", fmt.Errorf("unable to find the status of the task")
+		}
+
+		status := strconv.Itoa(int(*resp.Status))
+		if status == omsTaskStatusFailed {
+			reason := ""
+			if resp.ErrorReason != nil && resp.ErrorReason.ErrorCode != nil {
+				reason = *resp.ErrorReason.ErrorCode
+			}
+			return resp, status, fmt.Errorf("the task failed, error code: %s", reason)
+		}
+		return resp, status, nil
+	}
+}
+
+// flattenOmsTaskSourceCdn keeps the authentication key in the state, it's not returned by the API.
+func flattenOmsTaskSourceCdn(d *schema.ResourceData, sourceCdn *oms.SourceCdnResp) []map[string]interface{} {
+	if sourceCdn == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"domain":             sourceCdn.Domain,
+		"protocol":           sourceCdn.Protocol,
+		"authentication_key": d.Get("source_cdn.0.authentication_key"),
+	}
+	if sourceCdn.AuthenticationType != nil {
+		result["authentication_type"] = sourceCdn.AuthenticationType.Value()
+	}
+	return []map[string]interface{}{result}
+}
+
+func flattenOmsTaskBandwidthPolicy(policies *[]oms.BandwidthPolicyDto) []map[string]interface{} {
+	if policies == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(*policies))
+	for i, policy := range *policies {
+		result[i] = map[string]interface{}{
+			"max_bandwidth": policy.MaxBandwidth / (1024 * 1024),
+			"start":         policy.Start,
+			"end":           policy.End,
+		}
+	}
+	return result
+}
+
+func resourceOmsMigrationTaskRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.HcOmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating OMS client: %s", err)
+	}
+
+	taskID, err := parseOmsTaskID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.ShowTask(&oms.ShowTaskRequest{TaskId: taskID})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving OMS migration task")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("enable_kms", resp.EnableKms),
+		d.Set("description", resp.Description),
+		d.Set("source_cdn", flattenOmsTaskSourceCdn(d, resp.SourceCdn)),
+		d.Set("enable_restore", resp.EnableRestore),
+		d.Set("enable_failed_object_recording", resp.EnableFailedObjectRecording),
+		d.Set("bandwidth_policy", flattenOmsTaskBandwidthPolicy(resp.BandwidthPolicy)),
+		d.Set("name", resp.Name),
+		d.Set("status", resp.Status),
+		d.Set("progress", resp.Progress),
+		d.Set("total_num", resp.TotalNum),
+		d.Set("success_num", resp.SuccessfulNum),
+		d.Set("fail_num", resp.FailedNum),
+	)
+	if resp.TaskType != nil {
+		mErr = multierror.Append(mErr, d.Set("task_type", resp.TaskType.Value()))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OMS migration task fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOmsMigrationTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcOmsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OMS client: %s", err)
+	}
+
+	taskID, err := parseOmsTaskID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("bandwidth_policy") {
+		_, err := client.UpdateBandwidthPolicy(&oms.UpdateBandwidthPolicyRequest{
+			TaskId: taskID,
+			Body: &oms.UpdateBandwidthPolicyReq{
+				BandwidthPolicy: buildOmsTaskBandwidthPolicy(d.Get("bandwidth_policy").([]interface{})),
+			},
+		})
+		if err != nil {
+			return diag.Errorf("error updating the bandwidth policy of OMS migration task (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceOmsMigrationTaskRead(ctx, d, meta)
+}
+
+func resourceOmsMigrationTaskDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcOmsV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OMS client: %s", err)
+	}
+
+	taskID, err := parseOmsTaskID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.ShowTask(&oms.ShowTaskRequest{TaskId: taskID})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving OMS migration task")
+	}
+
+	// the running task must be stopped before the deletion
+	if resp.Status != nil && strconv.Itoa(int(*resp.Status)) == omsTaskStatusExecuting {
+		_, err = client.StopTask(&oms.StopTaskRequest{TaskId: taskID})
+		// OMS.0066 means the task is no longer running
+		if responseErr, ok := err.(*sdkerr.ServiceResponseError); err != nil && (!ok || responseErr.ErrorCode != "OMS.0066") {
+			return diag.Errorf("error stopping OMS migration task (%s): %s", d.Id(), err)
+		}
+	}
+
+	if _, err := client.DeleteTask(&oms.DeleteTaskRequest{TaskId: taskID}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting OMS migration task")
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceOstTicket manages a ticket of the Operator Service Center. The ticket can't be modified after it is
+// submitted, the comment is appended to the ticket as a message and the ticket is closed when the resource is deleted.
+func ResourceOstTicket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOstTicketCreate,
+		ReadContext:   resourceOstTicketRead,
+		UpdateContext: resourceOstTicketUpdate,
+		DeleteContext: resourceOstTicketDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"business_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_category_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"issue_type_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"incident_severity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"attachment_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"contact_way": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ticket_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"handler_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func addOstTicketComment(client *golangsdk.ServiceClient, id, comment string) error {
+	_, err := client.Request("POST", client.ServiceURL("servicerequest", "cases", id, "messages"),
+		&golangsdk.RequestOpts{
+			JSONBody: map[string]interface{}{
+				"content": comment,
+			},
+			OkCodes: []int{200, 201, 204},
+		})
+	return err
+}
+
+func resourceOstTicketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "osm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OSM client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"simple_description":        d.Get("title"),
+		"business_type_id":          d.Get("business_type_id"),
+		"product_category_id":       d.Get("product_category_id"),
+		"incident_business_type_id": valueIgnoreEmpty(d.Get("issue_type_id")),
+		"severity_id":               valueIgnoreEmpty(d.Get("incident_severity")),
+		"description":               valueIgnoreEmpty(d.Get("description")),
+		"attachment_ids":            valueIgnoreEmpty(d.Get("attachment_ids").(*schema.Set).List()),
+		"contact_mobile":            valueIgnoreEmpty(d.Get("phone")),
+		"contact_email":             valueIgnoreEmpty(d.Get("email")),
+	}
+	if v, ok := d.GetOk("contact_way"); ok {
+		createOpts["contact_way"] = v
+	}
+	resp, err := client.Request("POST", client.ServiceURL("servicerequest", "cases"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating OST ticket: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("incident_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the OST ticket ID from the API response")
+	}
+	d.SetId(id)
+
+	if comment, ok := d.GetOk("comment"); ok {
+		if err := addOstTicketComment(client, id, comment.(string)); err != nil {
+			return diag.Errorf("error adding comment to OST ticket (%s): %s", id, err)
+		}
+	}
+
+	return resourceOstTicketRead(ctx, d, meta)
+}
+
+func resourceOstTicketRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "osm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OSM client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("servicerequest", "cases", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving OST ticket")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ticket := pathSearch("case_detail", respBody, nil)
+
+	// the status is a numeric code of the ticket
+	status := pathSearch("status", ticket, nil)
+	if status != nil {
+		status = fmt.Sprint(status)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("title", pathSearch("simple_description", ticket, nil)),
+		d.Set("business_type_id", pathSearch("business_type_id", ticket, nil)),
+		d.Set("product_category_id", pathSearch("product_category_id", ticket, nil)),
+		d.Set("ticket_id", pathSearch("incident_id", ticket, nil)),
+		d.Set("status", status),
+		d.Set("created_at", pathSearch("create_time", ticket, nil)),
+		d.Set("handler_name", pathSearch("handler_name", ticket, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting OST ticket fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOstTicketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "osm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OSM client: %s", err)
+	}
+
+	// the comments are appended to the ticket, removing the comment does nothing
+	if comment, ok := d.GetOk("comment"); ok && d.HasChange("comment") {
+		if err := addOstTicketComment(client, d.Id(), comment.(string)); err != nil {
+			return diag.Errorf("error adding comment to OST ticket (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceOstTicketRead(ctx, d, meta)
+}
+
+func resourceOstTicketDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "osm", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating OSM client: %s", err)
+	}
+
+	// the ticket can't be deleted, it is closed instead
+	_, err = client.Request("PUT", client.ServiceURL("servicerequest", "cases", d.Id(), "operate"),
+		&golangsdk.RequestOpts{
+			JSONBody: map[string]interface{}{
+				"action": "close",
+			},
+			OkCodes: []int{200, 204},
+		})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error closing OST ticket")
+	}
+
+	return nil
+}
@@ -0,0 +1,242 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	blockquotas "github.com/chnsz/golangsdk/openstack/blockstorage/extensions/quotasets"
+	"github.com/chnsz/golangsdk/openstack/compute/v2/extensions/quotasets"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceQuota manages the quotas of a project, which is a singleton per project. The volumes and the snapshots are
+// not part of the Nova quota-sets, they are managed by the quota-sets of the block storage.
+func ResourceQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceQuotaCreate,
+		ReadContext:   resourceQuotaRead,
+		UpdateContext: resourceQuotaUpdate,
+		DeleteContext: resourceQuotaDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceQuotaImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"cores": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"ram": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"floating_ips": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"security_groups": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"security_group_rules": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"key_pairs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"volumes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"snapshots": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+		},
+	}
+}
+
+// quotaValue returns nil if the quota is not specified, so that the quota keeps unchanged. The raw configuration is
+// checked because zero is a valid quota.
+func quotaValue(d *schema.ResourceData, key string) *int {
+	if d.GetRawConfig().GetAttr(key).IsNull() {
+		return nil
+	}
+	value := d.Get(key).(int)
+	return &value
+}
+
+func updateQuotas(conf *config.Config, d *schema.ResourceData, region, projectID string) error {
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return err
+	}
+	computeOpts := quotasets.UpdateOpts{
+		Instances:          quotaValue(d, "instances"),
+		Cores:              quotaValue(d, "cores"),
+		RAM:                quotaValue(d, "ram"),
+		FloatingIPs:        quotaValue(d, "floating_ips"),
+		SecurityGroups:     quotaValue(d, "security_groups"),
+		SecurityGroupRules: quotaValue(d, "security_group_rules"),
+		KeyPairs:           quotaValue(d, "key_pairs"),
+	}
+	if _, err := quotasets.Update(computeClient, projectID, computeOpts).Extract(); err != nil {
+		return err
+	}
+
+	blockStorageOpts := blockquotas.UpdateOpts{
+		Volumes:   quotaValue(d, "volumes"),
+		Snapshots: quotaValue(d, "snapshots"),
+	}
+	if blockStorageOpts.Volumes == nil && blockStorageOpts.Snapshots == nil {
+		return nil
+	}
+	blockStorageClient, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return err
+	}
+	_, err = blockquotas.Update(blockStorageClient, projectID, blockStorageOpts).Extract()
+	return err
+}
+
+func resourceQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		projectID = client.ProjectID
+	}
+	if err := updateQuotas(conf, d, region, projectID); err != nil {
+		return diag.Errorf("error updating the quotas of project (%s): %s", projectID, err)
+	}
+	d.SetId(projectID)
+
+	return resourceQuotaRead(ctx, d, meta)
+}
+
+func resourceQuotaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+	blockStorageClient, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating block storage client: %s", err)
+	}
+
+	computeQuotas, err := quotasets.Get(computeClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving compute quotas")
+	}
+	blockStorageQuotas, err := blockquotas.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving block storage quotas: %s", err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("project_id", d.Id()),
+		d.Set("instances", computeQuotas.Instances),
+		d.Set("cores", computeQuotas.Cores),
+		d.Set("ram", computeQuotas.RAM),
+		d.Set("floating_ips", computeQuotas.FloatingIPs),
+		d.Set("security_groups", computeQuotas.SecurityGroups),
+		d.Set("security_group_rules", computeQuotas.SecurityGroupRules),
+		d.Set("key_pairs", computeQuotas.KeyPairs),
+		d.Set("volumes", blockStorageQuotas.Volumes),
+		d.Set("snapshots", blockStorageQuotas.Snapshots),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting quota fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	if err := updateQuotas(conf, d, GetRegion(d, conf), d.Id()); err != nil {
+		return diag.Errorf("error updating the quotas of project (%s): %s", d.Id(), err)
+	}
+
+	return resourceQuotaRead(ctx, d, meta)
+}
+
+// resourceQuotaDelete resets the quotas of the project to the defaults.
+func resourceQuotaDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	computeClient, err := conf.ComputeV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating compute client: %s", err)
+	}
+	blockStorageClient, err := conf.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating block storage client: %s", err)
+	}
+
+	if err := quotasets.Delete(computeClient, d.Id()).Err; err != nil {
+		return common.CheckDeletedDiag(d, err, "error resetting compute quotas")
+	}
+	if err := blockquotas.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return diag.Errorf("error resetting block storage quotas: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceQuotaImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, d.Set("project_id", d.Id())
+}
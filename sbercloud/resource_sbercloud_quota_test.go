@@ -0,0 +1,87 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/compute/v2/extensions/quotasets"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccQuota_basic(t *testing.T) {
+	var quotas quotasets.QuotaSet
+	resourceName := "sbercloud_quota.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuota_basic(20, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuotaExists(resourceName, &quotas),
+					resource.TestCheckResourceAttr(resourceName, "instances", "20"),
+					resource.TestCheckResourceAttr(resourceName, "cores", "40"),
+					resource.TestCheckResourceAttr(resourceName, "volumes", "20"),
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ram"),
+				),
+			},
+			{
+				Config: testAccQuota_basic(30, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuotaExists(resourceName, &quotas),
+					resource.TestCheckResourceAttr(resourceName, "instances", "30"),
+					resource.TestCheckResourceAttr(resourceName, "cores", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQuotaExists(n string, quotas *quotasets.QuotaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*config.Config)
+		computeClient, err := config.ComputeV2Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating Sbercloud compute client: %s", err)
+		}
+
+		found, err := quotasets.Get(computeClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+		*quotas = *found
+
+		return nil
+	}
+}
+
+func testAccQuota_basic(instances, cores int) string {
+	return fmt.Sprintf(`
+resource "sbercloud_quota" "test" {
+  instances = %d
+  cores     = %d
+  volumes   = 20
+}
+`, instances, cores)
+}
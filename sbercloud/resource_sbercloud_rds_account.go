@@ -0,0 +1,187 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsAccountCreate,
+		ReadContext:   resourceRdsAccountRead,
+		UpdateContext: resourceRdsAccountUpdate,
+		DeleteContext: resourceRdsAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"hosts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+		},
+	}
+}
+
+func resourceRdsAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := map[string]interface{}{
+		"name":     d.Get("name"),
+		"password": d.Get("password"),
+		"comment":  valueIgnoreEmpty(d.Get("description")),
+	}
+	if v, ok := d.GetOk("hosts"); ok {
+		createOpts["hosts"] = v.(*schema.Set).List()
+	}
+	_, err = client.Request("POST", client.ServiceURL("instances", instanceID, "db_user"), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS account: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, d.Get("name")))
+
+	return resourceRdsAccountRead(ctx, d, meta)
+}
+
+func resourceRdsAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.RdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceID, name, err := parseRdsSubResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users, err := listRdsSubResources(client, client.ServiceURL("instances", instanceID, "db_user", "detail"),
+		"users")
+	if err != nil {
+		if isRdsInstanceDeleted(client, instanceID) {
+			d.SetId("")
+			return nil
+		}
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS account")
+	}
+
+	user := pathSearch(fmt.Sprintf("[?name=='%s'] | [0]", name), users, nil)
+	if user == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceID),
+		d.Set("name", name),
+		d.Set("hosts", pathSearch("hosts", user, nil)),
+		d.Set("description", pathSearch("comment", user, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS account fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	if d.HasChange("password") {
+		pwdOpts := map[string]interface{}{
+			"name":     name,
+			"password": d.Get("password"),
+		}
+		_, err = client.Request("POST", client.ServiceURL("instances", instanceID, "db_user", "resetpwd"),
+			&golangsdk.RequestOpts{
+				JSONBody: pwdOpts,
+			})
+		if err != nil {
+			return diag.Errorf("error updating password of RDS account (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("description") {
+		commentOpts := map[string]interface{}{
+			"comment": d.Get("description"),
+		}
+		_, err = client.Request("PUT", client.ServiceURL("instances", instanceID, "db-users", name, "comment"),
+			&golangsdk.RequestOpts{
+				JSONBody: commentOpts,
+			})
+		if err != nil {
+			return diag.Errorf("error updating description of RDS account (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceRdsAccountRead(ctx, d, meta)
+}
+
+func resourceRdsAccountDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	deleteURL := client.ServiceURL("instances", d.Get("instance_id").(string), "db_user", d.Get("name").(string))
+	if _, err := client.Request("DELETE", deleteURL, &golangsdk.RequestOpts{}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RDS account")
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRdsAccount_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	userName := fmt.Sprintf("tf_acc_user_%s", acctest.RandString(5))
+	resourceName := "sbercloud_rds_account.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsSubResourceDestroy("sbercloud_rds_account", "db_user", "users"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsAccount_basic(name, userName, "Test@12345678", "test account"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsSubResourceExists(resourceName, "db_user", "users"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", userName),
+					resource.TestCheckResourceAttr(resourceName, "hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test account"),
+				),
+			},
+			{
+				Config: testAccRdsAccount_basic(name, userName, "Test@123456789", "test account updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsSubResourceExists(resourceName, "db_user", "users"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test account updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccRdsAccount_basic(name, userName, password, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_account" "test" {
+  instance_id = sbercloud_rds_instance.test.id
+  name        = "%s"
+  password    = "%s"
+  hosts       = ["192.168.0.%%"]
+  description = "%s"
+}
+`, testAccRdsMysqlInstance_base(name), userName, password, description)
+}
@@ -0,0 +1,226 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsDatabaseCreate,
+		ReadContext:   resourceRdsDatabaseRead,
+		UpdateContext: resourceRdsDatabaseUpdate,
+		DeleteContext: resourceRdsDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[\$a-z0-9-_]+$`),
+						"the name can only consist of lowercase letters, digits, hyphens (-), underscores (_) "+
+							"and dollar signs ($)"),
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+		},
+	}
+}
+
+// parseRdsSubResourceID splits the ID of the RDS sub-resources, which is in the format <instance_id>/<name>.
+func parseRdsSubResourceID(id string) (instanceID, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = fmt.Errorf("invalid format of the ID (%s), must be <instance_id>/<name>", id)
+		return
+	}
+	return parts[0], parts[1], nil
+}
+
+// isRdsInstanceDeleted returns true if the RDS instance no longer exists or is being deleted, in which case the
+// sub-resources of the instance are removed together with it.
+func isRdsInstanceDeleted(client *golangsdk.ServiceClient, instanceID string) bool {
+	resp, err := client.Request("GET", client.ServiceURL("instances")+"?id="+instanceID, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		_, ok := err.(golangsdk.ErrDefault404)
+		return ok
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return false
+	}
+
+	instance := pathSearch(fmt.Sprintf("instances[?id=='%s'] | [0]", instanceID), respBody, nil)
+	if instance == nil {
+		return true
+	}
+	status := pathSearch("status", instance, "").(string)
+	return status == "DELETING" || status == "DELETED"
+}
+
+// listRdsSubResources queries all the items of the paginated RDS list API, the key is the field name of the items
+// in the response body.
+func listRdsSubResources(client *golangsdk.ServiceClient, url, key string) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+	for page := 1; ; page++ {
+		resp, err := client.Request("GET", fmt.Sprintf("%s?page=%d&limit=100", url, page), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		items := pathSearch(key, respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, items...)
+		if len(items) < 100 {
+			break
+		}
+	}
+	return result, nil
+}
+
+func resourceRdsDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := map[string]interface{}{
+		"name":          d.Get("name"),
+		"character_set": d.Get("character_set"),
+		"comment":       valueIgnoreEmpty(d.Get("description")),
+	}
+	_, err = client.Request("POST", client.ServiceURL("instances", instanceID, "database"), &golangsdk.RequestOpts{
+		JSONBody: utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS database: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, d.Get("name")))
+
+	return resourceRdsDatabaseRead(ctx, d, meta)
+}
+
+func resourceRdsDatabaseRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.RdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceID, name, err := parseRdsSubResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databases, err := listRdsSubResources(client, client.ServiceURL("instances", instanceID, "database", "detail"),
+		"databases")
+	if err != nil {
+		if isRdsInstanceDeleted(client, instanceID) {
+			d.SetId("")
+			return nil
+		}
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS database")
+	}
+
+	database := pathSearch(fmt.Sprintf("[?name=='%s'] | [0]", name), databases, nil)
+	if database == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceID),
+		d.Set("name", name),
+		d.Set("character_set", pathSearch("character_set", database, nil)),
+		d.Set("description", pathSearch("comment", database, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS database fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"name":    d.Get("name"),
+		"comment": d.Get("description"),
+	}
+	updateURL := client.ServiceURL("instances", d.Get("instance_id").(string), "database", "update")
+	_, err = client.Request("POST", updateURL, &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating RDS database (%s): %s", d.Id(), err)
+	}
+
+	return resourceRdsDatabaseRead(ctx, d, meta)
+}
+
+func resourceRdsDatabaseDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	deleteURL := client.ServiceURL("instances", d.Get("instance_id").(string), "database", d.Get("name").(string))
+	if _, err := client.Request("DELETE", deleteURL, &golangsdk.RequestOpts{}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RDS database")
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func TestAccRdsDatabase_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	dbName := fmt.Sprintf("tf_acc_db_%s", acctest.RandString(5))
+	resourceName := "sbercloud_rds_database.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsSubResourceDestroy("sbercloud_rds_database", "database", "databases"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsDatabase_basic(name, dbName, "test database"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsSubResourceExists(resourceName, "database", "databases"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"sbercloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", dbName),
+					resource.TestCheckResourceAttr(resourceName, "character_set", "utf8"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test database"),
+				),
+			},
+			{
+				Config: testAccRdsDatabase_basic(name, dbName, "test database updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsSubResourceExists(resourceName, "database", "databases"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test database updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRdsSubResourceDestroy(rsType, path, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conf := testAccProvider.Meta().(*config.Config)
+		client, err := conf.RdsV3Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating RDS client: %s", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != rsType {
+				continue
+			}
+
+			instanceID, name, err := parseRdsSubResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			items, err := listRdsSubResources(client, client.ServiceURL("instances", instanceID, path, "detail"), key)
+			if err != nil {
+				// the instance has been deleted
+				continue
+			}
+			if pathSearch(fmt.Sprintf("[?name=='%s'] | [0]", name), items, nil) != nil {
+				return fmt.Errorf("%s (%s) still exists", rsType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRdsSubResourceExists(n, path, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		conf := testAccProvider.Meta().(*config.Config)
+		client, err := conf.RdsV3Client(SBC_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating RDS client: %s", err)
+		}
+
+		instanceID, name, err := parseRdsSubResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		items, err := listRdsSubResources(client, client.ServiceURL("instances", instanceID, path, "detail"), key)
+		if err != nil {
+			return err
+		}
+		if pathSearch(fmt.Sprintf("[?name=='%s'] | [0]", name), items, nil) == nil {
+			return fmt.Errorf("%s not found", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccRdsMysqlInstance_base(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_instance" "test" {
+  name              = "%s"
+  flavor            = "rds.mysql.c6.large.2"
+  availability_zone = [data.sbercloud_availability_zones.test.names[0]]
+  security_group_id = sbercloud_networking_secgroup.test.id
+  subnet_id         = sbercloud_vpc_subnet.test.id
+  vpc_id            = sbercloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "MySQL"
+    version  = "8.0"
+    port     = 3306
+  }
+  volume {
+    type = "HIGH"
+    size = 50
+  }
+}
+`, testAccRdsInstanceV3_base(name), name)
+}
+
+func testAccRdsDatabase_basic(name, dbName, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_database" "test" {
+  instance_id   = sbercloud_rds_instance.test.id
+  name          = "%s"
+  character_set = "utf8"
+  description   = "%s"
+}
+`, testAccRdsMysqlInstance_base(name), dbName, description)
+}
@@ -0,0 +1,441 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/rds/v3/instances"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceRdsRestore restores the backup of an RDS instance to a new instance. All the arguments can only be set on
+// creation, and the new instance is deleted together with the resource.
+func ResourceRdsRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsRestoreCreate,
+		ReadContext:   resourceRdsRestoreRead,
+		DeleteContext: resourceRdsRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"backup_id", "restore_time"},
+			},
+			"restore_time": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_az": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"target_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"volume": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_encryption_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"backup_strategy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keep_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"public_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fixed_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ha_replication_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"charging_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildRdsRestoreCreateOpts(d *schema.ResourceData, conf *config.Config,
+	source *instances.RdsInstanceResponse) map[string]interface{} {
+	restorePoint := map[string]interface{}{
+		"instance_id": d.Get("source_instance_id"),
+	}
+	if v, ok := d.GetOk("backup_id"); ok {
+		restorePoint["type"] = "backup"
+		restorePoint["backup_id"] = v
+	} else {
+		restorePoint["type"] = "timestamp"
+		restorePoint["restore_time"] = d.Get("restore_time")
+	}
+
+	flavor := d.Get("target_flavor").(string)
+	createOpts := map[string]interface{}{
+		"name":              d.Get("target_instance_name"),
+		"flavor_ref":        flavor,
+		"availability_zone": d.Get("target_az"),
+		"vpc_id":            d.Get("target_vpc_id"),
+		"subnet_id":         d.Get("target_subnet_id"),
+		"security_group_id": d.Get("target_security_group_id"),
+		// the volume of the new instance cannot be smaller than the volume of the source instance
+		"volume": map[string]interface{}{
+			"type": source.Volume.Type,
+			"size": source.Volume.Size,
+		},
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"restore_point":         restorePoint,
+	}
+	if strings.HasSuffix(flavor, ".ha") {
+		replicationMode := source.Ha.ReplicationMode
+		if replicationMode == "" {
+			replicationMode = "async"
+		}
+		createOpts["ha"] = map[string]interface{}{
+			"mode":             "ha",
+			"replication_mode": replicationMode,
+		}
+	}
+	return utils.RemoveNil(createOpts)
+}
+
+func rdsRestoreStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := rds.GetRdsInstanceByID(client, instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		if instance.Id == "" {
+			return instance, "DELETED", nil
+		}
+		if instance.Status == "FAILED" {
+			return instance, instance.Status, fmt.Errorf("the restoration of the RDS instance failed")
+		}
+		return instance, instance.Status, nil
+	}
+}
+
+func resourceRdsRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	sourceID := d.Get("source_instance_id").(string)
+	source, err := rds.GetRdsInstanceByID(client, sourceID)
+	if err != nil {
+		return diag.Errorf("error retrieving the source RDS instance (%s): %s", sourceID, err)
+	}
+	if source.Id == "" {
+		return diag.Errorf("the source RDS instance (%s) does not exist", sourceID)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("instances"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildRdsRestoreCreateOpts(d, conf, source),
+		OkCodes:          []int{202},
+	})
+	if err != nil {
+		return diag.Errorf("error restoring RDS instance (%s) to a new instance: %s", sourceID, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("instance.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the ID of the restored RDS instance from the API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"BUILD", "RESTORING", "DELETED"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      rdsRestoreStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the restored RDS instance (%s) to be active: %s", id, err)
+	}
+
+	return resourceRdsRestoreRead(ctx, d, meta)
+}
+
+func resourceRdsRestoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.RdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instance, err := rds.GetRdsInstanceByID(client, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the restored RDS instance: %s", err)
+	}
+	if instance.Id == "" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving the restored RDS instance")
+	}
+
+	nodes := make([]map[string]interface{}, len(instance.Nodes))
+	for i, v := range instance.Nodes {
+		nodes[i] = map[string]interface{}{
+			"id":                v.Id,
+			"name":              v.Name,
+			"role":              v.Role,
+			"status":            v.Status,
+			"availability_zone": v.AvailabilityZone,
+		}
+	}
+
+	var fixedIP string
+	// if the restoration failed, the list of the private IPs is empty
+	if len(instance.PrivateIps) > 0 {
+		fixedIP = instance.PrivateIps[0]
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("target_instance_id", instance.Id),
+		d.Set("target_instance_name", instance.Name),
+		d.Set("target_flavor", instance.FlavorRef),
+		d.Set("target_vpc_id", instance.VpcId),
+		d.Set("target_subnet_id", instance.SubnetId),
+		d.Set("target_security_group_id", instance.SecurityGroupId),
+		d.Set("enterprise_project_id", instance.EnterpriseProjectId),
+		d.Set("status", instance.Status),
+		d.Set("db", []map[string]interface{}{
+			{
+				"type":      instance.DataStore.Type,
+				"version":   instance.DataStore.Version,
+				"port":      instance.Port,
+				"user_name": instance.DbUserName,
+			},
+		}),
+		d.Set("volume", []map[string]interface{}{
+			{
+				"type":               instance.Volume.Type,
+				"size":               instance.Volume.Size,
+				"disk_encryption_id": instance.DiskEncryptionId,
+			},
+		}),
+		d.Set("backup_strategy", []map[string]interface{}{
+			{
+				"start_time": instance.BackupStrategy.StartTime,
+				"keep_days":  instance.BackupStrategy.KeepDays,
+			},
+		}),
+		d.Set("nodes", nodes),
+		d.Set("private_ips", instance.PrivateIps),
+		d.Set("public_ips", instance.PublicIps),
+		d.Set("fixed_ip", fixedIP),
+		d.Set("ha_replication_mode", instance.Ha.ReplicationMode),
+		d.Set("time_zone", instance.TimeZone),
+		d.Set("charging_mode", instance.ChargeInfo.ChargeMode),
+		d.Set("tags", utils.TagsToMap(instance.Tags)),
+		d.Set("created", instance.Created),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS restore fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.RdsV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	if err := instances.Delete(client, d.Id()).Err; err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting the restored RDS instance")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"ACTIVE", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      rdsRestoreStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        15 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the restored RDS instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package sbercloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/rds/v3/instances"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRdsRestore_basic(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "sbercloud_rds_restore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckRdsBackup(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy("sbercloud_rds_restore"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsRestore_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_name", name),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_instance_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "db.0.type"),
+					resource.TestCheckResourceAttrSet(resourceName, "volume.0.size"),
+					resource.TestCheckResourceAttrSet(resourceName, "fixed_ip"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsRestore_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_rds_restore" "test" {
+  source_instance_id       = "%s"
+  backup_id                = "%s"
+  target_instance_name     = "%s"
+  target_flavor            = "rds.mysql.c6.large.2"
+  target_vpc_id            = sbercloud_vpc.test.id
+  target_subnet_id         = sbercloud_vpc_subnet.test.id
+  target_security_group_id = sbercloud_networking_secgroup.test.id
+  target_az                = data.sbercloud_availability_zones.test.names[0]
+}
+`, testAccRdsInstanceV3_base(name), SBC_RDS_INSTANCE_ID, SBC_RDS_BACKUP_ID, name)
+}
@@ -0,0 +1,367 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRmsPolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRmsPolicyAssignmentCreate,
+		ReadContext:   resourceRmsPolicyAssignmentRead,
+		UpdateContext: resourceRmsPolicyAssignmentUpdate,
+		DeleteContext: resourceRmsPolicyAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"policy_definition_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_resource_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tag_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tag_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"scope.0.tag_key"},
+						},
+					},
+				},
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"period": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"One_Hour", "Three_Hours", "Six_Hours", "Twelve_Hours", "TwentyFour_Hours",
+				}, false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func rmsPolicyAssignmentsPath(conf *config.Config) string {
+	return fmt.Sprintf("resource-manager/domains/%s/policy-assignments", conf.DomainID)
+}
+
+func buildRmsPolicyAssignmentFilter(d *schema.ResourceData) map[string]interface{} {
+	scopes := d.Get("scope").([]interface{})
+	if len(scopes) == 0 || scopes[0] == nil {
+		return nil
+	}
+
+	scope := scopes[0].(map[string]interface{})
+	return utils.RemoveNil(map[string]interface{}{
+		"resource_types": utils.ExpandToStringList(scope["compliance_resource_types"].([]interface{})),
+		"tag_key":        valueIgnoreEmpty(scope["tag_key"]),
+		"tag_value":      valueIgnoreEmpty(scope["tag_value"]),
+	})
+}
+
+func buildRmsPolicyAssignmentParameters(d *schema.ResourceData) map[string]interface{} {
+	params := make(map[string]interface{})
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		params[k] = map[string]interface{}{
+			"value": v,
+		}
+	}
+	return params
+}
+
+func buildRmsPolicyAssignmentBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"name":                 d.Get("name"),
+		"description":          d.Get("description"),
+		"policy_definition_id": d.Get("policy_definition_id"),
+		"policy_filter":        buildRmsPolicyAssignmentFilter(d),
+		"parameters":           buildRmsPolicyAssignmentParameters(d),
+		"period":               valueIgnoreEmpty(d.Get("period")),
+	}
+	return utils.RemoveNil(params)
+}
+
+func resourceRmsPolicyAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "rms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RMS client: %s", err)
+	}
+
+	resp, err := client.Request("PUT", client.ServiceURL(rmsPolicyAssignmentsPath(conf)), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildRmsPolicyAssignmentBodyParams(d),
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error creating RMS policy assignment: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the RMS policy assignment ID from the API response")
+	}
+	d.SetId(id)
+
+	// it takes a while before the new policy assignment can be queried
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    rmsPolicyAssignmentStateRefreshFunc(client, conf, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for RMS policy assignment (%s) to become available: %s", id, err)
+	}
+
+	if v, ok := d.GetOk("status"); ok && v.(string) == "Disabled" {
+		if err := updateRmsPolicyAssignmentStatus(client, conf, id, "Disabled"); err != nil {
+			return diag.Errorf("error disabling RMS policy assignment (%s): %s", id, err)
+		}
+	}
+
+	return resourceRmsPolicyAssignmentRead(ctx, d, meta)
+}
+
+func getRmsPolicyAssignment(client *golangsdk.ServiceClient, conf *config.Config, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL(rmsPolicyAssignmentsPath(conf), id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func rmsPolicyAssignmentStateRefreshFunc(client *golangsdk.ServiceClient, conf *config.Config,
+	id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getRmsPolicyAssignment(client, conf, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "PENDING", nil
+			}
+			return nil, "", err
+		}
+		return respBody, "COMPLETED", nil
+	}
+}
+
+func updateRmsPolicyAssignmentStatus(client *golangsdk.ServiceClient, conf *config.Config, id, status string) error {
+	action := "enable"
+	if status == "Disabled" {
+		action = "disable"
+	}
+
+	_, err := client.Request("POST", client.ServiceURL(rmsPolicyAssignmentsPath(conf), id, action),
+		&golangsdk.RequestOpts{OkCodes: []int{200, 204}})
+	return err
+}
+
+// getRmsPolicyAssignmentComplianceState returns NonCompliant if any resource in the scope is non-compliant.
+func getRmsPolicyAssignmentComplianceState(client *golangsdk.ServiceClient, conf *config.Config,
+	id string) (string, error) {
+	path := client.ServiceURL(rmsPolicyAssignmentsPath(conf), id, "policy-states") +
+		"?compliance_state=NonCompliant&limit=1"
+	resp, err := client.Request("GET", path, &golangsdk.RequestOpts{KeepResponseBody: true})
+	if err != nil {
+		return "", err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	states := pathSearch("value", respBody, make([]interface{}, 0)).([]interface{})
+	if len(states) > 0 {
+		return "NonCompliant", nil
+	}
+	return "Compliant", nil
+}
+
+func flattenRmsPolicyAssignmentScope(respBody interface{}) []map[string]interface{} {
+	filter := pathSearch("policy_filter", respBody, nil)
+	if filter == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"compliance_resource_types": pathSearch("resource_types", filter, nil),
+			"tag_key":                   pathSearch("tag_key", filter, nil),
+			"tag_value":                 pathSearch("tag_value", filter, nil),
+		},
+	}
+}
+
+func flattenRmsPolicyAssignmentParameters(respBody interface{}) map[string]interface{} {
+	params := pathSearch("parameters", respBody, make(map[string]interface{})).(map[string]interface{})
+	result := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		result[k] = pathSearch("value", v, nil)
+	}
+	return result
+}
+
+func resourceRmsPolicyAssignmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "rms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RMS client: %s", err)
+	}
+
+	respBody, err := getRmsPolicyAssignment(client, conf, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RMS policy assignment")
+	}
+
+	complianceState, err := getRmsPolicyAssignmentComplianceState(client, conf, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the compliance state of RMS policy assignment (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("description", pathSearch("description", respBody, nil)),
+		d.Set("policy_definition_id", pathSearch("policy_definition_id", respBody, nil)),
+		d.Set("scope", flattenRmsPolicyAssignmentScope(respBody)),
+		d.Set("parameters", flattenRmsPolicyAssignmentParameters(respBody)),
+		d.Set("period", pathSearch("period", respBody, nil)),
+		d.Set("status", pathSearch("state", respBody, nil)),
+		d.Set("type", pathSearch("policy_assignment_type", respBody, nil)),
+		d.Set("created_at", pathSearch("created", respBody, nil)),
+		d.Set("updated_at", pathSearch("updated", respBody, nil)),
+		d.Set("compliance_state", complianceState),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RMS policy assignment fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRmsPolicyAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "rms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RMS client: %s", err)
+	}
+
+	if d.HasChanges("description", "scope", "parameters", "period") {
+		_, err = client.Request("PUT", client.ServiceURL(rmsPolicyAssignmentsPath(conf), d.Id()),
+			&golangsdk.RequestOpts{
+				JSONBody: buildRmsPolicyAssignmentBodyParams(d),
+				OkCodes:  []int{200},
+			})
+		if err != nil {
+			return diag.Errorf("error updating RMS policy assignment (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("status") {
+		status := d.Get("status").(string)
+		if err := updateRmsPolicyAssignmentStatus(client, conf, d.Id(), status); err != nil {
+			return diag.Errorf("error updating the status of RMS policy assignment (%s) to %s: %s",
+				d.Id(), status, err)
+		}
+	}
+
+	return resourceRmsPolicyAssignmentRead(ctx, d, meta)
+}
+
+func resourceRmsPolicyAssignmentDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "rms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RMS client: %s", err)
+	}
+
+	// the policy assignment must be disabled before deletion
+	if d.Get("status").(string) != "Disabled" {
+		if err := updateRmsPolicyAssignmentStatus(client, conf, d.Id(), "Disabled"); err != nil {
+			return common.CheckDeletedDiag(d, err, "error disabling RMS policy assignment")
+		}
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL(rmsPolicyAssignmentsPath(conf), d.Id()),
+		&golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RMS policy assignment")
+	}
+
+	return nil
+}
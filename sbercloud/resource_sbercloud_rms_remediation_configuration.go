@@ -0,0 +1,253 @@
+package sbercloud
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRmsRemediationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRmsRemediationConfigurationCreate,
+		ReadContext:   resourceRmsRemediationConfigurationRead,
+		UpdateContext: resourceRmsRemediationConfigurationUpdate,
+		DeleteContext: resourceRmsRemediationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRmsRemediationConfigurationImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_assignment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"fgs", "rfs"}, false),
+			},
+			"target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"automatic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"maximum_attempts": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"retry_attempt_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"static_parameter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"var_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"var_value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: utils.ValidateJsonString,
+							DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+								equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
+								return equal
+							},
+						},
+					},
+				},
+			},
+			"resource_parameter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func rmsRemediationConfigurationPath(d *schema.ResourceData, conf *config.Config) string {
+	return rmsPolicyAssignmentsPath(conf) + "/" + d.Get("policy_assignment_id").(string) +
+		"/remediation-configuration"
+}
+
+func buildRmsRemediationConfigurationBodyParams(d *schema.ResourceData) map[string]interface{} {
+	staticParams := make([]map[string]interface{}, 0)
+	for _, v := range d.Get("static_parameter").([]interface{}) {
+		param := v.(map[string]interface{})
+		// the value has been validated as a JSON string
+		var value interface{}
+		_ = json.Unmarshal([]byte(param["var_value"].(string)), &value)
+		staticParams = append(staticParams, map[string]interface{}{
+			"var_key":   param["var_key"],
+			"var_value": value,
+		})
+	}
+
+	var resourceParam map[string]interface{}
+	if v, ok := d.GetOk("resource_parameter"); ok {
+		resourceParam = map[string]interface{}{
+			"resource_id": v,
+		}
+	}
+
+	params := map[string]interface{}{
+		"target_type":           d.Get("target_type"),
+		"target_id":             d.Get("target_id"),
+		"automatic":             d.Get("automatic"),
+		"maximum_attempts":      valueIgnoreEmpty(d.Get("maximum_attempts")),
+		"retry_attempt_seconds": valueIgnoreEmpty(d.Get("retry_attempt_seconds")),
+		"static_parameter":      staticParams,
+		"resource_parameter":    resourceParam,
+	}
+	return utils.RemoveNil(params)
+}
+
+func putRmsRemediationConfiguration(ctx context.Context, d *schema.ResourceData, conf *config.Config,
+	timeout time.Duration) error {
+	client, err := NewServiceClient(conf, "rms", GetRegion(d, conf))
+	if err != nil {
+		return err
+	}
+
+	// the policy assignment created just now may not be found for a while, so retry the 404 errors
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := client.Request("PUT", client.ServiceURL(rmsRemediationConfigurationPath(d, conf)),
+			&golangsdk.RequestOpts{
+				JSONBody: buildRmsRemediationConfigurationBodyParams(d),
+				OkCodes:  []int{200},
+			})
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func resourceRmsRemediationConfigurationCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	if err := putRmsRemediationConfiguration(ctx, d, conf, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error creating RMS remediation configuration: %s", err)
+	}
+
+	d.SetId(d.Get("policy_assignment_id").(string))
+
+	return resourceRmsRemediationConfigurationRead(ctx, d, meta)
+}
+
+func flattenRmsRemediationStaticParameter(respBody interface{}) []map[string]interface{} {
+	params := pathSearch("static_parameter", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(params))
+	for i, param := range params {
+		value, _ := json.Marshal(pathSearch("var_value", param, nil))
+		result[i] = map[string]interface{}{
+			"var_key":   pathSearch("var_key", param, nil),
+			"var_value": string(value),
+		}
+	}
+	return result
+}
+
+func resourceRmsRemediationConfigurationRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "rms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RMS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL(rmsRemediationConfigurationPath(d, conf)),
+		&golangsdk.RequestOpts{KeepResponseBody: true})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RMS remediation configuration")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("target_type", pathSearch("target_type", respBody, nil)),
+		d.Set("target_id", pathSearch("target_id", respBody, nil)),
+		d.Set("automatic", pathSearch("automatic", respBody, nil)),
+		d.Set("maximum_attempts", pathSearch("maximum_attempts", respBody, nil)),
+		d.Set("retry_attempt_seconds", pathSearch("retry_attempt_seconds", respBody, nil)),
+		d.Set("static_parameter", flattenRmsRemediationStaticParameter(respBody)),
+		d.Set("resource_parameter", pathSearch("resource_parameter.resource_id", respBody, nil)),
+		d.Set("created_at", pathSearch("created_at", respBody, nil)),
+		d.Set("updated_at", pathSearch("updated_at", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RMS remediation configuration fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRmsRemediationConfigurationUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	if err := putRmsRemediationConfiguration(ctx, d, conf, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error updating RMS remediation configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceRmsRemediationConfigurationRead(ctx, d, meta)
+}
+
+func resourceRmsRemediationConfigurationDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "rms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating RMS client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL(rmsRemediationConfigurationPath(d, conf)),
+		&golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RMS remediation configuration")
+	}
+
+	return nil
+}
+
+func resourceRmsRemediationConfigurationImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, d.Set("policy_assignment_id", d.Id())
+}
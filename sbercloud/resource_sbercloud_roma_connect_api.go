@@ -0,0 +1,302 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the API types are represented by numbers in the requests and the responses
+var romaConnectApiTypes = map[string]int{
+	"Public":  1,
+	"Private": 2,
+}
+
+func ResourceRomaConnectApi() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRomaConnectApiCreate,
+		ReadContext:   resourceRomaConnectApiRead,
+		UpdateContext: resourceRomaConnectApiUpdate,
+		DeleteContext: resourceRomaConnectApiDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRomaConnectApiImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Public",
+				ValidateFunc: validation.StringInSlice([]string{"Public", "Private"}, false),
+			},
+			"request_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HTTPS",
+				ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "BOTH"}, false),
+			},
+			"request_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET", "POST", "PUT", "DELETE", "HEAD", "PATCH", "OPTIONS", "ANY",
+				}, false),
+			},
+			"request_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"security_authentication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "APP",
+				ValidateFunc: validation.StringInSlice([]string{"APP", "IAM", "NONE"}, false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"web": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_method": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"request_protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "HTTPS",
+							ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS"}, false),
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5000,
+							ValidateFunc: validation.IntBetween(1, 60000),
+						},
+					},
+				},
+			},
+			"registered_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildRomaConnectApiBodyParams(d *schema.ResourceData) map[string]interface{} {
+	web := d.Get("web").([]interface{})[0].(map[string]interface{})
+	return map[string]interface{}{
+		"group_id":     d.Get("group_id"),
+		"name":         d.Get("name"),
+		"type":         romaConnectApiTypes[d.Get("type").(string)],
+		"req_protocol": d.Get("request_protocol"),
+		"req_method":   d.Get("request_method"),
+		"req_uri":      d.Get("request_path"),
+		"auth_type":    d.Get("security_authentication"),
+		"remark":       d.Get("description"),
+		"backend_type": "HTTP",
+		"backend_api": map[string]interface{}{
+			"req_method":   web["request_method"],
+			"req_protocol": web["request_protocol"],
+			"req_uri":      web["path"],
+			"url_domain":   web["host"],
+			"timeout":      web["timeout"],
+		},
+	}
+}
+
+func flattenRomaConnectApiType(respBody interface{}) string {
+	apiType := int(pathSearch("type", respBody, float64(0)).(float64))
+	for name, value := range romaConnectApiTypes {
+		if value == apiType {
+			return name
+		}
+	}
+	return ""
+}
+
+func flattenRomaConnectApiWeb(respBody interface{}) []map[string]interface{} {
+	backend := pathSearch("backend_api", respBody, nil)
+	if backend == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"request_method":   pathSearch("req_method", backend, nil),
+			"request_protocol": pathSearch("req_protocol", backend, nil),
+			"path":             pathSearch("req_uri", backend, nil),
+			"host":             pathSearch("url_domain", backend, nil),
+			"timeout":          pathSearch("timeout", backend, nil),
+		},
+	}
+}
+
+func resourceRomaConnectApiCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createURL := client.ServiceURL("instances", instanceID, "apic", "apis")
+	resp, err := client.Request("POST", createURL, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(buildRomaConnectApiBodyParams(d)),
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect API: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the ROMA Connect API ID from the API response")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, id))
+
+	return resourceRomaConnectApiRead(ctx, d, meta)
+}
+
+func resourceRomaConnectApiRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "roma", region)
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	apiID := strings.TrimPrefix(d.Id(), instanceID+"/")
+	resp, err := client.Request("GET", client.ServiceURL("instances", instanceID, "apic", "apis", apiID),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ROMA Connect API")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("group_id", pathSearch("group_id", respBody, nil)),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("type", flattenRomaConnectApiType(respBody)),
+		d.Set("request_protocol", pathSearch("req_protocol", respBody, nil)),
+		d.Set("request_method", pathSearch("req_method", respBody, nil)),
+		d.Set("request_path", pathSearch("req_uri", respBody, nil)),
+		d.Set("security_authentication", pathSearch("auth_type", respBody, nil)),
+		d.Set("description", pathSearch("remark", respBody, nil)),
+		d.Set("web", flattenRomaConnectApiWeb(respBody)),
+		d.Set("registered_at", pathSearch("register_time", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ROMA Connect API fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRomaConnectApiUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	apiID := strings.TrimPrefix(d.Id(), instanceID+"/")
+	_, err = client.Request("PUT", client.ServiceURL("instances", instanceID, "apic", "apis", apiID),
+		&golangsdk.RequestOpts{
+			JSONBody: utils.RemoveNil(buildRomaConnectApiBodyParams(d)),
+		})
+	if err != nil {
+		return diag.Errorf("error updating ROMA Connect API (%s): %s", apiID, err)
+	}
+
+	return resourceRomaConnectApiRead(ctx, d, meta)
+}
+
+func resourceRomaConnectApiDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	apiID := strings.TrimPrefix(d.Id(), instanceID+"/")
+	if _, err := client.Request("DELETE", client.ServiceURL("instances", instanceID, "apic", "apis", apiID),
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting ROMA Connect API")
+	}
+
+	return nil
+}
+
+func resourceRomaConnectApiImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<id>")
+	}
+
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -0,0 +1,190 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRomaConnectApp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRomaConnectAppCreate,
+		ReadContext:   resourceRomaConnectAppRead,
+		UpdateContext: resourceRomaConnectAppUpdate,
+		DeleteContext: resourceRomaConnectAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRomaConnectAppImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"app_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"app_secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRomaConnectAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": valueIgnoreEmpty(d.Get("description")),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("instances", instanceID, "apps"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect application: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the ROMA Connect application ID from the API response")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, id))
+
+	return resourceRomaConnectAppRead(ctx, d, meta)
+}
+
+func resourceRomaConnectAppRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "roma", region)
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	appID := strings.TrimPrefix(d.Id(), instanceID+"/")
+	resp, err := client.Request("GET", client.ServiceURL("instances", instanceID, "apps", appID),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ROMA Connect application")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", respBody, nil)),
+		d.Set("description", pathSearch("remark", respBody, nil)),
+		d.Set("app_key", pathSearch("key", respBody, nil)),
+		d.Set("app_secret", pathSearch("secret", respBody, nil)),
+		d.Set("created_at", pathSearch("create_time", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ROMA Connect application fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRomaConnectAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	appID := strings.TrimPrefix(d.Id(), instanceID+"/")
+	updateOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("instances", instanceID, "apps", appID), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating ROMA Connect application (%s): %s", appID, err)
+	}
+
+	return resourceRomaConnectAppRead(ctx, d, meta)
+}
+
+func resourceRomaConnectAppDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	appID := strings.TrimPrefix(d.Id(), instanceID+"/")
+	if _, err := client.Request("DELETE", client.ServiceURL("instances", instanceID, "apps", appID),
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200, 204},
+		}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting ROMA Connect application")
+	}
+
+	return nil
+}
+
+func resourceRomaConnectAppImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<id>")
+	}
+
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
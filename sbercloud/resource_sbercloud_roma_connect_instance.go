@@ -0,0 +1,316 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRomaConnectInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRomaConnectInstanceCreate,
+		ReadContext:   resourceRomaConnectInstanceRead,
+		UpdateContext: resourceRomaConnectInstanceUpdate,
+		DeleteContext: resourceRomaConnectInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"bandwidth_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"maintain_begin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"maintain_end"},
+			},
+			"maintain_end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"maintain_begin"},
+			},
+			"enable_filebeat": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ingress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"public_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRomaConnectInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"flavor_id":             d.Get("flavor_id"),
+		"vpc_id":                d.Get("vpc_id"),
+		"subnet_id":             d.Get("subnet_id"),
+		"security_group_id":     d.Get("security_group_id"),
+		"available_zone_ids":    d.Get("availability_zones"),
+		"bandwidth_size":        valueIgnoreEmpty(d.Get("bandwidth_size")),
+		"description":           valueIgnoreEmpty(d.Get("description")),
+		"maintain_begin":        valueIgnoreEmpty(d.Get("maintain_begin")),
+		"maintain_end":          valueIgnoreEmpty(d.Get("maintain_end")),
+		"enable_filebeat":       d.Get("enable_filebeat"),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"tags":                  valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("instances"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+		OkCodes:          []int{200, 201, 202},
+	})
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect instance: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the ROMA Connect instance ID from the API response")
+	}
+	d.SetId(id)
+
+	// the provisioning takes about 10 to 20 minutes
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING"},
+		Target:       []string{"RUNNING"},
+		Refresh:      romaConnectInstanceStateRefreshFunc(client, id),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Minute,
+		PollInterval: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the ROMA Connect instance (%s) to be running: %s", id, err)
+	}
+
+	return resourceRomaConnectInstanceRead(ctx, d, meta)
+}
+
+func getRomaConnectInstance(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("instances", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func romaConnectInstanceStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getRomaConnectInstance(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := pathSearch("status", instance, "").(string)
+		if status == "CREATE_FAILED" || status == "ERROR" {
+			return instance, status, fmt.Errorf("the ROMA Connect instance is in %s status", status)
+		}
+		return instance, status, nil
+	}
+}
+
+func resourceRomaConnectInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "roma", region)
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	instance, err := getRomaConnectInstance(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ROMA Connect instance")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", instance, nil)),
+		d.Set("flavor_id", pathSearch("flavor_id", instance, nil)),
+		d.Set("vpc_id", pathSearch("vpc_id", instance, nil)),
+		d.Set("subnet_id", pathSearch("subnet_id", instance, nil)),
+		d.Set("security_group_id", pathSearch("security_group_id", instance, nil)),
+		d.Set("availability_zones", pathSearch("available_zone_ids", instance, nil)),
+		d.Set("bandwidth_size", pathSearch("bandwidth_size", instance, nil)),
+		d.Set("description", pathSearch("description", instance, nil)),
+		d.Set("maintain_begin", pathSearch("maintain_begin", instance, nil)),
+		d.Set("maintain_end", pathSearch("maintain_end", instance, nil)),
+		d.Set("enable_filebeat", pathSearch("enable_filebeat", instance, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", instance, nil)),
+		d.Set("tags", flattenResponseTags("tags", instance)),
+		d.Set("status", pathSearch("status", instance, nil)),
+		d.Set("instance_id", pathSearch("id", instance, nil)),
+		d.Set("ingress_ip", pathSearch("ingress_ip", instance, nil)),
+		d.Set("private_ips", pathSearch("private_ips", instance, nil)),
+		d.Set("public_ips", pathSearch("publicips[*].ip_address", instance, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting ROMA Connect instance fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRomaConnectInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "security_group_id", "maintain_begin", "maintain_end") {
+		updateOpts := map[string]interface{}{
+			"name":              d.Get("name"),
+			"description":       d.Get("description"),
+			"security_group_id": d.Get("security_group_id"),
+			"maintain_begin":    valueIgnoreEmpty(d.Get("maintain_begin")),
+			"maintain_end":      valueIgnoreEmpty(d.Get("maintain_end")),
+		}
+		_, err = client.Request("PUT", client.ServiceURL("instances", d.Id()), &golangsdk.RequestOpts{
+			JSONBody: utils.RemoveNil(updateOpts),
+		})
+		if err != nil {
+			return diag.Errorf("error updating ROMA Connect instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := utils.UpdateResourceTags(client, d, "instances", d.Id()); err != nil {
+			return diag.Errorf("error updating tags of ROMA Connect instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceRomaConnectInstanceRead(ctx, d, meta)
+}
+
+func resourceRomaConnectInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "roma", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating ROMA Connect client: %s", err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("instances", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting ROMA Connect instance")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"RUNNING", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      romaConnectInstanceStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the ROMA Connect instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceSdrsProtectedInstance manages the protected instances of an SDRS protection group. The target server is
+// created by SDRS in the target availability zone of the group.
+func ResourceSdrsProtectedInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSdrsProtectedInstanceCreate,
+		ReadContext:   resourceSdrsProtectedInstanceRead,
+		UpdateContext: resourceSdrsProtectedInstanceUpdate,
+		DeleteContext: resourceSdrsProtectedInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"primary_subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"primary_ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"primary_subnet_id"},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			// the following two arguments only take effect when the protected instance is deleted
+			"delete_target_server": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_target_eip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"target_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"progress": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSdrsProtectedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"protected_instance": utils.RemoveNil(map[string]interface{}{
+			"server_group_id":    d.Get("group_id"),
+			"server_id":          d.Get("server_id"),
+			"name":               d.Get("name"),
+			"description":        valueIgnoreEmpty(d.Get("description")),
+			"cluster_id":         valueIgnoreEmpty(d.Get("cluster_id")),
+			"primary_subnet_id":  valueIgnoreEmpty(d.Get("primary_subnet_id")),
+			"primary_ip_address": valueIgnoreEmpty(d.Get("primary_ip_address")),
+		}),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("protected-instances"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error creating SDRS protected instance: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobID := pathSearch("job_id", respBody, "").(string)
+	job, err := waitForSdrsJob(ctx, client, jobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the SDRS protected instance to be created: %s", err)
+	}
+	id := pathSearch("entities.protected_instance_id", job, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the SDRS protected instance ID from the job (%s)", jobID)
+	}
+	d.SetId(id)
+
+	return resourceSdrsProtectedInstanceRead(ctx, d, meta)
+}
+
+func resourceSdrsProtectedInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "sdrs", region)
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("protected-instances", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SDRS protected instance")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instance := pathSearch("protected_instance", respBody, nil)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", instance, nil)),
+		d.Set("group_id", pathSearch("server_group_id", instance, nil)),
+		d.Set("server_id", pathSearch("source_server", instance, nil)),
+		d.Set("description", pathSearch("description", instance, nil)),
+		d.Set("target_server", pathSearch("target_server", instance, nil)),
+		d.Set("status", pathSearch("status", instance, nil)),
+		d.Set("progress", pathSearch("progress", instance, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SDRS protected instance fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSdrsProtectedInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		updateOpts := map[string]interface{}{
+			"protected_instance": map[string]interface{}{
+				"name": d.Get("name"),
+			},
+		}
+		_, err = client.Request("PUT", client.ServiceURL("protected-instances", d.Id()), &golangsdk.RequestOpts{
+			JSONBody: updateOpts,
+			OkCodes:  []int{200},
+		})
+		if err != nil {
+			return diag.Errorf("error updating SDRS protected instance (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceSdrsProtectedInstanceRead(ctx, d, meta)
+}
+
+func resourceSdrsProtectedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	deleteOpts := map[string]interface{}{
+		"delete_target_server": d.Get("delete_target_server"),
+		"delete_target_eip":    d.Get("delete_target_eip"),
+	}
+	resp, err := client.Request("DELETE", client.ServiceURL("protected-instances", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         deleteOpts,
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting SDRS protected instance")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobID := pathSearch("job_id", respBody, "").(string)
+	if _, err := waitForSdrsJob(ctx, client, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the SDRS protected instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceSdrsProtectionGroup manages the SDRS protection groups. The failover and the failback of a group are
+// lifecycle actions rather than CRUD operations, so they are not managed by this resource.
+func ResourceSdrsProtectionGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSdrsProtectionGroupCreate,
+		ReadContext:   resourceSdrsProtectionGroupRead,
+		UpdateContext: resourceSdrsProtectionGroupUpdate,
+		DeleteContext: resourceSdrsProtectionGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[\w-]+$`),
+						"only letters, digits, underscores (_) and hyphens (-) are allowed"),
+				),
+			},
+			"source_availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"dr_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "migration",
+				ValidateFunc: validation.StringInSlice([]string{"migration"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"progress": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// waitForSdrsJob waits for the asynchronous job of SDRS to succeed and returns the job detail.
+func waitForSdrsJob(ctx context.Context, client *golangsdk.ServiceClient, jobID string,
+	timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"INIT", "RUNNING"},
+		Target:  []string{"SUCCESS"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Request("GET", client.ServiceURL("jobs", jobID), &golangsdk.RequestOpts{
+				KeepResponseBody: true,
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			job, err := utils.FlattenResponse(resp)
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := pathSearch("status", job, "").(string)
+			if status == "FAIL" {
+				return job, status, fmt.Errorf("the job failed: %v", pathSearch("fail_reason", job, ""))
+			}
+			return job, status, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func resourceSdrsProtectionGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"server_group": utils.RemoveNil(map[string]interface{}{
+			"name":                     d.Get("name"),
+			"description":              valueIgnoreEmpty(d.Get("description")),
+			"source_availability_zone": d.Get("source_availability_zone"),
+			"target_availability_zone": d.Get("target_availability_zone"),
+			"domain_id":                d.Get("domain_id"),
+			"source_vpc_id":            d.Get("source_vpc_id"),
+			"dr_type":                  d.Get("dr_type"),
+		}),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("server-groups"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error creating SDRS protection group: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobID := pathSearch("job_id", respBody, "").(string)
+	job, err := waitForSdrsJob(ctx, client, jobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the SDRS protection group to be created: %s", err)
+	}
+	id := pathSearch("entities.server_group_id", job, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the SDRS protection group ID from the job (%s)", jobID)
+	}
+	d.SetId(id)
+
+	return resourceSdrsProtectionGroupRead(ctx, d, meta)
+}
+
+func resourceSdrsProtectionGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "sdrs", region)
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("server-groups", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SDRS protection group")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	group := pathSearch("server_group", respBody, nil)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", group, nil)),
+		d.Set("description", pathSearch("description", group, nil)),
+		d.Set("source_availability_zone", pathSearch("source_availability_zone", group, nil)),
+		d.Set("target_availability_zone", pathSearch("target_availability_zone", group, nil)),
+		d.Set("domain_id", pathSearch("domain_id", group, nil)),
+		d.Set("source_vpc_id", pathSearch("source_vpc_id", group, nil)),
+		d.Set("dr_type", pathSearch("dr_type", group, nil)),
+		d.Set("status", pathSearch("status", group, nil)),
+		d.Set("health_status", pathSearch("health_status", group, nil)),
+		d.Set("progress", pathSearch("progress", group, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SDRS protection group fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSdrsProtectionGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	// only the name of the protection group can be updated
+	updateOpts := map[string]interface{}{
+		"server_group": map[string]interface{}{
+			"name": d.Get("name"),
+		},
+	}
+	_, err = client.Request("PUT", client.ServiceURL("server-groups", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+		OkCodes:  []int{200},
+	})
+	if err != nil {
+		return diag.Errorf("error updating SDRS protection group (%s): %s", d.Id(), err)
+	}
+
+	return resourceSdrsProtectionGroupRead(ctx, d, meta)
+}
+
+func resourceSdrsProtectionGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	resp, err := client.Request("DELETE", client.ServiceURL("server-groups", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting SDRS protection group")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobID := pathSearch("job_id", respBody, "").(string)
+	if _, err := waitForSdrsJob(ctx, client, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the SDRS protection group (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
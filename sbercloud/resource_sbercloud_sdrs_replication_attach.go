@@ -0,0 +1,227 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceSdrsReplicationAttach attaches a replication pair to a protected instance, the disks of the pair are
+// attached to the production and the DR servers at the same time.
+func ResourceSdrsReplicationAttach() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSdrsReplicationAttachCreate,
+		ReadContext:   resourceSdrsReplicationAttachRead,
+		DeleteContext: resourceSdrsReplicationAttachDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSdrsReplicationAttachImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"protected_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"replication_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"device": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replication_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getSdrsResource(client *golangsdk.ServiceClient, path, id, key string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL(path, id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return pathSearch(key, respBody, nil), nil
+}
+
+// checkSdrsReplicationAttachable checks the protection group of the protected instance before the attachment, the
+// API only reports a vague job failure when the replication of the group is not active.
+func checkSdrsReplicationAttachable(client *golangsdk.ServiceClient, instanceID string) error {
+	instance, err := getSdrsResource(client, "protected-instances", instanceID, "protected_instance")
+	if err != nil {
+		return fmt.Errorf("error retrieving SDRS protected instance (%s): %s", instanceID, err)
+	}
+	groupID := pathSearch("server_group_id", instance, "").(string)
+	group, err := getSdrsResource(client, "server-groups", groupID, "server_group")
+	if err != nil {
+		return fmt.Errorf("error retrieving SDRS protection group (%s): %s", groupID, err)
+	}
+
+	if protectedStatus := pathSearch("protected_status", group, "").(string); protectedStatus != "started" {
+		return fmt.Errorf("the protected instance (%s) belongs to the protection group (%s) whose replication is "+
+			"not active (protected status: %s), please enable the protection of the group first", instanceID,
+			groupID, protectedStatus)
+	}
+	return nil
+}
+
+func resourceSdrsReplicationAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	instanceID := d.Get("protected_instance_id").(string)
+	replicationID := d.Get("replication_id").(string)
+	if err := checkSdrsReplicationAttachable(client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	attachOpts := map[string]interface{}{
+		"protected_instance": map[string]interface{}{
+			"replication_id": replicationID,
+			"device":         d.Get("device"),
+		},
+	}
+	resp, err := client.Request("POST", client.ServiceURL("protected-instances", instanceID, "attachreplication"),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			JSONBody:         attachOpts,
+			OkCodes:          []int{200},
+		})
+	if err != nil {
+		return diag.Errorf("error attaching SDRS replication pair (%s) to protected instance (%s): %s",
+			replicationID, instanceID, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobID := pathSearch("job_id", respBody, "").(string)
+	if _, err := waitForSdrsJob(ctx, client, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the SDRS replication pair (%s) to be attached: %s", replicationID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, replicationID))
+
+	return resourceSdrsReplicationAttachRead(ctx, d, meta)
+}
+
+func resourceSdrsReplicationAttachRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "sdrs", region)
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	instanceID := d.Get("protected_instance_id").(string)
+	replicationID := d.Get("replication_id").(string)
+	instance, err := getSdrsResource(client, "protected-instances", instanceID, "protected_instance")
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SDRS protected instance")
+	}
+	expression := fmt.Sprintf("attachment[?replication=='%s']|[0]", replicationID)
+	attachment := pathSearch(expression, instance, nil)
+	if attachment == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving SDRS replication attachment")
+	}
+
+	replication, err := getSdrsResource(client, "replications", replicationID, "replication")
+	if err != nil {
+		return diag.Errorf("error retrieving SDRS replication pair (%s): %s", replicationID, err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("device", pathSearch("device", attachment, nil)),
+		d.Set("status", pathSearch("status", replication, nil)),
+		d.Set("replication_model", pathSearch("replication_model", replication, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SDRS replication attachment fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSdrsReplicationAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sdrs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SDRS client: %s", err)
+	}
+
+	instanceID := d.Get("protected_instance_id").(string)
+	replicationID := d.Get("replication_id").(string)
+	resp, err := client.Request("DELETE", client.ServiceURL("protected-instances", instanceID, "detachreplication",
+		replicationID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error detaching SDRS replication pair")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	jobID := pathSearch("job_id", respBody, "").(string)
+	if _, err := waitForSdrsJob(ctx, client, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the SDRS replication pair (%s) to be detached: %s", replicationID, err)
+	}
+
+	return nil
+}
+
+func resourceSdrsReplicationAttachImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <protected_instance_id>/<replication_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("protected_instance_id", parts[0]),
+		d.Set("replication_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,323 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceSecMasterAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSecMasterAlertCreate,
+		ReadContext:   resourceSecMasterAlertRead,
+		UpdateContext: resourceSecMasterAlertUpdate,
+		DeleteContext: resourceSecMasterAlertDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecMasterAlertImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"alert_type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"alert_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"severity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Tips", "Low", "Medium", "High", "Fatal",
+				}, false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Open",
+				ValidateFunc: validation.StringInSlice([]string{"Open", "Block", "Closed"}, false),
+			},
+			"data_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"product_feature": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"source_type": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 3),
+						},
+					},
+				},
+			},
+			"arrive_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"first_occurrence_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"occurrence_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func secMasterAlertsPath(d *schema.ResourceData) string {
+	return fmt.Sprintf("workspaces/%s/soc/alerts", d.Get("workspace_id").(string))
+}
+
+func buildSecMasterAlertType(d *schema.ResourceData) map[string]interface{} {
+	alertType := d.Get("alert_type").([]interface{})[0].(map[string]interface{})
+	return map[string]interface{}{
+		"category":   alertType["category"],
+		"alert_type": alertType["alert_type"],
+	}
+}
+
+func buildSecMasterAlertDataSource(d *schema.ResourceData) map[string]interface{} {
+	dataSource := d.Get("data_source").([]interface{})[0].(map[string]interface{})
+	return map[string]interface{}{
+		"product_name":    dataSource["product_name"],
+		"product_feature": dataSource["product_feature"],
+		"source_type":     dataSource["source_type"],
+	}
+}
+
+func buildSecMasterAlertBodyParams(d *schema.ResourceData, conf *config.Config,
+	client *golangsdk.ServiceClient, region string) map[string]interface{} {
+	dataObject := map[string]interface{}{
+		"version":             "1.0",
+		"workspace_id":        d.Get("workspace_id"),
+		"domain_id":           conf.DomainID,
+		"project_id":          client.ProjectID,
+		"region_id":           region,
+		"title":               d.Get("name"),
+		"description":         d.Get("description"),
+		"alert_type":          buildSecMasterAlertType(d),
+		"severity":            d.Get("severity"),
+		"handle_status":       d.Get("status"),
+		"data_source":         buildSecMasterAlertDataSource(d),
+		"arrive_time":         valueIgnoreEmpty(d.Get("arrive_time")),
+		"first_observed_time": valueIgnoreEmpty(d.Get("first_occurrence_time")),
+		"count":               valueIgnoreEmpty(d.Get("occurrence_count")),
+	}
+	return map[string]interface{}{
+		"data_object": utils.RemoveNil(dataObject),
+	}
+}
+
+func resourceSecMasterAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "secmaster", region)
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL(secMasterAlertsPath(d)), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildSecMasterAlertBodyParams(d, conf, client, region),
+	})
+	if err != nil {
+		return diag.Errorf("error creating SecMaster alert: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("data.data_object.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the SecMaster alert ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceSecMasterAlertRead(ctx, d, meta)
+}
+
+func flattenSecMasterAlertType(dataObject interface{}) []map[string]interface{} {
+	alertType := pathSearch("alert_type", dataObject, nil)
+	if alertType == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"category":   pathSearch("category", alertType, nil),
+			"alert_type": pathSearch("alert_type", alertType, nil),
+		},
+	}
+}
+
+func flattenSecMasterAlertDataSource(dataObject interface{}) []map[string]interface{} {
+	dataSource := pathSearch("data_source", dataObject, nil)
+	if dataSource == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"product_name":    pathSearch("product_name", dataSource, nil),
+			"product_feature": pathSearch("product_feature", dataSource, nil),
+			"source_type":     pathSearch("source_type", dataSource, nil),
+		},
+	}
+}
+
+func resourceSecMasterAlertRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "secmaster", region)
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL(secMasterAlertsPath(d), d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SecMaster alert")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataObject := pathSearch("data.data_object", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("title", dataObject, nil)),
+		d.Set("description", pathSearch("description", dataObject, nil)),
+		d.Set("alert_type", flattenSecMasterAlertType(dataObject)),
+		d.Set("severity", pathSearch("severity", dataObject, nil)),
+		d.Set("status", pathSearch("handle_status", dataObject, nil)),
+		d.Set("data_source", flattenSecMasterAlertDataSource(dataObject)),
+		d.Set("arrive_time", pathSearch("arrive_time", dataObject, nil)),
+		d.Set("first_occurrence_time", pathSearch("first_observed_time", dataObject, nil)),
+		d.Set("occurrence_count", pathSearch("count", dataObject, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SecMaster alert fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecMasterAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "secmaster", region)
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL(secMasterAlertsPath(d), d.Id()), &golangsdk.RequestOpts{
+		JSONBody: buildSecMasterAlertBodyParams(d, conf, client, region),
+	})
+	if err != nil {
+		return diag.Errorf("error updating SecMaster alert (%s): %s", d.Id(), err)
+	}
+
+	return resourceSecMasterAlertRead(ctx, d, meta)
+}
+
+// resourceSecMasterAlertDelete closes the alert, because the alerts are tickets which cannot be removed.
+func resourceSecMasterAlertDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "secmaster", region)
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	if d.Get("status").(string) == "Closed" {
+		log.Printf("[DEBUG] the SecMaster alert (%s) has been closed, remove it from the state", d.Id())
+		return nil
+	}
+
+	if err := d.Set("status", "Closed"); err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = client.Request("PUT", client.ServiceURL(secMasterAlertsPath(d), d.Id()), &golangsdk.RequestOpts{
+		JSONBody: buildSecMasterAlertBodyParams(d, conf, client, region),
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error closing SecMaster alert")
+	}
+
+	return nil
+}
+
+func resourceSecMasterAlertImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <workspace_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("workspace_id", parts[0])
+}
@@ -0,0 +1,186 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceSecMasterWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSecMasterWorkspaceCreate,
+		ReadContext:   resourceSecMasterWorkspaceRead,
+		UpdateContext: resourceSecMasterWorkspaceUpdate,
+		DeleteContext: resourceSecMasterWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"is_trial": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecMasterWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "secmaster", region)
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	regionID := d.Get("region_id").(string)
+	if regionID == "" {
+		regionID = region
+	}
+	createOpts := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"description":           d.Get("description"),
+		"region_id":             regionID,
+		"project_id":            client.ProjectID,
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"is_trial":              d.Get("is_trial"),
+		"tags":                  utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("workspaces"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         utils.RemoveNil(createOpts),
+	})
+	if err != nil {
+		return diag.Errorf("error creating SecMaster workspace: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("data.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the SecMaster workspace ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceSecMasterWorkspaceRead(ctx, d, meta)
+}
+
+func resourceSecMasterWorkspaceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "secmaster", region)
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("workspaces", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SecMaster workspace")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("data.name", respBody, nil)),
+		d.Set("description", pathSearch("data.description", respBody, nil)),
+		d.Set("region_id", pathSearch("data.region_id", respBody, nil)),
+		d.Set("enterprise_project_id", pathSearch("data.enterprise_project_id", respBody, nil)),
+		d.Set("tags", flattenResponseTags("data.tags", respBody)),
+		d.Set("status", pathSearch("data.status", respBody, nil)),
+		d.Set("created_at", pathSearch("data.create_time", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SecMaster workspace fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecMasterWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "secmaster", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"tags":        utils.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	_, err = client.Request("PUT", client.ServiceURL("workspaces", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating SecMaster workspace (%s): %s", d.Id(), err)
+	}
+
+	return resourceSecMasterWorkspaceRead(ctx, d, meta)
+}
+
+func resourceSecMasterWorkspaceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "secmaster", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SecMaster client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("workspaces", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting SecMaster workspace")
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceSisVocabulary manages the hot word tables which improve the accuracy of the speech recognition of SIS.
+func ResourceSisVocabulary() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSisVocabularyCreate,
+		ReadContext:   resourceSisVocabularyRead,
+		UpdateContext: resourceSisVocabularyUpdate,
+		DeleteContext: resourceSisVocabularyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"zh_cn", "en_us"}, false),
+			},
+			"contents": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 32),
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"vocabulary_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildSisVocabularyBodyParams builds the body of both the creation and the update, the API replaces the whole word
+// list rather than updating it incrementally.
+func buildSisVocabularyBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name"),
+		"language":    d.Get("language"),
+		"contents":    d.Get("contents"),
+		"description": d.Get("description"),
+	}
+}
+
+func resourceSisVocabularyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sis", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SIS client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("asr", "vocabularies"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildSisVocabularyBodyParams(d),
+	})
+	if err != nil {
+		return diag.Errorf("error creating SIS vocabulary: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("vocabulary_id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the SIS vocabulary ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceSisVocabularyRead(ctx, d, meta)
+}
+
+func resourceSisVocabularyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "sis", region)
+	if err != nil {
+		return diag.Errorf("error creating SIS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("asr", "vocabularies", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SIS vocabulary")
+	}
+	vocabulary, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", vocabulary, nil)),
+		d.Set("language", pathSearch("language", vocabulary, nil)),
+		d.Set("contents", pathSearch("contents", vocabulary, nil)),
+		d.Set("description", pathSearch("description", vocabulary, nil)),
+		d.Set("vocabulary_id", pathSearch("vocabulary_id", vocabulary, nil)),
+		d.Set("created_at", pathSearch("create_time", vocabulary, nil)),
+		d.Set("updated_at", pathSearch("update_time", vocabulary, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SIS vocabulary fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSisVocabularyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sis", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SIS client: %s", err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL("asr", "vocabularies", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: buildSisVocabularyBodyParams(d),
+	})
+	if err != nil {
+		return diag.Errorf("error updating SIS vocabulary (%s): %s", d.Id(), err)
+	}
+
+	return resourceSisVocabularyRead(ctx, d, meta)
+}
+
+func resourceSisVocabularyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "sis", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SIS client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("asr", "vocabularies", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting SIS vocabulary")
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the parameter name of each rule template
+var swrRetentionParamKeys = map[string]string{
+	"date_rule": "days",
+	"tag_rule":  "num",
+}
+
+func ResourceSwrImageRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSwrImageRetentionPolicyCreate,
+		ReadContext:   resourceSwrImageRetentionPolicyRead,
+		UpdateContext: resourceSwrImageRetentionPolicyUpdate,
+		DeleteContext: resourceSwrImageRetentionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSwrImageRetentionPolicyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"date_rule", "tag_rule"}, false),
+			},
+			"number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tag_selectors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"label", "regexp"}, false),
+						},
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func swrImageRetentionPolicyPath(d *schema.ResourceData) string {
+	return fmt.Sprintf("manage/namespaces/%s/repos/%s/retentions", d.Get("organization").(string),
+		strings.ReplaceAll(d.Get("repository").(string), "/", "$"))
+}
+
+func buildSwrImageRetentionPolicyBodyParams(d *schema.ResourceData) map[string]interface{} {
+	ruleType := d.Get("type").(string)
+
+	selectors := make([]map[string]interface{}, 0)
+	for _, v := range d.Get("tag_selectors").([]interface{}) {
+		selector := v.(map[string]interface{})
+		selectors = append(selectors, map[string]interface{}{
+			"kind":    selector["kind"],
+			"pattern": selector["pattern"],
+		})
+	}
+
+	return map[string]interface{}{
+		"algorithm": "or",
+		"rules": []map[string]interface{}{
+			{
+				"template": ruleType,
+				"params": map[string]interface{}{
+					swrRetentionParamKeys[ruleType]: strconv.Itoa(d.Get("number").(int)),
+				},
+				"tag_selectors": selectors,
+			},
+		},
+	}
+}
+
+func resourceSwrImageRetentionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.SwrV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SWR client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL(swrImageRetentionPolicyPath(d)), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildSwrImageRetentionPolicyBodyParams(d),
+	})
+	if err != nil {
+		return diag.Errorf("error creating SWR image retention policy: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("id", respBody, float64(0)).(float64)
+	if id == 0 {
+		return diag.Errorf("unable to find the SWR image retention policy ID from the API response")
+	}
+	d.SetId(strconv.Itoa(int(id)))
+
+	return resourceSwrImageRetentionPolicyRead(ctx, d, meta)
+}
+
+func flattenSwrImageRetentionTagSelectors(rule interface{}) []map[string]interface{} {
+	selectors := pathSearch("tag_selectors", rule, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(selectors))
+	for i, selector := range selectors {
+		result[i] = map[string]interface{}{
+			"kind":    pathSearch("kind", selector, nil),
+			"pattern": pathSearch("pattern", selector, nil),
+		}
+	}
+	return result
+}
+
+func resourceSwrImageRetentionPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.SwrV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating SWR client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL(swrImageRetentionPolicyPath(d), d.Id()),
+		&golangsdk.RequestOpts{KeepResponseBody: true})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving SWR image retention policy")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := pathSearch("rules[0]", respBody, nil)
+	if rule == nil {
+		return diag.Errorf("the SWR image retention policy (%s) has no rule", d.Id())
+	}
+	ruleType := pathSearch("template", rule, "").(string)
+	number, err := strconv.Atoi(pathSearch(fmt.Sprintf("params.%s", swrRetentionParamKeys[ruleType]),
+		rule, "0").(string))
+	if err != nil {
+		return diag.Errorf("error parsing the parameter of SWR image retention policy (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("type", ruleType),
+		d.Set("number", number),
+		d.Set("tag_selectors", flattenSwrImageRetentionTagSelectors(rule)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting SWR image retention policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSwrImageRetentionPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.SwrV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SWR client: %s", err)
+	}
+
+	_, err = client.Request("PATCH", client.ServiceURL(swrImageRetentionPolicyPath(d), d.Id()),
+		&golangsdk.RequestOpts{JSONBody: buildSwrImageRetentionPolicyBodyParams(d)})
+	if err != nil {
+		return diag.Errorf("error updating SWR image retention policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceSwrImageRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceSwrImageRetentionPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.SwrV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SWR client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL(swrImageRetentionPolicyPath(d), d.Id()),
+		&golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting SWR image retention policy")
+	}
+
+	return nil
+}
+
+func resourceSwrImageRetentionPolicyImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <organization>/<repository>/<id>")
+	}
+
+	d.SetId(parts[2])
+	mErr := multierror.Append(nil,
+		d.Set("organization", parts[0]),
+		d.Set("repository", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,53 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk/openstack/swr/v2/repositories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/swr"
+)
+
+// ResourceSWROrganization extends the organization resource of the swr package with the number of repositories in
+// the organization.
+func ResourceSWROrganization() *schema.Resource {
+	organization := swr.ResourceSWROrganization()
+	organization.Schema["repository_count"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+
+	readContext := organization.ReadContext
+	organization.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := readContext(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, readSWROrganizationRepositoryCount(d, meta.(*config.Config))...)
+	}
+
+	return organization
+}
+
+func readSWROrganizationRepositoryCount(d *schema.ResourceData, conf *config.Config) diag.Diagnostics {
+	client, err := conf.SwrV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SWR client: %s", err)
+	}
+
+	pages, err := repositories.List(client, repositories.ListOpts{Namespace: d.Id()}).AllPages()
+	if err != nil {
+		return diag.Errorf("error retrieving the repositories of SWR organization (%s): %s", d.Id(), err)
+	}
+	repos, err := repositories.ExtractRepositories(pages)
+	if err != nil {
+		return diag.Errorf("error extracting the repositories of SWR organization (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("repository_count", len(repos)); err != nil {
+		return diag.Errorf("error setting SWR organization fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk/openstack/common/tags"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/swr"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const swrRepositoryTagType = "swr_repository"
+
+// ResourceSWRRepository extends the repository resource of the swr package with the tags, which are managed by the
+// SWR tag API.
+func ResourceSWRRepository() *schema.Resource {
+	repository := swr.ResourceSWRRepository()
+	repository.Schema["tags"] = tagsSchema()
+
+	createContext, readContext, updateContext := repository.CreateContext, repository.ReadContext,
+		repository.UpdateContext
+	repository.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if diags := createContext(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+		if rawTags := d.Get("tags").(map[string]interface{}); len(rawTags) > 0 {
+			client, err := meta.(*config.Config).SwrV2Client(GetRegion(d, meta.(*config.Config)))
+			if err != nil {
+				return diag.Errorf("error creating SWR client: %s", err)
+			}
+			err = tags.Create(client, swrRepositoryTagType, swrRepositoryTagResourceID(d),
+				utils.ExpandResourceTags(rawTags)).ExtractErr()
+			if err != nil {
+				return diag.Errorf("error setting tags of SWR repository (%s): %s", d.Id(), err)
+			}
+		}
+		return repository.ReadContext(ctx, d, meta)
+	}
+	repository.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := readContext(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, readSWRRepositoryTags(d, meta.(*config.Config))...)
+	}
+	repository.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if d.HasChange("tags") {
+			client, err := meta.(*config.Config).SwrV2Client(GetRegion(d, meta.(*config.Config)))
+			if err != nil {
+				return diag.Errorf("error creating SWR client: %s", err)
+			}
+			if err := utils.UpdateResourceTags(client, d, swrRepositoryTagType, swrRepositoryTagResourceID(d)); err != nil {
+				return diag.Errorf("error updating tags of SWR repository (%s): %s", d.Id(), err)
+			}
+		}
+		return updateContext(ctx, d, meta)
+	}
+
+	return repository
+}
+
+// swrRepositoryTagResourceID returns the ID of the repository in the tag API, which is in the format of
+// <organization>@<repository>.
+func swrRepositoryTagResourceID(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s@%s", d.Get("organization").(string), d.Id())
+}
+
+func readSWRRepositoryTags(d *schema.ResourceData, conf *config.Config) diag.Diagnostics {
+	client, err := conf.SwrV2Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating SWR client: %s", err)
+	}
+
+	resourceTags, err := tags.Get(client, swrRepositoryTagType, swrRepositoryTagResourceID(d)).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving tags of SWR repository (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tags", utils.TagsToMap(resourceTags.Tags)); err != nil {
+		return diag.Errorf("error setting SWR repository fields: %s", err)
+	}
+	return nil
+}
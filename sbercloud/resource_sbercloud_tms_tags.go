@@ -0,0 +1,232 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the maximum number of the predefined tags in a single create or delete request
+const tmsTagsBatchSize = 500
+
+// ResourceTmsTags manages the predefined tags of TMS in bulk. The predefined tags are a global catalog of the account,
+// so the resource can't be imported.
+func ResourceTmsTags() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTmsTagsCreate,
+		ReadContext:   resourceTmsTagsRead,
+		UpdateContext: resourceTmsTagsUpdate,
+		DeleteContext: resourceTmsTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 36),
+								validation.StringMatch(regexp.MustCompile(`^[\p{L}\d_-]+$`),
+									"only letters, digits, underscores (_) and hyphens (-) are allowed"),
+							),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(0, 43),
+								validation.StringMatch(regexp.MustCompile(`^[\p{L}\d_.-]*$`),
+									"only letters, digits, underscores (_), periods (.) and hyphens (-) are allowed"),
+							),
+						},
+					},
+				},
+			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func tmsTagKey(key, value string) string {
+	return fmt.Sprintf("%s=%s", key, value)
+}
+
+// listTmsPredefinedTags returns all predefined tags of the account, the map key is made by tmsTagKey.
+func listTmsPredefinedTags(client *golangsdk.ServiceClient) (map[string]bool, error) {
+	result := make(map[string]bool)
+	marker := ""
+	for {
+		listPath := client.ServiceURL("predefine_tags") + "?limit=1000"
+		if marker != "" {
+			listPath += "&marker=" + url.QueryEscape(marker)
+		}
+		resp, err := client.Request("GET", listPath, &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		tags := pathSearch("tags", respBody, make([]interface{}, 0)).([]interface{})
+		for _, tag := range tags {
+			result[tmsTagKey(pathSearch("key", tag, "").(string), pathSearch("value", tag, "").(string))] = true
+		}
+
+		marker = pathSearch("marker", respBody, "").(string)
+		if len(tags) == 0 || marker == "" {
+			break
+		}
+	}
+	return result, nil
+}
+
+// doTmsTagsAction creates or deletes the predefined tags in batches.
+func doTmsTagsAction(client *golangsdk.ServiceClient, action string, tags []interface{}) error {
+	for start := 0; start < len(tags); start += tmsTagsBatchSize {
+		end := start + tmsTagsBatchSize
+		if end > len(tags) {
+			end = len(tags)
+		}
+
+		opts := map[string]interface{}{
+			"action": action,
+			"tags":   tags[start:end],
+		}
+		_, err := client.Request("POST", client.ServiceURL("predefine_tags", "action"), &golangsdk.RequestOpts{
+			JSONBody: opts,
+			OkCodes:  []int{200, 204},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createTmsTags creates the tags which do not exist yet. The existing tags are adopted only if adopt_existing is set.
+func createTmsTags(client *golangsdk.ServiceClient, d *schema.ResourceData, tags []interface{}) error {
+	existing, err := listTmsPredefinedTags(client)
+	if err != nil {
+		return fmt.Errorf("error listing TMS predefined tags: %s", err)
+	}
+
+	toCreate := make([]interface{}, 0, len(tags))
+	conflicts := make([]string, 0)
+	for _, v := range tags {
+		tag := v.(map[string]interface{})
+		k := tmsTagKey(tag["key"].(string), tag["value"].(string))
+		if !existing[k] {
+			toCreate = append(toCreate, tag)
+		} else if !d.Get("adopt_existing").(bool) {
+			conflicts = append(conflicts, k)
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("the predefined tags already exist, set adopt_existing to manage them: %s",
+			strings.Join(conflicts, ", "))
+	}
+
+	return doTmsTagsAction(client, "create", toCreate)
+}
+
+func resourceTmsTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "tms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating TMS client: %s", err)
+	}
+
+	if err := createTmsTags(client, d, d.Get("tags").(*schema.Set).List()); err != nil {
+		return diag.Errorf("error creating TMS predefined tags: %s", err)
+	}
+	d.SetId(resource.UniqueId())
+
+	return resourceTmsTagsRead(ctx, d, meta)
+}
+
+func resourceTmsTagsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "tms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating TMS client: %s", err)
+	}
+
+	existing, err := listTmsPredefinedTags(client)
+	if err != nil {
+		return diag.Errorf("error listing TMS predefined tags: %s", err)
+	}
+
+	// only the managed tags which still exist are kept
+	tags := make([]interface{}, 0)
+	for _, v := range d.Get("tags").(*schema.Set).List() {
+		tag := v.(map[string]interface{})
+		if existing[tmsTagKey(tag["key"].(string), tag["value"].(string))] {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("tags", tags); err != nil {
+		return diag.Errorf("error setting TMS predefined tags fields: %s", err)
+	}
+	return nil
+}
+
+func resourceTmsTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "tms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating TMS client: %s", err)
+	}
+
+	if d.HasChange("tags") {
+		oldRaw, newRaw := d.GetChange("tags")
+		oldTags, newTags := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		if err := doTmsTagsAction(client, "delete", oldTags.Difference(newTags).List()); err != nil {
+			return diag.Errorf("error deleting TMS predefined tags: %s", err)
+		}
+		if err := createTmsTags(client, d, newTags.Difference(oldTags).List()); err != nil {
+			return diag.Errorf("error creating TMS predefined tags: %s", err)
+		}
+	}
+
+	return resourceTmsTagsRead(ctx, d, meta)
+}
+
+func resourceTmsTagsDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "tms", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating TMS client: %s", err)
+	}
+
+	if err := doTmsTagsAction(client, "delete", d.Get("tags").(*schema.Set).List()); err != nil {
+		return diag.Errorf("error deleting TMS predefined tags: %s", err)
+	}
+	return nil
+}
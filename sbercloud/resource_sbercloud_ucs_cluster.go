@@ -0,0 +1,230 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the cluster categories of the UCS API
+var ucsClusterCategories = map[string]string{
+	"attached": "attachedcluster",
+	"native":   "self",
+}
+
+func ResourceUcsCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUcsClusterCreate,
+		ReadContext:   resourceUcsClusterRead,
+		DeleteContext: resourceUcsClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"attached", "native"}, false),
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			// the access configuration contains the credentials and the API never returns it
+			"access_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kubeconfig": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ExactlyOneOf: []string{"access_config.0.kubeconfig", "access_config.0.token"},
+						},
+						"token": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_installed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildUcsClusterAccessConfig(d *schema.ResourceData) map[string]interface{} {
+	configs := d.Get("access_config").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	accessConfig := configs[0].(map[string]interface{})
+	return utils.RemoveNil(map[string]interface{}{
+		"kubeconfig": valueIgnoreEmpty(accessConfig["kubeconfig"]),
+		"token":      valueIgnoreEmpty(accessConfig["token"]),
+	})
+}
+
+func resourceUcsClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "ucs", region)
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	clusterRegion := d.Get("cluster_region").(string)
+	if clusterRegion == "" {
+		clusterRegion = region
+	}
+	spec := map[string]interface{}{
+		"category":     ucsClusterCategories[d.Get("cluster_type").(string)],
+		"clusterID":    valueIgnoreEmpty(d.Get("cluster_id")),
+		"region":       clusterRegion,
+		"endpoint":     valueIgnoreEmpty(d.Get("endpoint")),
+		"accessConfig": buildUcsClusterAccessConfig(d),
+	}
+	createOpts := map[string]interface{}{
+		"kind":       "Cluster",
+		"apiVersion": "v1",
+		"metadata": map[string]interface{}{
+			"name": d.Get("name"),
+		},
+		"spec": utils.RemoveNil(spec),
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("clusters"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error registering UCS cluster: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the UCS cluster ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceUcsClusterRead(ctx, d, meta)
+}
+
+func resourceUcsClusterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "ucs", region)
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("clusters", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving UCS cluster")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterType := ""
+	category := pathSearch("spec.category", respBody, "").(string)
+	for k, v := range ucsClusterCategories {
+		if v == category {
+			clusterType = k
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("metadata.name", respBody, nil)),
+		d.Set("cluster_type", clusterType),
+		d.Set("cluster_id", pathSearch("spec.clusterID", respBody, nil)),
+		d.Set("cluster_region", pathSearch("spec.region", respBody, nil)),
+		d.Set("endpoint", pathSearch("spec.endpoint", respBody, nil)),
+		d.Set("status", pathSearch("status.phase", respBody, nil)),
+		d.Set("uid", pathSearch("metadata.uid", respBody, nil)),
+		d.Set("agent_installed", pathSearch("status.agentInstalled", respBody, false)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting UCS cluster fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceUcsClusterDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("clusters", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error unregistering UCS cluster")
+	}
+
+	return nil
+}
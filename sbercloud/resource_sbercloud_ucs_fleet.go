@@ -0,0 +1,155 @@
+package sbercloud
+
+import (
+	"context"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceUcsFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUcsFleetCreate,
+		ReadContext:   resourceUcsFleetRead,
+		UpdateContext: resourceUcsFleetUpdate,
+		DeleteContext: resourceUcsFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"namespaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cluster_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildUcsFleetBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": d.Get("name"),
+			"annotations": map[string]interface{}{
+				"kubernetes.io/description": d.Get("description"),
+			},
+		},
+		"spec": map[string]interface{}{
+			"namespaces": utils.ExpandToStringList(d.Get("namespaces").([]interface{})),
+		},
+	}
+}
+
+func resourceUcsFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("clustergroups"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildUcsFleetBodyParams(d),
+	})
+	if err != nil {
+		return diag.Errorf("error creating UCS fleet: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the UCS fleet ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceUcsFleetRead(ctx, d, meta)
+}
+
+func resourceUcsFleetRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("clustergroups", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving UCS fleet")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", pathSearch("metadata.name", respBody, nil)),
+		d.Set("description", pathSearch(`metadata.annotations."kubernetes.io/description"`, respBody, nil)),
+		d.Set("namespaces", pathSearch("spec.namespaces", respBody, nil)),
+		d.Set("cluster_ids", pathSearch("spec.clusterIds", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting UCS fleet fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceUcsFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	_, err = client.Request("PUT", client.ServiceURL("clustergroups", d.Id()), &golangsdk.RequestOpts{
+		JSONBody: buildUcsFleetBodyParams(d),
+	})
+	if err != nil {
+		return diag.Errorf("error updating UCS fleet (%s): %s", d.Id(), err)
+	}
+
+	return resourceUcsFleetRead(ctx, d, meta)
+}
+
+func resourceUcsFleetDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("clustergroups", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting UCS fleet")
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceUcsPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUcsPolicyCreate,
+		ReadContext:   resourceUcsPolicyRead,
+		UpdateContext: resourceUcsPolicyUpdate,
+		DeleteContext: resourceUcsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUcsPolicyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OPA", "Baseline"}, false),
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scope": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"namespaces": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ucsPolicyPath(d *schema.ResourceData) string {
+	return fmt.Sprintf("clustergroups/%s/policyinstances", d.Get("fleet_id").(string))
+}
+
+func buildUcsPolicyScope(d *schema.ResourceData) map[string]interface{} {
+	scopes := d.Get("scope").([]interface{})
+	if len(scopes) == 0 || scopes[0] == nil {
+		return nil
+	}
+
+	scope := scopes[0].(map[string]interface{})
+	return map[string]interface{}{
+		"clusterIds": utils.ExpandToStringList(scope["cluster_ids"].([]interface{})),
+		"namespaces": utils.ExpandToStringList(scope["namespaces"].([]interface{})),
+	}
+}
+
+func resourceUcsPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": d.Get("name"),
+		},
+		"spec": utils.RemoveNil(map[string]interface{}{
+			"kind":    d.Get("kind"),
+			"content": d.Get("content"),
+			"scope":   buildUcsPolicyScope(d),
+		}),
+	}
+	resp, err := client.Request("POST", client.ServiceURL(ucsPolicyPath(d)), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         createOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error creating UCS policy: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("uid", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the UCS policy ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceUcsPolicyRead(ctx, d, meta)
+}
+
+func flattenUcsPolicyScope(respBody interface{}) []map[string]interface{} {
+	scope := pathSearch("spec.scope", respBody, nil)
+	if scope == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"cluster_ids": pathSearch("clusterIds", scope, nil),
+			"namespaces":  pathSearch("namespaces", scope, nil),
+		},
+	}
+}
+
+func resourceUcsPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL(ucsPolicyPath(d), d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving UCS policy")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("name", pathSearch("metadata.name", respBody, nil)),
+		d.Set("kind", pathSearch("spec.kind", respBody, nil)),
+		d.Set("content", pathSearch("spec.content", respBody, nil)),
+		d.Set("scope", flattenUcsPolicyScope(respBody)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting UCS policy fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceUcsPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"spec": utils.RemoveNil(map[string]interface{}{
+			"content": d.Get("content"),
+			"scope":   buildUcsPolicyScope(d),
+		}),
+	}
+	_, err = client.Request("PUT", client.ServiceURL(ucsPolicyPath(d), d.Id()), &golangsdk.RequestOpts{
+		JSONBody: updateOpts,
+	})
+	if err != nil {
+		return diag.Errorf("error updating UCS policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceUcsPolicyRead(ctx, d, meta)
+}
+
+func resourceUcsPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "ucs", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating UCS client: %s", err)
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL(ucsPolicyPath(d), d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting UCS policy")
+	}
+
+	return nil
+}
+
+func resourceUcsPolicyImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <fleet_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("fleet_id", parts[0])
+}
@@ -0,0 +1,411 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vod/v1"
+	vod "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vod/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceVodMediaAsset() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVodMediaAssetCreate,
+		ReadContext:   resourceVodMediaAssetRead,
+		UpdateContext: resourceVodMediaAssetUpdate,
+		DeleteContext: resourceVodMediaAssetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			// in the format of <bucket>/<object key>
+			"obs_object_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"template_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"auto_publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"output_bucket_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 16,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 16),
+				},
+			},
+			"media_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"original_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_urls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func parseVodObsObjectPath(objectPath string) (bucket, object string, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(objectPath, "obs://"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid obs_object_path, want '<bucket>/<object key>', but got '%s'", objectPath)
+	}
+	return parts[0], parts[1], nil
+}
+
+func buildVodMediaAssetTags(d *schema.ResourceData) *string {
+	return utils.StringIgnoreEmpty(strings.Join(utils.ExpandToStringList(d.Get("tags").([]interface{})), ","))
+}
+
+// getVodMediaAssetSummary returns the summary of the media asset, which contains the processing status, a 404 error
+// is returned if the asset doesn't exist or has been deleted.
+func getVodMediaAssetSummary(client *v1.VodClient, assetID string) (*vod.AssetSummary, error) {
+	resp, err := client.ListAssetList(&vod.ListAssetListRequest{AssetId: &[]string{assetID}})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Assets != nil {
+		for _, asset := range *resp.Assets {
+			if asset.AssetId == assetID && asset.AssetStatus.Value() != "DELETED" {
+				return &asset, nil
+			}
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func vodMediaAssetProcessingRefreshFunc(client *v1.VodClient, assetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		asset, err := getVodMediaAssetSummary(client, assetID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if asset.AssetStatus.Value() == "FAILED" {
+			return asset, "FAILED", fmt.Errorf("the media asset failed to be processed: %s",
+				utils.StringValue(asset.ExecDesc))
+		}
+		if asset.AssetStatus.Value() == "CREATING" {
+			return asset, "PROCESSING", nil
+		}
+		if asset.TranscodeStatus != nil {
+			switch asset.TranscodeStatus.Value() {
+			case "WAITING_TRANSCODE", "TRANSCODING":
+				return asset, "PROCESSING", nil
+			case "TRANSCODE_FAILED":
+				return asset, "FAILED", fmt.Errorf("the media asset failed to be transcoded: %s",
+					utils.StringValue(asset.ExecDesc))
+			}
+		}
+		return asset, "COMPLETED", nil
+	}
+}
+
+func waitForVodMediaAssetProcessed(ctx context.Context, client *v1.VodClient, assetID string,
+	timeout time.Duration) (*vod.AssetSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PROCESSING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      vodMediaAssetProcessingRefreshFunc(client, assetID),
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+	}
+	asset, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return asset.(*vod.AssetSummary), nil
+}
+
+func resourceVodMediaAssetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.HcVodV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VOD client: %s", err)
+	}
+
+	bucket, object, err := parseVodObsObjectPath(d.Get("obs_object_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the media type is the extension of the source video, e.g. MP4
+	var videoType vod.PublishAssetFromObsReqVideoType
+	mediaType := strings.ToUpper(strings.TrimPrefix(path.Ext(object), "."))
+	if err := videoType.UnmarshalJSON([]byte(mediaType)); err != nil || mediaType == "" {
+		return diag.Errorf("unable to get the media type from the obs_object_path (%s)", object)
+	}
+
+	_, err = client.UpdateBucketAuthorized(&vod.UpdateBucketAuthorizedRequest{
+		Body: &vod.UpdateBucketAuthorizedReq{
+			Bucket:    bucket,
+			Operation: "1",
+		},
+	})
+	if err != nil {
+		return diag.Errorf("error authorizing the OBS bucket (%s) to VOD: %s", bucket, err)
+	}
+
+	createOpts := vod.PublishAssetFromObsReq{
+		VideoType:         videoType,
+		Title:             d.Get("name").(string),
+		Description:       utils.StringIgnoreEmpty(d.Get("description").(string)),
+		Tags:              buildVodMediaAssetTags(d),
+		TemplateGroupName: utils.StringIgnoreEmpty(d.Get("template_group_name").(string)),
+		AutoPublish:       utils.Int32(0),
+		Input: &vod.FileAddr{
+			Bucket:   bucket,
+			Object:   object,
+			Location: region,
+		},
+		OutputBucket: utils.StringIgnoreEmpty(d.Get("output_bucket_name").(string)),
+		OutputPath:   utils.StringIgnoreEmpty(d.Get("output_path").(string)),
+	}
+	if d.Get("auto_publish").(bool) {
+		createOpts.AutoPublish = utils.Int32(1)
+	}
+
+	resp, err := client.PublishAssetFromObs(&vod.PublishAssetFromObsRequest{Body: &createOpts})
+	if err != nil {
+		return diag.Errorf("error creating VOD media asset: %s", err)
+	}
+	if resp.AssetId == nil {
+		return diag.Errorf("unable to find the VOD media asset ID from the API response")
+	}
+	d.SetId(*resp.AssetId)
+
+	if _, err := waitForVodMediaAssetProcessed(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for VOD media asset (%s) to be processed: %s", d.Id(), err)
+	}
+
+	return resourceVodMediaAssetRead(ctx, d, meta)
+}
+
+func flattenVodMediaAssetOutputURLs(transcodeInfo *vod.TranscodeInfo) []string {
+	if transcodeInfo == nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(transcodeInfo.Output))
+	for _, output := range transcodeInfo.Output {
+		result = append(result, output.Url)
+	}
+	return result
+}
+
+func resourceVodMediaAssetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.HcVodV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VOD client: %s", err)
+	}
+
+	// the processing is triggered by the creation and may still be running, e.g. right after importing
+	asset, err := waitForVodMediaAssetProcessed(ctx, client, d.Id(), d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VOD media asset")
+	}
+	detail, err := client.ShowAssetDetail(&vod.ShowAssetDetailRequest{AssetId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VOD media asset")
+	}
+
+	status := asset.AssetStatus.Value()
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", asset.Title),
+		d.Set("description", asset.Description),
+		d.Set("auto_publish", status == "PUBLISHED"),
+		d.Set("media_id", asset.AssetId),
+		d.Set("duration", asset.Duration),
+		d.Set("size", asset.Size),
+		d.Set("original_url", asset.OriginalUrl),
+		d.Set("output_urls", flattenVodMediaAssetOutputURLs(detail.TranscodeInfo)),
+		d.Set("status", status),
+	)
+	if baseInfo := detail.BaseInfo; baseInfo != nil {
+		var tags []string
+		if tagsValue := utils.StringValue(baseInfo.Tags); tagsValue != "" {
+			tags = strings.Split(tagsValue, ",")
+		}
+		mErr = multierror.Append(mErr, d.Set("tags", tags))
+
+		if sourcePath := baseInfo.SourcePath; sourcePath != nil {
+			mErr = multierror.Append(mErr,
+				d.Set("obs_object_path", fmt.Sprintf("%s/%s", sourcePath.Bucket, sourcePath.Object)))
+		}
+		if outputPath := baseInfo.OutputPath; outputPath != nil {
+			mErr = multierror.Append(mErr,
+				d.Set("output_bucket_name", outputPath.Bucket),
+				d.Set("output_path", outputPath.Object),
+			)
+		}
+	}
+	if transcodeInfo := detail.TranscodeInfo; transcodeInfo != nil && transcodeInfo.TemplateGroupName != "" {
+		mErr = multierror.Append(mErr, d.Set("template_group_name", transcodeInfo.TemplateGroupName))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting VOD media asset fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVodMediaAssetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcVodV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating VOD client: %s", err)
+	}
+
+	if d.HasChanges("name", "description", "tags") {
+		tags := ""
+		if v := buildVodMediaAssetTags(d); v != nil {
+			tags = *v
+		}
+		_, err := client.UpdateAssetMeta(&vod.UpdateAssetMetaRequest{
+			Body: &vod.UpdateAssetMetaReq{
+				AssetId:     d.Id(),
+				Title:       utils.String(d.Get("name").(string)),
+				Description: utils.String(d.Get("description").(string)),
+				Tags:        utils.String(tags),
+			},
+		})
+		if err != nil {
+			return diag.Errorf("error updating VOD media asset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("auto_publish") {
+		publishOpts := vod.PublishAssetReq{AssetId: []string{d.Id()}}
+		if d.Get("auto_publish").(bool) {
+			_, err = client.PublishAssets(&vod.PublishAssetsRequest{Body: &publishOpts})
+		} else {
+			_, err = client.UnpublishAssets(&vod.UnpublishAssetsRequest{Body: &publishOpts})
+		}
+		if err != nil {
+			return diag.Errorf("error changing the publish status of VOD media asset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceVodMediaAssetRead(ctx, d, meta)
+}
+
+func resourceVodMediaAssetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.HcVodV1Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating VOD client: %s", err)
+	}
+
+	resp, err := client.DeleteAssets(&vod.DeleteAssetsRequest{AssetId: []string{d.Id()}})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting VOD media asset")
+	}
+	if resp.DeleteResultArray != nil {
+		for _, result := range *resp.DeleteResultArray {
+			if result.Status != nil && result.Status.Value() == "FAILED" {
+				return diag.Errorf("error deleting VOD media asset (%s): the deletion failed", d.Id())
+			}
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			asset, err := getVodMediaAssetSummary(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return asset, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for VOD media asset (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceVpcFlowLog() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVpcFlowLogCreate,
+		ReadContext:   resourceVpcFlowLogRead,
+		UpdateContext: resourceVpcFlowLogUpdate,
+		DeleteContext: resourceVpcFlowLogDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"vpc", "subnet", "port"}, false),
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"traffic_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validation.StringInSlice([]string{"all", "accept", "reject"}, false),
+			},
+			"log_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_topic_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVpcFlowLogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "vpc_flow_log", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"description":           valueIgnoreEmpty(d.Get("description")),
+		"resource_type":         d.Get("resource_type"),
+		"resource_id":           d.Get("resource_id"),
+		"traffic_type":          d.Get("traffic_type"),
+		"log_group_id":          d.Get("log_group_id"),
+		"log_topic_id":          d.Get("log_topic_id"),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	resp, err := client.Request("POST", client.ServiceURL("fl", "flow_logs"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"flow_log": utils.RemoveNil(createOpts),
+		},
+	})
+	if err != nil {
+		return diag.Errorf("error creating VPC flow log: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("flow_log.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the VPC flow log ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceVpcFlowLogRead(ctx, d, meta)
+}
+
+func getVpcFlowLog(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("fl", "flow_logs", id), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func resourceVpcFlowLogRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "vpc_flow_log", region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	respBody, err := getVpcFlowLog(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VPC flow log")
+	}
+
+	flowLog := pathSearch("flow_log", respBody, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", pathSearch("name", flowLog, nil)),
+		d.Set("description", pathSearch("description", flowLog, nil)),
+		d.Set("resource_type", pathSearch("resource_type", flowLog, nil)),
+		d.Set("resource_id", pathSearch("resource_id", flowLog, nil)),
+		d.Set("traffic_type", pathSearch("traffic_type", flowLog, nil)),
+		d.Set("log_group_id", pathSearch("log_group_id", flowLog, nil)),
+		d.Set("log_topic_id", pathSearch("log_topic_id", flowLog, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", flowLog, nil)),
+		d.Set("status", pathSearch("status", flowLog, nil)),
+		d.Set("created_at", pathSearch("created_at", flowLog, nil)),
+		d.Set("updated_at", pathSearch("updated_at", flowLog, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting VPC flow log fields: %s", err)
+	}
+
+	return nil
+}
+
+func updateVpcFlowLog(client *golangsdk.ServiceClient, id string, params map[string]interface{}) error {
+	_, err := client.Request("PUT", client.ServiceURL("fl", "flow_logs", id), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"flow_log": params,
+		},
+	})
+	return err
+}
+
+func resourceVpcFlowLogUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "vpc_flow_log", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"name":         d.Get("name"),
+		"description":  d.Get("description"),
+		"traffic_type": d.Get("traffic_type"),
+	}
+	if err := updateVpcFlowLog(client, d.Id(), updateOpts); err != nil {
+		return diag.Errorf("error updating VPC flow log (%s): %s", d.Id(), err)
+	}
+
+	return resourceVpcFlowLogRead(ctx, d, meta)
+}
+
+func vpcFlowLogStateRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := getVpcFlowLog(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return respBody, pathSearch("flow_log.status", respBody, "").(string), nil
+	}
+}
+
+// resourceVpcFlowLogDelete disables the flow log and waits for it to leave the ACTIVE status before deleting it,
+// so that the logs being collected are not lost.
+func resourceVpcFlowLogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "vpc_flow_log", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	if d.Get("status").(string) == "ACTIVE" {
+		err := updateVpcFlowLog(client, d.Id(), map[string]interface{}{
+			"admin_state": false,
+		})
+		if err != nil {
+			return common.CheckDeletedDiag(d, err, "error disabling VPC flow log")
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"ACTIVE"},
+			Target:       []string{"DOWN", "ERROR", "DELETED"},
+			Refresh:      vpcFlowLogStateRefreshFunc(client, d.Id()),
+			Timeout:      d.Timeout(schema.TimeoutDelete),
+			Delay:        2 * time.Second,
+			PollInterval: 3 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("error waiting for the VPC flow log (%s) to be disabled: %s", d.Id(), err)
+		}
+	}
+
+	_, err = client.Request("DELETE", client.ServiceURL("fl", "flow_logs", d.Id()), &golangsdk.RequestOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting VPC flow log")
+	}
+
+	return nil
+}
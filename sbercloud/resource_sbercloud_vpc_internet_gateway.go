@@ -0,0 +1,253 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/routetables"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceVpcInternetGateway manages the internet gateway of a VPC by the IGW API of VPC v3. Only the name can be
+// updated, and the gateway can't be deleted while it is still the next hop of any route of the VPC.
+func ResourceVpcInternetGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVpcInternetGatewayCreate,
+		ReadContext:   resourceVpcInternetGatewayRead,
+		UpdateContext: resourceVpcInternetGatewayUpdate,
+		DeleteContext: resourceVpcInternetGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enable_ipv6": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildVpcInternetGatewayCreateOpts(d *schema.ResourceData, conf *config.Config) map[string]interface{} {
+	createOpts := map[string]interface{}{
+		"vpc_id":                d.Get("vpc_id"),
+		"name":                  d.Get("name"),
+		"availability_zone":     valueIgnoreEmpty(d.Get("availability_zone")),
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"tags":                  valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	if v, ok := d.GetOk("enable_ipv6"); ok {
+		createOpts["enable_ipv6"] = v
+	}
+	return map[string]interface{}{
+		"vpc_igw": utils.RemoveNil(createOpts),
+	}
+}
+
+func resourceVpcInternetGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	resp, err := client.Request("POST", client.ServiceURL("geip", "vpc-igws"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         buildVpcInternetGatewayCreateOpts(d, conf),
+		OkCodes:          []int{200, 201},
+	})
+	if err != nil {
+		return diag.Errorf("error creating VPC internet gateway: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := pathSearch("vpc_igw.id", respBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the VPC internet gateway ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceVpcInternetGatewayRead(ctx, d, meta)
+}
+
+func resourceVpcInternetGatewayRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.NetworkingV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("geip", "vpc-igws", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VPC internet gateway")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	igw := pathSearch("vpc_igw", respBody, nil)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vpc_id", pathSearch("vpc_id", igw, nil)),
+		d.Set("name", pathSearch("name", igw, nil)),
+		d.Set("availability_zone", pathSearch("availability_zone", igw, nil)),
+		d.Set("enable_ipv6", pathSearch("enable_ipv6", igw, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", igw, nil)),
+		d.Set("tags", flattenResponseTags("tags", igw)),
+		d.Set("status", pathSearch("status", igw, nil)),
+		d.Set("ip_address", pathSearch("ip_address", igw, nil)),
+		d.Set("ipv6_address", pathSearch("ipv6_address", igw, nil)),
+		d.Set("created_at", pathSearch("created_at", igw, nil)),
+		d.Set("updated_at", pathSearch("updated_at", igw, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting VPC internet gateway fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVpcInternetGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := conf.NetworkingV3Client(GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	if d.HasChange("name") {
+		_, err := client.Request("PUT", client.ServiceURL("geip", "vpc-igws", d.Id()), &golangsdk.RequestOpts{
+			JSONBody: map[string]interface{}{
+				"vpc_igw": map[string]interface{}{
+					"name": d.Get("name"),
+				},
+			},
+		})
+		if err != nil {
+			return diag.Errorf("error updating VPC internet gateway (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceVpcInternetGatewayRead(ctx, d, meta)
+}
+
+// checkVpcInternetGatewayRoutes returns an error if any route of the VPC still uses the gateway as the next hop.
+func checkVpcInternetGatewayRoutes(client *golangsdk.ServiceClient, vpcID, igwID string) error {
+	pages, err := routetables.List(client, routetables.ListOpts{VpcID: vpcID}).AllPages()
+	if err != nil {
+		return fmt.Errorf("error retrieving the route tables of VPC (%s): %s", vpcID, err)
+	}
+	tables, err := routetables.ExtractRouteTables(pages)
+	if err != nil {
+		return fmt.Errorf("error extracting the route tables of VPC (%s): %s", vpcID, err)
+	}
+
+	for _, table := range tables {
+		// the routes are not returned by the list API
+		detail, err := routetables.Get(client, table.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving route table (%s): %s", table.ID, err)
+		}
+		for _, route := range detail.Routes {
+			if route.NextHop == igwID {
+				return fmt.Errorf("the route (%s) of route table (%s) still points to the internet gateway (%s), "+
+					"remove the route before deleting the gateway", route.DestinationCIDR, table.ID, igwID)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceVpcInternetGatewayDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := conf.NetworkingV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+	vpcClient, err := conf.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+
+	if err := checkVpcInternetGatewayRoutes(vpcClient, d.Get("vpc_id").(string), d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := client.Request("DELETE", client.ServiceURL("geip", "vpc-igws", d.Id()), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	}); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting VPC internet gateway")
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package sbercloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/subnets"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the names of the extra DHCP options of the VPC subnet API
+const (
+	vpcSubnetDhcpOptNtp        = "ntp"
+	vpcSubnetDhcpOptDomainName = "domainname"
+)
+
+// ResourceVpcSubnetDhcpOptions manages the DHCP options of a subnet. The DNS servers and the host routes are standard
+// Neutron attributes of the subnet, the NTP servers and the domain name are the extra DHCP options of the VPC API.
+// Deleting the resource removes the options and restores the DNS servers of the subnet before the creation.
+func ResourceVpcSubnetDhcpOptions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVpcSubnetDhcpOptionsPut,
+		ReadContext:   resourceVpcSubnetDhcpOptionsRead,
+		UpdateContext: resourceVpcSubnetDhcpOptionsPut,
+		DeleteContext: resourceVpcSubnetDhcpOptionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dns_nameservers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"ntp_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"host_routes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"nexthop": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+					},
+				},
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"original_dns_nameservers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildVpcSubnetHostRoutes(rawRoutes []interface{}) []map[string]interface{} {
+	routes := make([]map[string]interface{}, 0, len(rawRoutes))
+	for _, raw := range rawRoutes {
+		route := raw.(map[string]interface{})
+		routes = append(routes, map[string]interface{}{
+			"destination": route["destination"],
+			"nexthop":     route["nexthop"],
+		})
+	}
+	return routes
+}
+
+func flattenVpcSubnetHostRoutes(respBody interface{}) []map[string]interface{} {
+	rawRoutes := pathSearch("subnet.host_routes", respBody, make([]interface{}, 0)).([]interface{})
+	routes := make([]map[string]interface{}, 0, len(rawRoutes))
+	for _, route := range rawRoutes {
+		routes = append(routes, map[string]interface{}{
+			"destination": pathSearch("destination", route, nil),
+			"nexthop":     pathSearch("nexthop", route, nil),
+		})
+	}
+	return routes
+}
+
+// updateVpcNeutronSubnet updates the DNS servers and the host routes by the Neutron API, the empty lists are sent to
+// remove the existing values.
+func updateVpcNeutronSubnet(client *golangsdk.ServiceClient, neutronSubnetID string, dnsNameservers []string,
+	hostRoutes []map[string]interface{}) error {
+	_, err := client.Request("PUT", client.ServiceURL("subnets", neutronSubnetID), &golangsdk.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"subnet": map[string]interface{}{
+				"dns_nameservers": dnsNameservers,
+				"host_routes":     hostRoutes,
+			},
+		},
+	})
+	return err
+}
+
+// updateVpcSubnetExtraDhcpOpts sets the NTP servers and the domain name, the options with empty values are removed.
+func updateVpcSubnetExtraDhcpOpts(client *golangsdk.ServiceClient, subnet *subnets.Subnet, ntpAddresses []string,
+	domainName string) error {
+	opts := subnets.UpdateOpts{
+		Name:       subnet.Name,
+		EnableDHCP: subnet.EnableDHCP,
+		ExtraDhcpOpts: []subnets.ExtraDhcpOpt{
+			{OptName: vpcSubnetDhcpOptNtp, OptValue: strings.Join(ntpAddresses, ",")},
+			{OptName: vpcSubnetDhcpOptDomainName, OptValue: domainName},
+		},
+	}
+	_, err := subnets.Update(client, subnet.VPC_ID, subnet.ID, opts).Extract()
+	return err
+}
+
+func getVpcNeutronSubnet(client *golangsdk.ServiceClient, neutronSubnetID string) (interface{}, error) {
+	resp, err := client.Request("GET", client.ServiceURL("subnets", neutronSubnetID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// resourceVpcSubnetDhcpOptionsPut is used by both the creation and the update, because the options are always
+// replaced as a whole.
+func resourceVpcSubnetDhcpOptionsPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	vpcClient, err := conf.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+	neutronClient, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	subnetID := d.Get("subnet_id").(string)
+	config.MutexKV.Lock(subnetID)
+	defer config.MutexKV.Unlock(subnetID)
+
+	subnet, err := subnets.Get(vpcClient, subnetID).Extract()
+	if err != nil {
+		return diag.Errorf("error retrieving VPC subnet (%s): %s", subnetID, err)
+	}
+
+	// keep the DNS servers before the creation, they are restored when the resource is deleted
+	if d.IsNewResource() {
+		neutronSubnet, err := getVpcNeutronSubnet(neutronClient, subnet.SubnetId)
+		if err != nil {
+			return diag.Errorf("error retrieving Neutron subnet (%s): %s", subnet.SubnetId, err)
+		}
+		if err := d.Set("original_dns_nameservers",
+			pathSearch("subnet.dns_nameservers", neutronSubnet, nil)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	dnsNameservers := utils.ExpandToStringList(d.Get("dns_nameservers").([]interface{}))
+	if _, ok := d.GetOk("dns_nameservers"); !ok {
+		dnsNameservers = utils.ExpandToStringList(d.Get("original_dns_nameservers").([]interface{}))
+	}
+	err = updateVpcNeutronSubnet(neutronClient, subnet.SubnetId, dnsNameservers,
+		buildVpcSubnetHostRoutes(d.Get("host_routes").([]interface{})))
+	if err != nil {
+		return diag.Errorf("error updating DNS servers and host routes of VPC subnet (%s): %s", subnetID, err)
+	}
+
+	err = updateVpcSubnetExtraDhcpOpts(vpcClient, subnet,
+		utils.ExpandToStringList(d.Get("ntp_addresses").([]interface{})), d.Get("domain_name").(string))
+	if err != nil {
+		return diag.Errorf("error updating DHCP options of VPC subnet (%s): %s", subnetID, err)
+	}
+	d.SetId(subnetID)
+
+	return resourceVpcSubnetDhcpOptionsRead(ctx, d, meta)
+}
+
+func resourceVpcSubnetDhcpOptionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	vpcClient, err := conf.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+	neutronClient, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	subnet, err := subnets.Get(vpcClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VPC subnet")
+	}
+	neutronSubnet, err := getVpcNeutronSubnet(neutronClient, subnet.SubnetId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Neutron subnet")
+	}
+
+	var ntpAddresses []string
+	var domainName string
+	for _, opt := range subnet.ExtraDhcpOpts {
+		switch opt.OptName {
+		case vpcSubnetDhcpOptNtp:
+			if opt.OptValue != "" {
+				ntpAddresses = strings.Split(opt.OptValue, ",")
+			}
+		case vpcSubnetDhcpOptDomainName:
+			domainName = opt.OptValue
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("subnet_id", subnet.ID),
+		d.Set("vpc_id", subnet.VPC_ID),
+		d.Set("dns_nameservers", pathSearch("subnet.dns_nameservers", neutronSubnet, nil)),
+		d.Set("host_routes", flattenVpcSubnetHostRoutes(neutronSubnet)),
+		d.Set("ntp_addresses", ntpAddresses),
+		d.Set("domain_name", domainName),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting VPC subnet DHCP options fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVpcSubnetDhcpOptionsDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	vpcClient, err := conf.NetworkingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC client: %s", err)
+	}
+	neutronClient, err := conf.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating networking client: %s", err)
+	}
+
+	config.MutexKV.Lock(d.Id())
+	defer config.MutexKV.Unlock(d.Id())
+
+	subnet, err := subnets.Get(vpcClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving VPC subnet")
+	}
+
+	// the original DNS servers are unknown for the imported resource, keep the current ones in this case
+	dnsNameservers := utils.ExpandToStringList(d.Get("original_dns_nameservers").([]interface{}))
+	if len(dnsNameservers) == 0 {
+		dnsNameservers = utils.ExpandToStringList(d.Get("dns_nameservers").([]interface{}))
+	}
+	err = updateVpcNeutronSubnet(neutronClient, subnet.SubnetId, dnsNameservers, make([]map[string]interface{}, 0))
+	if err != nil {
+		return diag.Errorf("error resetting DNS servers and host routes of VPC subnet (%s): %s", d.Id(), err)
+	}
+	if err := updateVpcSubnetExtraDhcpOpts(vpcClient, subnet, nil, ""); err != nil {
+		return diag.Errorf("error resetting DHCP options of VPC subnet (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
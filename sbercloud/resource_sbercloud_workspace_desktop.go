@@ -0,0 +1,371 @@
+package sbercloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func workspaceDesktopVolumeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SAS", "SSD"}, false),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceWorkspaceDesktop() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkspaceDesktopCreate,
+		ReadContext:   resourceWorkspaceDesktopRead,
+		UpdateContext: resourceWorkspaceDesktopUpdate,
+		DeleteContext: resourceWorkspaceDesktopDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"image_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"market", "gold", "private"}, false),
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"root_volume": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     workspaceDesktopVolumeSchema(),
+			},
+			"data_volumes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem:     workspaceDesktopVolumeSchema(),
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"nic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"desktop_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "DEDICATED",
+				ValidateFunc: validation.StringInSlice([]string{"DEDICATED", "POOLED"}, false),
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"desktop_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildWorkspaceDesktopVolumes(rawVolumes []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rawVolumes))
+	for i, v := range rawVolumes {
+		raw := v.(map[string]interface{})
+		result[i] = map[string]interface{}{
+			"type": raw["type"],
+			"size": raw["size"],
+		}
+	}
+	return result
+}
+
+func buildWorkspaceDesktopNics(rawNics []interface{}) []map[string]interface{} {
+	if len(rawNics) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(rawNics))
+	for i, v := range rawNics {
+		raw := v.(map[string]interface{})
+		result[i] = map[string]interface{}{
+			"subnet_id": raw["network_id"],
+		}
+	}
+	return result
+}
+
+func buildWorkspaceDesktopSecurityGroups(rawIDs []interface{}) []map[string]interface{} {
+	if len(rawIDs) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(rawIDs))
+	for i, v := range rawIDs {
+		result[i] = map[string]interface{}{
+			"id": v,
+		}
+	}
+	return result
+}
+
+func resourceWorkspaceDesktopCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "workspace", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	createOpts := map[string]interface{}{
+		"desktop_type":      d.Get("desktop_type"),
+		"availability_zone": valueIgnoreEmpty(d.Get("availability_zone")),
+		"product_id":        d.Get("flavor_id"),
+		"image_type":        d.Get("image_type"),
+		"image_id":          d.Get("image_id"),
+		"root_volume":       buildWorkspaceDesktopVolumes(d.Get("root_volume").([]interface{}))[0],
+		"data_volumes":      valueIgnoreEmpty(buildWorkspaceDesktopVolumes(d.Get("data_volumes").([]interface{}))),
+		"nics":              buildWorkspaceDesktopNics(d.Get("nic").([]interface{})),
+		"security_groups":   buildWorkspaceDesktopSecurityGroups(d.Get("security_groups").([]interface{})),
+		"desktops": []map[string]interface{}{
+			{
+				"user_name":  d.Get("user_name"),
+				"user_email": d.Get("user_email"),
+				"user_group": "users",
+			},
+		},
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+		"tags":                  valueIgnoreEmpty(utils.ExpandResourceTags(d.Get("tags").(map[string]interface{}))),
+	}
+	job, err := doWorkspaceJobRequest(ctx, client, "POST", client.ServiceURL("desktops"), utils.RemoveNil(createOpts),
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating Workspace desktop: %s", err)
+	}
+
+	id := pathSearch("entities.desktop_id", job, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the Workspace desktop ID from the job detail")
+	}
+	d.SetId(id)
+
+	return resourceWorkspaceDesktopRead(ctx, d, meta)
+}
+
+func flattenWorkspaceDesktopVolumes(volumes interface{}) []map[string]interface{} {
+	rawVolumes, ok := volumes.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(rawVolumes))
+	for i, v := range rawVolumes {
+		result[i] = map[string]interface{}{
+			"type": pathSearch("type", v, nil),
+			"size": pathSearch("size", v, nil),
+			"id":   pathSearch("id", v, nil),
+		}
+	}
+	return result
+}
+
+func flattenWorkspaceDesktopNics(desktop interface{}) []map[string]interface{} {
+	subnetIDs, ok := pathSearch("nics[*].subnet_id", desktop, nil).([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(subnetIDs))
+	for i, v := range subnetIDs {
+		result[i] = map[string]interface{}{
+			"network_id": v,
+		}
+	}
+	return result
+}
+
+func resourceWorkspaceDesktopRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "workspace", region)
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("desktops", d.Id()), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Workspace desktop")
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desktop := pathSearch("desktop", respBody, nil)
+	rootVolume := pathSearch("root_volume", desktop, nil)
+	var rootVolumes []map[string]interface{}
+	if rootVolume != nil {
+		rootVolumes = flattenWorkspaceDesktopVolumes([]interface{}{rootVolume})
+	}
+	publicIP := pathSearch(`addresses.*[] | [?"OS-EXT-IPS:type"=='floating'] | [0].addr`, desktop, nil)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("flavor_id", pathSearch("product.product_id", desktop, nil)),
+		d.Set("image_id", pathSearch("metadata.image_id", desktop, nil)),
+		d.Set("user_name", pathSearch("user_list[0]", desktop, nil)),
+		d.Set("root_volume", rootVolumes),
+		d.Set("data_volumes", flattenWorkspaceDesktopVolumes(pathSearch("data_volumes", desktop, nil))),
+		d.Set("availability_zone", pathSearch(`"OS-EXT-AZ:availability_zone"`, desktop, nil)),
+		d.Set("security_groups", pathSearch("security_groups[*].id", desktop, nil)),
+		d.Set("nic", flattenWorkspaceDesktopNics(desktop)),
+		d.Set("desktop_type", pathSearch("desktop_type", desktop, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", desktop, nil)),
+		d.Set("tags", flattenResponseTags("tags", desktop)),
+		d.Set("desktop_id", pathSearch("desktop_id", desktop, nil)),
+		d.Set("status", pathSearch("status", desktop, nil)),
+		d.Set("public_ip", publicIP),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting Workspace desktop fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWorkspaceDesktopUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "workspace", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	if d.HasChange("flavor_id") {
+		resizeOpts := map[string]interface{}{
+			"desktops": []map[string]interface{}{
+				{
+					"desktop_id": d.Id(),
+				},
+			},
+			"product_id": d.Get("flavor_id"),
+			"mode":       "STOP_DESKTOP",
+		}
+		_, err = doWorkspaceJobRequest(ctx, client, "POST", client.ServiceURL("desktops", "resize"), resizeOpts,
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error changing the flavor of Workspace desktop (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceWorkspaceDesktopRead(ctx, d, meta)
+}
+
+func resourceWorkspaceDesktopDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "workspace", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	_, err = doWorkspaceJobRequest(ctx, client, "DELETE", client.ServiceURL("desktops", d.Id()), nil,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting Workspace desktop")
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package sbercloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceWorkspaceService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkspaceServiceCreate,
+		ReadContext:   resourceWorkspaceServiceRead,
+		UpdateContext: resourceWorkspaceServiceUpdate,
+		DeleteContext: resourceWorkspaceServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"domain_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "LITE_AD",
+				ValidateFunc: validation.StringInSlice([]string{"LITE_AD", "LOCAL_AD"}, false),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain_admin_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"domain_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"dns_address": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"internet_access_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func workspaceJobStateRefreshFunc(client *golangsdk.ServiceClient, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Request("GET", client.ServiceURL("workspace-jobs", jobID), &golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := pathSearch("status", respBody, "").(string)
+		if status == "FAILED" {
+			return respBody, status, fmt.Errorf("the job (%s) failed: %s", jobID,
+				pathSearch("fail_reason", respBody, ""))
+		}
+		return respBody, status, nil
+	}
+}
+
+// waitForWorkspaceJobSuccess waits for the asynchronous job of Workspace and returns the job detail.
+func waitForWorkspaceJobSuccess(ctx context.Context, client *golangsdk.ServiceClient, jobID string,
+	timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"WAITING", "RUNNING"},
+		Target:       []string{"SUCCESS"},
+		Refresh:      workspaceJobStateRefreshFunc(client, jobID),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// doWorkspaceJobRequest sends the request which starts an asynchronous job of Workspace and waits for the job.
+func doWorkspaceJobRequest(ctx context.Context, client *golangsdk.ServiceClient, method, url string,
+	body interface{}, timeout time.Duration) (interface{}, error) {
+	resp, err := client.Request(method, url, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID := pathSearch("job_id", respBody, "").(string)
+	if jobID == "" {
+		return nil, fmt.Errorf("unable to find the job ID from the API response")
+	}
+	return waitForWorkspaceJobSuccess(ctx, client, jobID, timeout)
+}
+
+func buildWorkspaceServiceSubnetIDs(d *schema.ResourceData) []map[string]interface{} {
+	subnetIDs := d.Get("subnet_ids").([]interface{})
+	result := make([]map[string]interface{}, len(subnetIDs))
+	for i, v := range subnetIDs {
+		result[i] = map[string]interface{}{
+			"subnet_id": v,
+		}
+	}
+	return result
+}
+
+func resourceWorkspaceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "workspace", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	domain := map[string]interface{}{
+		"domain_type":          d.Get("domain_type"),
+		"domain_name":          valueIgnoreEmpty(d.Get("domain_name")),
+		"domain_admin_account": valueIgnoreEmpty(d.Get("domain_admin_account")),
+		"domain_password":      valueIgnoreEmpty(d.Get("domain_password")),
+		"active_dns_ip":        valueIgnoreEmpty(utils.ExpandToStringList(d.Get("dns_address").([]interface{}))),
+	}
+	createOpts := map[string]interface{}{
+		"ad_domains":            utils.RemoveNil(domain),
+		"vpc_id":                d.Get("vpc_id"),
+		"subnet_ids":            buildWorkspaceServiceSubnetIDs(d),
+		"access_mode":           "INTERNET",
+		"enterprise_project_id": valueIgnoreEmpty(GetEnterpriseProjectID(d, conf)),
+	}
+	_, err = doWorkspaceJobRequest(ctx, client, "POST", client.ServiceURL("workspaces"), utils.RemoveNil(createOpts),
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error registering Workspace service: %s", err)
+	}
+
+	// the service is a singleton of the project, so the project ID is used as the resource ID
+	d.SetId(client.ProjectID)
+
+	return resourceWorkspaceServiceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceServiceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := GetRegion(d, conf)
+	client, err := NewServiceClient(conf, "workspace", region)
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	resp, err := client.Request("GET", client.ServiceURL("workspaces"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return diag.Errorf("error retrieving Workspace service: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	status := pathSearch("status", respBody, "").(string)
+	if status == "" || status == "CLOSED" {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vpc_id", pathSearch("vpc_id", respBody, nil)),
+		d.Set("subnet_ids", pathSearch("subnet_ids[*].subnet_id", respBody, nil)),
+		d.Set("domain_type", pathSearch("ad_domains.domain_type", respBody, nil)),
+		d.Set("domain_name", pathSearch("ad_domains.domain_name", respBody, nil)),
+		d.Set("domain_admin_account", pathSearch("ad_domains.domain_admin_account", respBody, nil)),
+		d.Set("dns_address", pathSearch("ad_domains.active_dns_ip", respBody, nil)),
+		d.Set("enterprise_project_id", pathSearch("enterprise_project_id", respBody, nil)),
+		d.Set("status", status),
+		d.Set("internet_access_address", pathSearch("internet_access_address", respBody, nil)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting Workspace service fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWorkspaceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "workspace", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	updateOpts := map[string]interface{}{
+		"subnet_ids": buildWorkspaceServiceSubnetIDs(d),
+	}
+	_, err = doWorkspaceJobRequest(ctx, client, "PUT", client.ServiceURL("workspaces"), updateOpts,
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("error updating Workspace service: %s", err)
+	}
+
+	return resourceWorkspaceServiceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	client, err := NewServiceClient(conf, "workspace", GetRegion(d, conf))
+	if err != nil {
+		return diag.Errorf("error creating Workspace client: %s", err)
+	}
+
+	_, err = doWorkspaceJobRequest(ctx, client, "DELETE", client.ServiceURL("workspaces"), nil,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error unregistering Workspace service: %s", err)
+	}
+
+	return nil
+}
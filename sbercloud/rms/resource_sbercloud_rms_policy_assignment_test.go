@@ -0,0 +1,96 @@
+package rms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getPolicyAssignmentResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "rms", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud RMS client: %s", err)
+	}
+
+	path := fmt.Sprintf("resource-manager/domains/%s/policy-assignments/%s", conf.DomainID, state.Primary.ID)
+	resp, err := c.Request("GET", c.ServiceURL(path), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccRmsPolicyAssignment_basic(t *testing.T) {
+	var assignment interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rms_policy_assignment.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&assignment,
+		getPolicyAssignmentResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckRmsPolicyDefinition(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRmsPolicyAssignment_basic(rName, "created by acc test", "Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.compliance_resource_types.0",
+						"ecs.cloudservers"),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "compliance_state"),
+				),
+			},
+			{
+				Config: testAccRmsPolicyAssignment_basic(rName, "updated by acc test", "Disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRmsPolicyAssignment_basic(rName, description, status string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_rms_policy_assignment" "test" {
+  name                 = "%s"
+  description          = "%s"
+  policy_definition_id = "%s"
+  status               = "%s"
+
+  scope {
+    compliance_resource_types = ["ecs.cloudservers"]
+  }
+}
+`, rName, description, acceptance.SBC_RMS_POLICY_DEFINITION_ID, status)
+}
@@ -0,0 +1,109 @@
+package rms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getRemediationConfigurationResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "rms", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud RMS client: %s", err)
+	}
+
+	path := fmt.Sprintf("resource-manager/domains/%s/policy-assignments/%s/remediation-configuration",
+		conf.DomainID, state.Primary.ID)
+	resp, err := c.Request("GET", c.ServiceURL(path), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccRmsRemediationConfiguration_basic(t *testing.T) {
+	var configuration interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_rms_remediation_configuration.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&configuration,
+		getRemediationConfigurationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckRmsPolicyDefinition(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRmsRemediationConfiguration_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_assignment_id",
+						"sbercloud_rms_policy_assignment.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "fgs"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id",
+						"sbercloud_fgs_function.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "false"),
+					resource.TestCheckResourceAttr(resourceName, "static_parameter.#", "1"),
+				),
+			},
+			{
+				Config: testAccRmsRemediationConfiguration_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRmsRemediationConfiguration_basic(rName string, automatic bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_fgs_function" "test" {
+  name        = "%s"
+  app         = "default"
+  handler     = "index.handler"
+  memory_size = 128
+  timeout     = 3
+  runtime     = "Python2.7"
+  code_type   = "inline"
+  func_code   = "aW1wb3J0IGpzb24KZGVmIGhhbmRsZXIgKGV2ZW50LCBjb250ZXh0KToKICAgIG91dHB1dCA9ICdIZWxsbyBtZXNzYWdlOiAnICsganNvbi5kdW1wcyhldmVudCkKICAgIHJldHVybiBvdXRwdXQ="
+}
+
+resource "sbercloud_rms_remediation_configuration" "test" {
+  policy_assignment_id = sbercloud_rms_policy_assignment.test.id
+  target_type          = "fgs"
+  target_id            = sbercloud_fgs_function.test.id
+  automatic            = %t
+
+  static_parameter {
+    var_key   = "action"
+    var_value = jsonencode("stop")
+  }
+}
+`, testAccRmsPolicyAssignment_basic(rName, "created by acc test", "Enabled"), rName, automatic)
+}
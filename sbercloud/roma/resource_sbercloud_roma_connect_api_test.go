@@ -0,0 +1,101 @@
+package roma
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getApiResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "roma", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud ROMA Connect client: %s", err)
+	}
+
+	instanceID := state.Primary.Attributes["instance_id"]
+	apiID := state.Primary.ID[len(instanceID)+1:]
+	resp, err := c.Request("GET", c.ServiceURL("instances", instanceID, "apic", "apis", apiID),
+		&golangsdk.RequestOpts{
+			KeepResponseBody: true,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccRomaConnectApi_basic(t *testing.T) {
+	var api interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_roma_connect_api.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&api,
+		getApiResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckRomaConnectApiGroup(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRomaConnectApi_basic(rName, "GET", "/test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "group_id", acceptance.SBC_ROMA_API_GROUP_ID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "Public"),
+					resource.TestCheckResourceAttr(resourceName, "request_method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "request_path", "/test"),
+					resource.TestCheckResourceAttr(resourceName, "security_authentication", "APP"),
+					resource.TestCheckResourceAttr(resourceName, "web.0.host", "www.example.com"),
+				),
+			},
+			{
+				Config: testAccRomaConnectApi_basic(rName+"_update", "POST", "/test/update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "request_method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "request_path", "/test/update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRomaConnectApi_basic(name, method, path string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_roma_connect_api" "test" {
+  instance_id    = "%[1]s"
+  group_id       = "%[2]s"
+  name           = "%[3]s"
+  request_method = "%[4]s"
+  request_path   = "%[5]s"
+
+  web {
+    request_method = "%[4]s"
+    path           = "%[5]s"
+    host           = "www.example.com"
+  }
+}
+`, acceptance.SBC_ROMA_INSTANCE_ID, acceptance.SBC_ROMA_API_GROUP_ID, name, method, path)
+}
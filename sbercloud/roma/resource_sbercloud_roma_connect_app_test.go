@@ -0,0 +1,89 @@
+package roma
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAppResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "roma", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud ROMA Connect client: %s", err)
+	}
+
+	instanceID := state.Primary.Attributes["instance_id"]
+	appID := state.Primary.ID[len(instanceID)+1:]
+	resp, err := c.Request("GET", c.ServiceURL("instances", instanceID, "apps", appID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccRomaConnectApp_basic(t *testing.T) {
+	var app interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_roma_connect_app.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&app,
+		getAppResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckRomaConnectInstance(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRomaConnectApp_basic(rName, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", acceptance.SBC_ROMA_INSTANCE_ID),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_secret"),
+				),
+			},
+			{
+				Config: testAccRomaConnectApp_basic(rName+"_update", "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRomaConnectApp_basic(name, description string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_roma_connect_app" "test" {
+  instance_id = "%[1]s"
+  name        = "%[2]s"
+  description = "%[3]s"
+}
+`, acceptance.SBC_ROMA_INSTANCE_ID, name, description)
+}
@@ -0,0 +1,123 @@
+package roma
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getInstanceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "roma", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud ROMA Connect client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("instances", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccRomaConnectInstance_basic(t *testing.T) {
+	var instance interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_roma_connect_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckRomaConnectFlavor(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRomaConnectInstance_basic(rName, rName, "created by terraform", "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", acceptance.SBC_ROMA_FLAVOR_ID),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingress_ip"),
+				),
+			},
+			{
+				Config: testAccRomaConnectInstance_basic(rName, rName+"-update", "updated by terraform", "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRomaConnectInstance_base(rName string) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  vpc_id     = sbercloud_vpc.test.id
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "sbercloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+`, rName)
+}
+
+func testAccRomaConnectInstance_basic(rName, name, description, owner string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_roma_connect_instance" "test" {
+  name               = "%[2]s"
+  flavor_id          = "%[3]s"
+  vpc_id             = sbercloud_vpc.test.id
+  subnet_id          = sbercloud_vpc_subnet.test.id
+  security_group_id  = sbercloud_networking_secgroup.test.id
+  availability_zones = [data.sbercloud_availability_zones.test.names[0]]
+  description        = "%[4]s"
+
+  tags = {
+    owner = "%[5]s"
+  }
+}
+`, testAccRomaConnectInstance_base(rName), name, acceptance.SBC_ROMA_FLAVOR_ID, description, owner)
+}
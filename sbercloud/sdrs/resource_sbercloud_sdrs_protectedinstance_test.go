@@ -0,0 +1,128 @@
+package sdrs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getProtectedInstanceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "sdrs", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SDRS client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("protected-instances", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccSdrsProtectedInstance_basic(t *testing.T) {
+	var instance interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_sdrs_protectedinstance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getProtectedInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckSdrsDomainId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSdrsProtectedInstance_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id",
+						"sbercloud_sdrs_protectiongroup.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_id",
+						"sbercloud_compute_instance.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "target_server"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccSdrsProtectedInstance_basic(rName, rName+"-update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"delete_target_server", "delete_target_eip",
+				},
+			},
+		},
+	})
+}
+
+func testAccSdrsProtectedInstance_basic(rName, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "sbercloud_compute_flavors" "test" {
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+data "sbercloud_images_image" "test" {
+  name        = "Ubuntu 18.04 server 64bit"
+  most_recent = true
+}
+
+resource "sbercloud_compute_instance" "test" {
+  name              = "%[2]s"
+  image_id          = data.sbercloud_images_image.test.id
+  flavor_id         = data.sbercloud_compute_flavors.test.ids[0]
+  security_groups   = ["default"]
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  system_disk_type  = "SSD"
+
+  network {
+    uuid = sbercloud_vpc_subnet.test.id
+  }
+}
+
+resource "sbercloud_sdrs_protectiongroup" "test" {
+  name                     = "%[2]s"
+  source_availability_zone = data.sbercloud_availability_zones.test.names[0]
+  target_availability_zone = data.sbercloud_availability_zones.test.names[1]
+  domain_id                = "%[3]s"
+  source_vpc_id            = sbercloud_vpc.test.id
+}
+
+resource "sbercloud_sdrs_protectedinstance" "test" {
+  name                 = "%[4]s"
+  group_id             = sbercloud_sdrs_protectiongroup.test.id
+  server_id            = sbercloud_compute_instance.test.id
+  delete_target_server = true
+  delete_target_eip    = true
+}
+`, testAccSdrsProtectionGroup_base(rName), rName, acceptance.SBC_SDRS_DOMAIN_ID, name)
+}
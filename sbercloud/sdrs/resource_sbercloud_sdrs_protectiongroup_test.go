@@ -0,0 +1,110 @@
+package sdrs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getProtectionGroupResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "sdrs", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SDRS client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("server-groups", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccSdrsProtectionGroup_basic(t *testing.T) {
+	var group interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_sdrs_protectiongroup.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&group,
+		getProtectionGroupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckSdrsDomainId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSdrsProtectionGroup_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "domain_id", acceptance.SBC_SDRS_DOMAIN_ID),
+					resource.TestCheckResourceAttr(resourceName, "dr_type", "migration"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "health_status"),
+				),
+			},
+			{
+				Config: testAccSdrsProtectionGroup_basic(rName, rName+"-update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSdrsProtectionGroup_base(rName string) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/20"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  vpc_id     = sbercloud_vpc.test.id
+  gateway_ip = "192.168.0.1"
+}
+`, rName)
+}
+
+func testAccSdrsProtectionGroup_basic(rName, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "sbercloud_sdrs_protectiongroup" "test" {
+  name                     = "%[2]s"
+  description              = "Created by acceptance test"
+  source_availability_zone = data.sbercloud_availability_zones.test.names[0]
+  target_availability_zone = data.sbercloud_availability_zones.test.names[1]
+  domain_id                = "%[3]s"
+  source_vpc_id            = sbercloud_vpc.test.id
+}
+`, testAccSdrsProtectionGroup_base(rName), name, acceptance.SBC_SDRS_DOMAIN_ID)
+}
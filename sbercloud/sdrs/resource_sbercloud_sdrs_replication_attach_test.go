@@ -0,0 +1,90 @@
+package sdrs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getReplicationAttachResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "sdrs", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SDRS client: %s", err)
+	}
+
+	instanceID := state.Primary.Attributes["protected_instance_id"]
+	resp, err := c.Request("GET", c.ServiceURL("protected-instances", instanceID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("protected_instance.attachment[?replication=='%s']|[0]",
+		state.Primary.Attributes["replication_id"])
+	attachment := utils.PathSearch(expression, respBody, nil)
+	if attachment == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return attachment, nil
+}
+
+func TestAccSdrsReplicationAttach_basic(t *testing.T) {
+	var attachment interface{}
+	resourceName := "sbercloud_sdrs_replication_attach.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&attachment,
+		getReplicationAttachResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckSdrsReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSdrsReplicationAttach_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "protected_instance_id",
+						acceptance.SBC_SDRS_PROTECTED_INSTANCE_ID),
+					resource.TestCheckResourceAttr(resourceName, "replication_id", acceptance.SBC_SDRS_REPLICATION_ID),
+					resource.TestCheckResourceAttr(resourceName, "device", "/dev/vdb"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "replication_model"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSdrsReplicationAttach_basic() string {
+	return fmt.Sprintf(`
+resource "sbercloud_sdrs_replication_attach" "test" {
+  protected_instance_id = "%s"
+  replication_id        = "%s"
+  device                = "/dev/vdb"
+}
+`, acceptance.SBC_SDRS_PROTECTED_INSTANCE_ID, acceptance.SBC_SDRS_REPLICATION_ID)
+}
@@ -0,0 +1,122 @@
+package secmaster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getAlertResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "secmaster", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SecMaster client: %s", err)
+	}
+
+	path := fmt.Sprintf("workspaces/%s/soc/alerts", state.Primary.Attributes["workspace_id"])
+	resp, err := c.Request("GET", c.ServiceURL(path, state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	// the alert is closed instead of being deleted
+	if utils.PathSearch("data.data_object.handle_status", respBody, "") == "Closed" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return respBody, nil
+}
+
+func TestAccSecMasterAlert_basic(t *testing.T) {
+	var alert interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_secmaster_alert.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&alert,
+		getAlertResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecMasterAlert_basic(rName, "Medium"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id",
+						"sbercloud_secmaster_workspace.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "severity", "Medium"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Open"),
+					resource.TestCheckResourceAttr(resourceName, "alert_type.0.category", "Abnormal network behavior"),
+					resource.TestCheckResourceAttr(resourceName, "data_source.0.source_type", "3"),
+				),
+			},
+			{
+				Config: testAccSecMasterAlert_basic(rName, "High"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "severity", "High"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSecMasterAlertImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccSecMasterAlertImportStateIdFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccSecMasterAlert_basic(rName, severity string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_secmaster_workspace" "test" {
+  name = "%[1]s"
+}
+
+resource "sbercloud_secmaster_alert" "test" {
+  workspace_id = sbercloud_secmaster_workspace.test.id
+  name         = "%[1]s"
+  description  = "created by acc test"
+  severity     = "%[2]s"
+
+  alert_type {
+    category   = "Abnormal network behavior"
+    alert_type = "Abnormal access frequency of IP address"
+  }
+
+  data_source {
+    product_name    = "SecMaster"
+    product_feature = "SecMaster"
+    source_type     = 3
+  }
+}
+`, rName, severity)
+}
@@ -0,0 +1,99 @@
+package sis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getVocabularyResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "sis", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SIS client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("asr", "vocabularies", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccSisVocabulary_basic(t *testing.T) {
+	var vocabulary interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_sis_vocabulary.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&vocabulary,
+		getVocabularyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSisVocabulary_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "language", "en_us"),
+					resource.TestCheckResourceAttr(resourceName, "contents.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "contents.0", "terraform"),
+					resource.TestCheckResourceAttrPair(resourceName, "vocabulary_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccSisVocabulary_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "contents.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "contents.2", "sbercloud"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSisVocabulary_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_sis_vocabulary" "test" {
+  name        = "%s"
+  language    = "en_us"
+  description = "created by acceptance test"
+  contents    = ["terraform", "provider"]
+}
+`, rName)
+}
+
+func testAccSisVocabulary_update(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_sis_vocabulary" "test" {
+  name        = "%s_update"
+  language    = "en_us"
+  description = "updated by acceptance test"
+  contents    = ["terraform", "provider", "sbercloud"]
+}
+`, rName)
+}
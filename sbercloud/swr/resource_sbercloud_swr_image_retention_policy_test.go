@@ -0,0 +1,106 @@
+package swr
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getImageRetentionPolicyResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.SwrV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SWR client: %s", err)
+	}
+
+	path := fmt.Sprintf("manage/namespaces/%s/repos/%s/retentions", state.Primary.Attributes["organization"],
+		strings.ReplaceAll(state.Primary.Attributes["repository"], "/", "$"))
+	resp, err := c.Request("GET", c.ServiceURL(path, state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccSWRImageRetentionPolicy_basic(t *testing.T) {
+	var policy interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_swr_image_retention_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&policy,
+		getImageRetentionPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSWRImageRetentionPolicy_basic(rName, "date_rule", 30),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "date_rule"),
+					resource.TestCheckResourceAttr(resourceName, "number", "30"),
+					resource.TestCheckResourceAttr(resourceName, "tag_selectors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tag_selectors.0.kind", "label"),
+					resource.TestCheckResourceAttr(resourceName, "tag_selectors.0.pattern", "latest"),
+				),
+			},
+			{
+				Config: testAccSWRImageRetentionPolicy_basic(rName, "tag_rule", 10),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "tag_rule"),
+					resource.TestCheckResourceAttr(resourceName, "number", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSWRImageRetentionPolicyImportStateFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccSWRImageRetentionPolicyImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["organization"], rs.Primary.Attributes["repository"],
+			rs.Primary.ID), nil
+	}
+}
+
+func testAccSWRImageRetentionPolicy_basic(rName, ruleType string, number int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_swr_image_retention_policy" "test" {
+  organization = sbercloud_swr_organization.test.name
+  repository   = sbercloud_swr_repository.test.name
+  type         = "%s"
+  number       = %d
+
+  tag_selectors {
+    kind    = "label"
+    pattern = "latest"
+  }
+}
+`, testAccSWRRepository_basic(rName, "created by acc test", false, "{}"), ruleType, number)
+}
@@ -0,0 +1,85 @@
+package swr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/swr/v2/namespaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getOrganizationPermissionsResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.SwrV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SWR client: %s", err)
+	}
+
+	return namespaces.GetAccess(c, state.Primary.ID).Extract()
+}
+
+func TestAccSWROrganizationPermissions_basic(t *testing.T) {
+	var access namespaces.Access
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_swr_organization_permissions.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&access,
+		getOrganizationPermissionsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSWROrganizationPermissions_basic(rName, "Read"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users.0.permission", "Read"),
+					resource.TestCheckResourceAttrPair(resourceName, "users.0.user_id",
+						"sbercloud_identity_user.test", "id"),
+				),
+			},
+			{
+				Config: testAccSWROrganizationPermissions_basic(rName, "Write"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "users.0.permission", "Write"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSWROrganizationPermissions_basic(rName, permission string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_identity_user" "test" {
+  name     = "%s"
+  enabled  = true
+  password = "Test@12345678"
+}
+
+resource "sbercloud_swr_organization_permissions" "test" {
+  organization = sbercloud_swr_organization.test.name
+
+  users {
+    user_name  = sbercloud_identity_user.test.name
+    user_id    = sbercloud_identity_user.test.id
+    permission = "%s"
+  }
+}
+`, testAccSWROrganization_basic(rName), rName, permission)
+}
@@ -0,0 +1,66 @@
+package swr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/swr/v2/namespaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getOrganizationResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.SwrV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SWR client: %s", err)
+	}
+
+	return namespaces.Get(c, state.Primary.ID).Extract()
+}
+
+func TestAccSWROrganization_basic(t *testing.T) {
+	var org namespaces.Namespace
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_swr_organization.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&org,
+		getOrganizationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSWROrganization_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "permission", "Manage"),
+					resource.TestCheckResourceAttrSet(resourceName, "creator"),
+					resource.TestCheckResourceAttrSet(resourceName, "login_server"),
+					resource.TestCheckResourceAttr(resourceName, "repository_count", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSWROrganization_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_swr_organization" "test" {
+  name = "%s"
+}
+`, rName)
+}
@@ -0,0 +1,102 @@
+package swr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk/openstack/swr/v2/repositories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getRepositoryResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.SwrV2Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud SWR client: %s", err)
+	}
+
+	return repositories.Get(c, state.Primary.Attributes["organization"], state.Primary.ID).Extract()
+}
+
+func TestAccSWRRepository_basic(t *testing.T) {
+	var repo repositories.ImageRepository
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_swr_repository.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&repo,
+		getRepositoryResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSWRRepository_basic(rName, "created by acc test", false, `{
+    foo = "bar"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization",
+						"sbercloud_swr_organization.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "path"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccSWRRepository_basic(rName, "updated by acc test", true, `{
+    foo   = "baz"
+    owner = "terraform"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+					resource.TestCheckResourceAttr(resourceName, "tags.owner", "terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSWRRepositoryImportStateFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccSWRRepositoryImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["organization"], rs.Primary.ID), nil
+	}
+}
+
+func testAccSWRRepository_basic(rName, description string, isPublic bool, tags string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_swr_repository" "test" {
+  organization = sbercloud_swr_organization.test.name
+  name         = "%s"
+  description  = "%s"
+  category     = "linux"
+  is_public    = %t
+
+  tags = %s
+}
+`, testAccSWROrganization_basic(rName), rName, description, isPublic, tags)
+}
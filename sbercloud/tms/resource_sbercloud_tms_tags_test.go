@@ -0,0 +1,123 @@
+package tms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+// getTagsResourceFunc returns the managed tags which exist in the predefined tag catalog. A not found error is returned
+// when none of them exists.
+func getTagsResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "tms", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud TMS client: %s", err)
+	}
+
+	// the indexes of the set elements are not sequential
+	managed := make(map[string]bool)
+	for k, v := range state.Primary.Attributes {
+		if strings.HasPrefix(k, "tags.") && strings.HasSuffix(k, ".key") {
+			managed[v+"="+state.Primary.Attributes[strings.TrimSuffix(k, ".key")+".value"]] = true
+		}
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("predefine_tags")+"?limit=1000", &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]interface{}, 0)
+	for _, tag := range utils.PathSearch("tags", respBody, make([]interface{}, 0)).([]interface{}) {
+		if managed[fmt.Sprintf("%v=%v", utils.PathSearch("key", tag, ""), utils.PathSearch("value", tag, ""))] {
+			found = append(found, tag)
+		}
+	}
+	if len(found) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return found, nil
+}
+
+func TestAccTmsTags_basic(t *testing.T) {
+	var tags interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_tms_tags.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&tags,
+		getTagsResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTmsTags_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccTmsTags_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTmsTags_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_tms_tags" "test" {
+  tags {
+    key   = "%[1]s"
+    value = "foo"
+  }
+  tags {
+    key   = "%[1]s"
+    value = "bar"
+  }
+}
+`, rName)
+}
+
+func testAccTmsTags_update(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_tms_tags" "test" {
+  tags {
+    key   = "%[1]s"
+    value = "foo"
+  }
+  tags {
+    key   = "%[1]s_update"
+    value = "bar"
+  }
+  tags {
+    key   = "%[1]s_update"
+    value = "baz"
+  }
+}
+`, rName)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
 func convertToStr(v interface{}) string {
@@ -51,3 +53,44 @@ func navigateValue(d interface{}, index []string, arrayIndex map[string]int) (in
 
 	return d, nil
 }
+
+// pathSearch works like utils.PathSearch, but it also returns the default value when the expression matches
+// nothing in the response body, so that the result can be asserted to the type of the default value safely.
+func pathSearch(expression string, obj interface{}, defaultValue interface{}) interface{} {
+	if v := utils.PathSearch(expression, obj, defaultValue); v != nil {
+		return v
+	}
+	return defaultValue
+}
+
+// valueIgnoreEmpty returns nil if the value is empty, so that it can be removed from the request body by
+// utils.RemoveNil.
+func valueIgnoreEmpty(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		if rv.Len() == 0 {
+			return nil
+		}
+	default:
+		if rv.IsZero() {
+			return nil
+		}
+	}
+	return v
+}
+
+// flattenResponseTags converts the tag list, which is found by the expression in the response body, to a map.
+func flattenResponseTags(expression string, respBody interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	tagList := pathSearch(expression, respBody, make([]interface{}, 0)).([]interface{})
+	for _, tag := range tagList {
+		key := pathSearch("key", tag, "").(string)
+		result[key] = pathSearch("value", tag, "")
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+package ucs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getPolicyResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "ucs", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud UCS client: %s", err)
+	}
+
+	path := fmt.Sprintf("clustergroups/%s/policyinstances/%s", state.Primary.Attributes["fleet_id"],
+		state.Primary.ID)
+	resp, err := c.Request("GET", c.ServiceURL(path), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccUcsPolicy_basic(t *testing.T) {
+	var policy interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_ucs_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&policy,
+		getPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUcsPolicy_basic(rName, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "kind", "OPA"),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.namespaces.0", "default"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "sbercloud_ucs_fleet.test", "id"),
+				),
+			},
+			{
+				Config: testAccUcsPolicy_basic(rName, "kube-public"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.namespaces.0", "kube-public"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccUcsPolicyImportStateFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccUcsPolicyImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["fleet_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccUcsPolicy_basic(rName, namespace string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_ucs_policy" "test" {
+  fleet_id = sbercloud_ucs_fleet.test.id
+  name     = "%s"
+  kind     = "OPA"
+  content  = <<EOT
+apiVersion: constraints.gatekeeper.sh/v1beta1
+kind: K8sRequiredLabels
+metadata:
+  name: ns-must-have-owner
+spec:
+  parameters:
+    labels: ["owner"]
+EOT
+
+  scope {
+    namespaces = ["%s"]
+  }
+}
+`, testAccUcsFleet_basic(rName, "created by acc test"), rName, namespace)
+}
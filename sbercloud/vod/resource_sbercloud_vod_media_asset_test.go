@@ -0,0 +1,105 @@
+package vod
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	vod "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vod/v1/model"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getResourceAsset(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcVodV1Client(acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud VOD client: %s", err)
+	}
+
+	return client.ShowAssetDetail(&vod.ShowAssetDetailRequest{AssetId: state.Primary.ID})
+}
+
+func TestAccVodMediaAsset_basic(t *testing.T) {
+	var asset vod.ShowAssetDetailResponse
+	rName := acceptance.RandomAccResourceNameWithDash()
+	updateName := rName + "-update"
+	description := "test video"
+	descriptionUpdate := "test video update"
+	resourceName := "sbercloud_vod_media_asset.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&asset,
+		getResourceAsset,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheckVODMediaAsset(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaAsset_basic(testAccMediaAsset_base(rName), rName, description, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", description),
+					resource.TestCheckResourceAttr(resourceName, "obs_object_path",
+						fmt.Sprintf("%s/input/%s", rName, acceptance.SBC_VOD_MEDIA_ASSET_FILE)),
+					resource.TestCheckResourceAttr(resourceName, "auto_publish", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0", "test_label_1"),
+					resource.TestCheckResourceAttrPair(resourceName, "media_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "CREATED"),
+					resource.TestCheckResourceAttrSet(resourceName, "duration"),
+					resource.TestCheckResourceAttrSet(resourceName, "size"),
+					resource.TestCheckResourceAttrSet(resourceName, "original_url"),
+				),
+			},
+			{
+				Config: testAccMediaAsset_basic(testAccMediaAsset_base(rName), updateName, descriptionUpdate, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", updateName),
+					resource.TestCheckResourceAttr(resourceName, "description", descriptionUpdate),
+					resource.TestCheckResourceAttr(resourceName, "auto_publish", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "PUBLISHED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMediaAsset_base(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_obs_bucket" "test" {
+  bucket = "%s"
+  acl    = "private"
+}
+
+resource "sbercloud_obs_bucket_object" "test" {
+  bucket = sbercloud_obs_bucket.test.bucket
+  key    = "input/%[2]s"
+  source = "%[2]s"
+}`, rName, acceptance.SBC_VOD_MEDIA_ASSET_FILE)
+}
+
+func testAccMediaAsset_basic(baseConfig, rName, description string, autoPublish bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_vod_media_asset" "test" {
+  name            = "%s"
+  description     = "%s"
+  obs_object_path = "${sbercloud_obs_bucket.test.bucket}/${sbercloud_obs_bucket_object.test.key}"
+  auto_publish    = %t
+  tags            = ["test_label_1", "test_label_2"]
+}
+`, baseConfig, rName, description, autoPublish)
+}
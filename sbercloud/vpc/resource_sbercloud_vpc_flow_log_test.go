@@ -0,0 +1,117 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getFlowLogResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "vpc_flow_log", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud VPC client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("fl", "flow_logs", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccVpcFlowLog_basic(t *testing.T) {
+	var flowLog interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_vpc_flow_log.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&flowLog,
+		getFlowLogResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcFlowLog_basic(rName, rName, "all"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "subnet"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_type", "all"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id",
+						"sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_id",
+						"sbercloud_lts_group.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_topic_id",
+						"sbercloud_lts_stream.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccVpcFlowLog_basic(rName, rName+"-update", "reject"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-update"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_type", "reject"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVpcFlowLog_basic(rName, flowLogName, trafficType string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = sbercloud_vpc.test.id
+}
+
+resource "sbercloud_lts_group" "test" {
+  group_name  = "%[1]s"
+  ttl_in_days = 1
+}
+
+resource "sbercloud_lts_stream" "test" {
+  group_id    = sbercloud_lts_group.test.id
+  stream_name = "%[1]s"
+}
+
+resource "sbercloud_vpc_flow_log" "test" {
+  name          = "%[2]s"
+  description   = "created by acc test"
+  resource_type = "subnet"
+  resource_id   = sbercloud_vpc_subnet.test.id
+  traffic_type  = "%[3]s"
+  log_group_id  = sbercloud_lts_group.test.id
+  log_topic_id  = sbercloud_lts_stream.test.id
+}
+`, rName, flowLogName, trafficType)
+}
@@ -0,0 +1,96 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func TestAccVpcSubnetDhcpOptions_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "sbercloud_vpc_subnet_dhcp_options.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcSubnetV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcSubnetDhcpOptions_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "sbercloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "dns_nameservers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ntp_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "host_routes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "host_routes.0.destination", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "host_routes.0.nexthop", "192.168.0.10"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "example.com"),
+				),
+			},
+			{
+				Config: testAccVpcSubnetDhcpOptions_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ntp_addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ntp_addresses.0", "192.168.0.200"),
+					resource.TestCheckResourceAttr(resourceName, "host_routes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_dns_nameservers"},
+			},
+		},
+	})
+}
+
+func testAccVpcSubnetDhcpOptions_base(rName string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = sbercloud_vpc.test.id
+}
+`, rName)
+}
+
+func testAccVpcSubnetDhcpOptions_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_vpc_subnet_dhcp_options" "test" {
+  subnet_id       = sbercloud_vpc_subnet.test.id
+  dns_nameservers = ["100.125.13.59", "8.8.8.8"]
+  ntp_addresses   = ["192.168.0.100", "192.168.0.101"]
+  domain_name     = "example.com"
+
+  host_routes {
+    destination = "10.0.0.0/24"
+    nexthop     = "192.168.0.10"
+  }
+}
+`, testAccVpcSubnetDhcpOptions_base(rName))
+}
+
+func testAccVpcSubnetDhcpOptions_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "sbercloud_vpc_subnet_dhcp_options" "test" {
+  subnet_id     = sbercloud_vpc_subnet.test.id
+  ntp_addresses = ["192.168.0.200"]
+}
+`, testAccVpcSubnetDhcpOptions_base(rName))
+}
@@ -0,0 +1,103 @@
+package workspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getDesktopResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "workspace", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud Workspace client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("desktops", state.Primary.ID), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccWorkspaceDesktop_basic(t *testing.T) {
+	var desktop interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_workspace_desktop.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&desktop,
+		getDesktopResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckWorkspaceDesktop(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceDesktop_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", acceptance.SBC_WORKSPACE_FLAVOR_ID),
+					resource.TestCheckResourceAttr(resourceName, "image_id", acceptance.SBC_WORKSPACE_IMAGE_ID),
+					resource.TestCheckResourceAttr(resourceName, "root_volume.0.size", "80"),
+					resource.TestCheckResourceAttr(resourceName, "data_volumes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "desktop_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"image_type", "user_email",
+				},
+			},
+		},
+	})
+}
+
+func testAccWorkspaceDesktop_basic(rName string) string {
+	return fmt.Sprintf(`
+data "sbercloud_availability_zones" "test" {}
+
+resource "sbercloud_workspace_desktop" "test" {
+  flavor_id         = "%[2]s"
+  image_type        = "market"
+  image_id          = "%[3]s"
+  availability_zone = data.sbercloud_availability_zones.test.names[0]
+  user_name         = "user-%[1]s"
+  user_email        = "terraform@example.com"
+
+  root_volume {
+    type = "SAS"
+    size = 80
+  }
+
+  data_volumes {
+    type = "SAS"
+    size = 50
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, rName, acceptance.SBC_WORKSPACE_FLAVOR_ID, acceptance.SBC_WORKSPACE_IMAGE_ID)
+}
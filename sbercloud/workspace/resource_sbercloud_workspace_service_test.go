@@ -0,0 +1,111 @@
+package workspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud"
+	"github.com/sbercloud-terraform/terraform-provider-sbercloud/sbercloud/acceptance"
+)
+
+func getServiceResourceFunc(conf *config.Config, _ *terraform.ResourceState) (interface{}, error) {
+	c, err := sbercloud.NewServiceClient(conf, "workspace", acceptance.SBC_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SberCloud Workspace client: %s", err)
+	}
+
+	resp, err := c.Request("GET", c.ServiceURL("workspaces"), &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	status := utils.PathSearch("status", respBody, "").(string)
+	if status == "" || status == "CLOSED" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return respBody, nil
+}
+
+func TestAccWorkspaceService_basic(t *testing.T) {
+	var service interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "sbercloud_workspace_service.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&service,
+		getServiceResourceFunc,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceService_basic(rName, "[sbercloud_vpc_subnet.test.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "sbercloud_vpc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_type", "LITE_AD"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUBSCRIBED"),
+				),
+			},
+			{
+				Config: testAccWorkspaceService_basic(rName,
+					"[sbercloud_vpc_subnet.test.id, sbercloud_vpc_subnet.standby.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"domain_password"},
+			},
+		},
+	})
+}
+
+func testAccWorkspaceService_basic(rName, subnetIDs string) string {
+	return fmt.Sprintf(`
+resource "sbercloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "sbercloud_vpc_subnet" "test" {
+  vpc_id     = sbercloud_vpc.test.id
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "sbercloud_vpc_subnet" "standby" {
+  vpc_id     = sbercloud_vpc.test.id
+  name       = "%[1]s-standby"
+  cidr       = "192.168.1.0/24"
+  gateway_ip = "192.168.1.1"
+}
+
+resource "sbercloud_workspace_service" "test" {
+  vpc_id     = sbercloud_vpc.test.id
+  subnet_ids = %[2]s
+}
+`, rName, subnetIDs)
+}